@@ -0,0 +1,310 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+	"github.com/myleshyson/lsprotocol-go/protocol/recording"
+)
+
+// filter selects the messages that are printed. Responses are selected with
+// the request they answer.
+type filter struct {
+	// Methods to select, or every method when empty.
+	Methods []string
+	// Selects messages whose content mentions the uri.
+	URI string
+	// Selects requests and responses with the id.
+	ID string
+}
+
+// inspectedMessage is a decoded message of a stream.
+type inspectedMessage struct {
+	Time      time.Time
+	Direction recording.Direction
+	// One of "request", "notification" or "response".
+	Kind   string
+	Method protocol.MethodKind
+	ID     string
+	// The size of the message's content in bytes.
+	Size    int
+	Content json.RawMessage
+	Decoded protocol.Message
+	// Why the message couldn't be decoded.
+	Err error
+	// How long the request took to answer, for responses.
+	Latency time.Duration
+	// The error the request was answered with, for responses.
+	Failure *protocol.ResponseError
+	// Whether the filter selects the message.
+	selected bool
+}
+
+// inspector decodes the messages of a session in the order they were sent,
+// correlating responses with their requests, and prints the ones its filter
+// selects.
+type inspector struct {
+	filter filter
+	// Prints the content of messages, not only their summary line.
+	body bool
+
+	out     io.Writer
+	pending map[string]*inspectedMessage
+	stats   map[protocol.MethodKind]*methodStats
+	methods []protocol.MethodKind
+}
+
+type methodStats struct {
+	count     int
+	failures  int
+	latencies []time.Duration
+}
+
+// Creates an inspector printing to out.
+func newInspector(out io.Writer) *inspector {
+	return &inspector{
+		out:     out,
+		pending: map[string]*inspectedMessage{},
+		stats:   map[protocol.MethodKind]*methodStats{},
+	}
+}
+
+// Decodes, records and prints a message's content, sent in direction at at, or
+// at an unknown time if at is zero.
+func (i *inspector) add(direction recording.Direction, at time.Time, content []byte) *inspectedMessage {
+	message := &inspectedMessage{Time: at, Direction: direction, Size: len(content), Content: content}
+
+	var envelope struct {
+		ID     json.RawMessage         `json:"id"`
+		Method string                  `json:"method"`
+		Error  *protocol.ResponseError `json:"error"`
+	}
+
+	if err := json.Unmarshal(content, &envelope); err != nil {
+		message.Err = err
+		message.selected = true
+		i.print(message)
+		return message
+	}
+
+	message.Method = protocol.MethodKind(envelope.Method)
+	message.ID = formatID(envelope.ID)
+
+	switch {
+	case envelope.Method == "":
+		message.Kind = "response"
+		message.Failure = envelope.Error
+
+		if request, exists := i.pending[string(direction.Reverse())+message.ID]; exists {
+			delete(i.pending, string(direction.Reverse())+message.ID)
+			message.Method = request.Method
+
+			// Streams that weren't timed have no latencies.
+			if !at.IsZero() && !request.Time.IsZero() {
+				message.Latency = at.Sub(request.Time)
+			}
+			message.selected = request.selected && (i.filter.ID == "" || i.filter.ID == message.ID)
+		} else {
+			message.selected = i.selects(message)
+		}
+
+		message.Decoded, message.Err = protocol.DecodeResponse(message.Method, content)
+	case message.ID != "":
+		message.Kind = "request"
+		message.selected = i.selects(message)
+		i.pending[string(direction)+message.ID] = message
+		message.Decoded, message.Err = decode(content)
+	default:
+		message.Kind = "notification"
+		message.selected = i.selects(message)
+		message.Decoded, message.Err = decode(content)
+	}
+
+	i.record(message)
+	i.print(message)
+
+	return message
+}
+
+func decode(content []byte) (protocol.Message, error) {
+	framed, err := protocol.EncodeMessage(json.RawMessage(content))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return protocol.DecodeMessage(framed)
+}
+
+// Returns ids as they were sent, without the quotes of string ids.
+func formatID(id json.RawMessage) string {
+	if len(id) == 0 || string(id) == "null" {
+		return ""
+	}
+
+	if unquoted, err := strconv.Unquote(string(id)); err == nil {
+		return unquoted
+	}
+
+	return string(id)
+}
+
+func (i *inspector) selects(message *inspectedMessage) bool {
+	if len(i.filter.Methods) > 0 && !slices.Contains(i.filter.Methods, string(message.Method)) {
+		return false
+	}
+
+	if i.filter.URI != "" && !bytes.Contains(message.Content, []byte(i.filter.URI)) {
+		return false
+	}
+
+	return i.filter.ID == "" || i.filter.ID == message.ID
+}
+
+// Counts requests and notifications, and the latencies of responses.
+func (i *inspector) record(message *inspectedMessage) {
+	if !message.selected {
+		return
+	}
+
+	stats, exists := i.stats[message.Method]
+
+	if !exists {
+		stats = &methodStats{}
+		i.stats[message.Method] = stats
+		i.methods = append(i.methods, message.Method)
+	}
+
+	if message.Kind != "response" {
+		stats.count++
+		return
+	}
+
+	if message.Failure != nil {
+		stats.failures++
+	}
+
+	if message.Latency > 0 {
+		stats.latencies = append(stats.latencies, message.Latency)
+	}
+}
+
+func (i *inspector) print(message *inspectedMessage) {
+	if !message.selected || i.out == nil {
+		return
+	}
+
+	var line []string
+
+	if !message.Time.IsZero() {
+		line = append(line, message.Time.Format("15:04:05.000"))
+	}
+
+	line = append(line, string(message.Direction))
+
+	if message.Kind != "" {
+		line = append(line, message.Kind)
+	}
+
+	if message.Method != "" {
+		line = append(line, string(message.Method))
+	}
+
+	if message.ID != "" {
+		line = append(line, "#"+message.ID)
+	}
+
+	if message.Latency > 0 {
+		line = append(line, message.Latency.Round(time.Microsecond).String())
+	}
+
+	line = append(line, formatSize(message.Size))
+
+	if message.Failure != nil {
+		line = append(line, fmt.Sprintf("error %d: %s", message.Failure.Code, message.Failure.Message))
+	}
+
+	fmt.Fprintln(i.out, strings.Join(line, " "))
+
+	if message.Err != nil {
+		fmt.Fprintf(i.out, "  decode error: %s\n", message.Err)
+	}
+
+	if !i.body {
+		return
+	}
+
+	var indented bytes.Buffer
+
+	if err := json.Indent(&indented, message.Content, "  ", "  "); err != nil {
+		indented.Reset()
+		indented.Write(message.Content)
+	}
+
+	fmt.Fprintf(i.out, "  %s\n\n", indented.String())
+}
+
+func formatSize(size int) string {
+	switch {
+	case size >= 1<<20:
+		return fmt.Sprintf("%.1fMB", float64(size)/(1<<20))
+	case size >= 1<<10:
+		return fmt.Sprintf("%.1fkB", float64(size)/(1<<10))
+	}
+
+	return fmt.Sprintf("%dB", size)
+}
+
+// Prints the number of messages per method and the percentiles of the
+// latencies of its requests, in the order the methods were first seen.
+func (i *inspector) summary(w io.Writer) error {
+	table := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(table, "METHOD\tCOUNT\tERRORS\tP50\tP90\tP99\tMAX")
+
+	for _, method := range i.methods {
+		stats := i.stats[method]
+		name := string(method)
+
+		if name == "" {
+			// Responses to requests that weren't part of the stream.
+			name = "(unknown)"
+		}
+
+		row := []string{name, strconv.Itoa(stats.count), strconv.Itoa(stats.failures)}
+
+		if len(stats.latencies) == 0 {
+			row = append(row, "-", "-", "-", "-")
+		} else {
+			latencies := slices.Clone(stats.latencies)
+			slices.Sort(latencies)
+
+			for _, rank := range []float64{50, 90, 99, 100} {
+				row = append(row, percentile(latencies, rank).Round(time.Microsecond).String())
+			}
+		}
+
+		fmt.Fprintln(table, strings.Join(row, "\t"))
+	}
+
+	return table.Flush()
+}
+
+// Helper function that returns the nearest-rank percentile of sorted latencies.
+func percentile(sorted []time.Duration, rank float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+
+	index := int(math.Ceil(rank / 100 * float64(len(sorted))))
+
+	return sorted[min(max(index, 1), len(sorted))-1]
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/myleshyson/lsprotocol-go/protocol/recording"
+)
+
+var start = time.Date(2024, 1, 1, 10, 1, 2, 0, time.UTC)
+
+func session(i *inspector) {
+	i.add(recording.ClientToServer, start, []byte(`{"jsonrpc":"2.0","id":1,"method":"textDocument/hover","params":{"textDocument":{"uri":"file:///a.go"},"position":{"line":0,"character":0}}}`))
+	i.add(recording.ClientToServer, start.Add(time.Millisecond), []byte(`{"jsonrpc":"2.0","id":2,"method":"textDocument/hover","params":{"textDocument":{"uri":"file:///b.go"},"position":{"line":0,"character":0}}}`))
+	i.add(recording.ServerToClient, start.Add(2*time.Millisecond), []byte(`{"jsonrpc":"2.0","id":1,"method":"workspace/configuration","params":{"items":[]}}`))
+	i.add(recording.ServerToClient, start.Add(12*time.Millisecond), []byte(`{"jsonrpc":"2.0","id":1,"result":null}`))
+	i.add(recording.ServerToClient, start.Add(31*time.Millisecond), []byte(`{"jsonrpc":"2.0","id":2,"error":{"code":-32801,"message":"content modified"}}`))
+	i.add(recording.ClientToServer, start.Add(32*time.Millisecond), []byte(`{"jsonrpc":"2.0","id":1,"result":[]}`))
+	i.add(recording.ClientToServer, start.Add(40*time.Millisecond), []byte(`{"jsonrpc":"2.0","method":"textDocument/didClose","params":{"textDocument":{"uri":"file:///a.go"}}}`))
+}
+
+func TestInspectorCorrelatesResponses(t *testing.T) {
+	var output bytes.Buffer
+	i := newInspector(&output)
+
+	session(i)
+
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+
+	if len(lines) != 7 {
+		t.Fatalf("Expected 7 messages, got %s", output.String())
+	}
+	if lines[3] != "10:01:02.012 server-to-client response textDocument/hover #1 12ms 38B" {
+		t.Fatalf("Expected the response to have its request's method and latency, got %s", lines[3])
+	}
+	if !strings.Contains(lines[4], "textDocument/hover #2 30ms") || !strings.HasSuffix(lines[4], "error -32801: content modified") {
+		t.Fatalf("Expected the failed response, got %s", lines[4])
+	}
+	if !strings.Contains(lines[5], "client-to-server response workspace/configuration #1") {
+		t.Fatalf("Expected the client's response to match the server's request, got %s", lines[5])
+	}
+}
+
+func TestInspectorFilters(t *testing.T) {
+	var output bytes.Buffer
+	i := newInspector(&output)
+	i.filter = filter{URI: "file:///a.go", Methods: []string{"textDocument/hover", "textDocument/didClose"}}
+	i.body = true
+
+	session(i)
+
+	if strings.Count(output.String(), "file:///b.go") != 0 || strings.Count(output.String(), "workspace/configuration") != 0 {
+		t.Fatalf("Expected other documents and methods to be filtered, got %s", output.String())
+	}
+	if !strings.Contains(output.String(), "response textDocument/hover #1") || !strings.Contains(output.String(), `"uri": "file:///a.go"`) {
+		t.Fatalf("Expected the selected request's response and bodies, got %s", output.String())
+	}
+
+	output.Reset()
+	i = newInspector(&output)
+	i.filter = filter{ID: "2"}
+
+	session(i)
+
+	if lines := strings.Split(strings.TrimSpace(output.String()), "\n"); len(lines) != 2 {
+		t.Fatalf("Expected the request and response with the id, got %s", output.String())
+	}
+}
+
+func TestInspectorSummary(t *testing.T) {
+	i := newInspector(nil)
+	session(i)
+
+	var output bytes.Buffer
+	if err := i.summary(&output); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+
+	if len(lines) != 4 || strings.Join(strings.Fields(lines[1]), " ") != "textDocument/hover 2 1 12ms 30ms 30ms 30ms" {
+		t.Fatalf("Unexpected summary: %s", output.String())
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	latencies := make([]time.Duration, 100)
+	for i := range latencies {
+		latencies[i] = time.Duration(i+1) * time.Millisecond
+	}
+
+	if percentile(latencies, 50) != 50*time.Millisecond || percentile(latencies, 99) != 99*time.Millisecond || percentile(latencies, 100) != 100*time.Millisecond {
+		t.Fatal("Expected nearest-rank percentiles")
+	}
+	if percentile(nil, 50) != 0 || percentile(latencies[:1], 0) != time.Millisecond {
+		t.Fatal("Expected percentiles of empty and single latencies")
+	}
+}
@@ -0,0 +1,378 @@
+// Command lspinspect decodes framed language server protocol streams and
+// prints their messages with their direction, latency and size.
+//
+// Usage:
+//
+//	lspinspect [flags] [stream [stream]]
+//
+// Each stream is a file, a named pipe, or "-" for stdin, which is the default.
+// The first stream holds the messages sent in -direction, and the second
+// stream, if any, the messages sent in reverse, e.g. the two sides of a server
+// captured with tee. Live streams, such as pipes, are read concurrently and
+// messages are timed as they are read, so latencies are measured. Files have
+// no timing, so they are read in order and print no times or latencies. Either
+// way, a response is inspected after the request it answers. With -recording,
+// the streams are recordings written by recording.Recorder, which include the
+// direction and time of every message.
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+	"github.com/myleshyson/lsprotocol-go/protocol/recording"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "lspinspect:", err)
+		os.Exit(1)
+	}
+}
+
+// A message read from a stream, or the end of the stream.
+type read struct {
+	direction recording.Direction
+	// When the message was read, for live streams.
+	time    time.Time
+	content []byte
+	err     error
+	end     bool
+}
+
+func run(args []string, stdin io.Reader, stdout io.Writer) error {
+	flags := flag.NewFlagSet("lspinspect", flag.ContinueOnError)
+	methods := flags.String("method", "", "only show these comma separated methods")
+	uri := flags.String("uri", "", "only show messages that mention this uri")
+	id := flags.String("id", "", "only show the request and response with this id")
+	summary := flags.Bool("summary", false, "only show the number of messages and the latencies per method")
+	body := flags.Bool("body", true, "show the content of messages")
+	direction := flags.String("direction", string(recording.ClientToServer), "the direction of the messages of the first stream")
+	isRecording := flags.Bool("recording", false, "read recordings of recording.Recorder instead of framed streams")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *direction != string(recording.ClientToServer) && *direction != string(recording.ServerToClient) {
+		return fmt.Errorf("invalid direction: %s", *direction)
+	}
+
+	paths := flags.Args()
+
+	if len(paths) == 0 {
+		paths = []string{"-"}
+	}
+
+	if len(paths) > 2 {
+		return errors.New("expected at most two streams")
+	}
+
+	if len(paths) == 2 && paths[0] == "-" && paths[1] == "-" {
+		return errors.New("expected stdin to be one stream at most")
+	}
+
+	output := bufio.NewWriter(stdout)
+	defer output.Flush()
+
+	inspector := newInspector(output)
+	inspector.body = *body
+	inspector.filter = filter{URI: *uri, ID: *id}
+
+	if *methods != "" {
+		inspector.filter.Methods = strings.Split(*methods, ",")
+	}
+
+	if *summary {
+		inspector.out = nil
+	}
+
+	streams := make([]io.Reader, len(paths))
+
+	for i, path := range paths {
+		if path == "-" {
+			streams[i] = stdin
+			continue
+		}
+
+		file, err := os.Open(path)
+
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		streams[i] = file
+	}
+
+	var err error
+
+	if *isRecording {
+		err = inspectRecordings(inspector, streams)
+	} else {
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+
+		live := !slices.ContainsFunc(streams, func(stream io.Reader) bool { return !isLive(stream) })
+		err = inspectStreams(ctx, inspector, streams, recording.Direction(*direction), live)
+	}
+
+	if *summary {
+		if summaryErr := inspector.summary(output); summaryErr != nil {
+			return summaryErr
+		}
+	}
+
+	return err
+}
+
+// Reports whether stream is a pipe or a terminal rather than a regular file,
+// so its messages can be timed as they are read.
+func isLive(stream io.Reader) bool {
+	file, ok := stream.(*os.File)
+
+	if !ok {
+		return false
+	}
+
+	info, err := file.Stat()
+
+	return err == nil && !info.Mode().IsRegular()
+}
+
+// Reads framed streams, the first sent in direction and the second in
+// reverse, until they end or ctx is done. Live streams are read concurrently
+// and timed, and other streams one after the other.
+func inspectStreams(ctx context.Context, inspector *inspector, streams []io.Reader, direction recording.Direction, live bool) error {
+	directions := []recording.Direction{direction, direction.Reverse()}[:len(streams)]
+	merger := newMerger(inspector, directions, live)
+	var errs []error
+
+	receive := func(next read) {
+		switch {
+		case next.err != nil:
+			errs = append(errs, fmt.Errorf("reading %s stream: %w", next.direction, next.err))
+		case next.end:
+			merger.end(next.direction)
+		default:
+			merger.push(next)
+		}
+	}
+
+	if !live {
+		for i, stream := range streams {
+			readStream(stream, directions[i], false, func(next read) bool {
+				receive(next)
+				return ctx.Err() == nil
+			})
+		}
+
+		return errors.Join(errs...)
+	}
+
+	reads := make(chan read)
+
+	for i, stream := range streams {
+		go readStream(stream, directions[i], true, func(next read) bool {
+			select {
+			case reads <- next:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
+	}
+
+	// Releases responses held back for longer than holdTimeout.
+	ticker := time.NewTicker(holdTimeout)
+	defer ticker.Stop()
+
+	for ended := 0; ended < len(streams); {
+		select {
+		case <-ctx.Done():
+			// Interrupted, e.g. to see the summary of a live pipe.
+			return errors.Join(errs...)
+		case <-ticker.C:
+			merger.drain()
+		case next := <-reads:
+			if next.end {
+				ended++
+			}
+			receive(next)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Reads the messages of a framed stream, timing them if live, and passes them
+// to receive until it returns false. The end of the stream is passed last.
+func readStream(stream io.Reader, direction recording.Direction, live bool, receive func(read) bool) {
+	scanner := protocol.NewScanner(stream)
+
+	for scanner.Scan() {
+		_, _, content, err := protocol.SplitMessage(scanner.Bytes())
+		message := read{direction: direction, content: slices.Clone(content), err: err}
+
+		if live {
+			message.time = time.Now()
+		}
+
+		if !receive(message) {
+			return
+		}
+	}
+
+	if err := scanner.Err(); err != nil && !receive(read{direction: direction, err: err}) {
+		return
+	}
+
+	receive(read{direction: direction, end: true})
+}
+
+// How long a live stream is held back by a response whose request wasn't read.
+const holdTimeout = time.Second
+
+// merger passes the messages of two streams to an inspector so that responses
+// follow the request they answer, whichever stream is read first. Each stream
+// keeps its order, so a response whose request hasn't been read holds back its
+// stream until the request is read, or can't be anymore because the other
+// stream ended. Live streams are held back for holdTimeout at most.
+type merger struct {
+	inspector *inspector
+	live      bool
+	queued    map[recording.Direction][]read
+	ended     map[recording.Direction]bool
+	// Requests passed on and not yet answered, by the direction they were sent
+	// in and id.
+	requests map[string]bool
+}
+
+// Creates a merger of the streams sent in directions.
+func newMerger(inspector *inspector, directions []recording.Direction, live bool) *merger {
+	m := &merger{
+		inspector: inspector,
+		live:      live,
+		queued:    map[recording.Direction][]read{},
+		ended:     map[recording.Direction]bool{recording.ClientToServer: true, recording.ServerToClient: true},
+		requests:  map[string]bool{},
+	}
+
+	// Directions without a stream have nothing left to read.
+	for _, direction := range directions {
+		m.ended[direction] = false
+	}
+
+	return m
+}
+
+func (m *merger) push(message read) {
+	m.queued[message.direction] = append(m.queued[message.direction], message)
+	m.drain()
+}
+
+func (m *merger) end(direction recording.Direction) {
+	m.ended[direction] = true
+	m.drain()
+}
+
+// Passes on queued messages until every stream is empty or held back.
+func (m *merger) drain() {
+	for m.release(false) || m.release(true) {
+	}
+}
+
+// Passes on the first queued message that is ready or, with force, that waits
+// for a request that can't be read anymore. Reports whether it passed one on.
+func (m *merger) release(force bool) bool {
+	for _, direction := range []recording.Direction{recording.ClientToServer, recording.ServerToClient} {
+		queue := m.queued[direction]
+
+		if len(queue) == 0 {
+			continue
+		}
+
+		next := queue[0]
+		id, request, response := envelopeID(next.content)
+
+		if response && !m.requests[string(direction.Reverse())+id] && !(force && m.expired(next)) {
+			continue
+		}
+
+		m.queued[direction] = queue[1:]
+
+		if request {
+			m.requests[string(direction)+id] = true
+		} else if response {
+			delete(m.requests, string(direction.Reverse())+id)
+		}
+
+		m.inspector.add(next.direction, next.time, next.content)
+		return true
+	}
+
+	return false
+}
+
+// Reports whether the request a held back message waits for can't be read
+// anymore.
+func (m *merger) expired(message read) bool {
+	return m.ended[message.direction.Reverse()] || m.live && time.Since(message.time) >= holdTimeout
+}
+
+// Helper function that returns the id of a message's content, and whether it
+// is a request or a response.
+func envelopeID(content []byte) (string, bool, bool) {
+	var envelope struct {
+		ID     json.RawMessage `json:"id"`
+		Method string          `json:"method"`
+	}
+
+	if err := json.Unmarshal(content, &envelope); err != nil {
+		return "", false, false
+	}
+
+	id := formatID(envelope.ID)
+
+	if id == "" {
+		return "", false, false
+	}
+
+	return id, envelope.Method != "", envelope.Method == ""
+}
+
+// Reads recordings and inspects their entries in the order they were recorded.
+func inspectRecordings(inspector *inspector, streams []io.Reader) error {
+	var entries []recording.Entry
+
+	for _, stream := range streams {
+		read, err := recording.ReadEntries(stream)
+
+		if err != nil {
+			return err
+		}
+
+		entries = append(entries, read...)
+	}
+
+	slices.SortStableFunc(entries, func(a, b recording.Entry) int {
+		return a.Time.Compare(b.Time)
+	})
+
+	for _, entry := range entries {
+		inspector.add(entry.Direction, entry.Time, entry.Message)
+	}
+
+	return nil
+}
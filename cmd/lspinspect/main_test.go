@@ -0,0 +1,158 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+	"github.com/myleshyson/lsprotocol-go/protocol/recording"
+)
+
+func framed(t *testing.T, messages ...string) []byte {
+	var stream bytes.Buffer
+
+	for _, message := range messages {
+		content, err := protocol.EncodeMessage(json.RawMessage(message))
+		if err != nil {
+			t.Fatal(err)
+		}
+		stream.Write(content)
+	}
+
+	return stream.Bytes()
+}
+
+func TestRunFramedStreams(t *testing.T) {
+	dir := t.TempDir()
+	server := filepath.Join(dir, "server")
+
+	if err := os.WriteFile(server, framed(t, `{"jsonrpc":"2.0","method":"window/logMessage","params":{"type":3,"message":"ready"}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	stdin := bytes.NewReader(framed(t,
+		`{"jsonrpc":"2.0","id":1,"method":"shutdown"}`,
+		`{"jsonrpc":"2.0","method":"exit"}`,
+	))
+
+	var output bytes.Buffer
+	if err := run([]string{"-body=false", "-", server}, stdin, &output); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, expected := range []string{
+		"client-to-server request shutdown #1 44B",
+		"client-to-server notification exit",
+		"server-to-client notification window/logMessage",
+	} {
+		if !strings.Contains(output.String(), expected) {
+			t.Fatalf("Expected %q, got %s", expected, output.String())
+		}
+	}
+}
+
+func TestRunRecordingSummary(t *testing.T) {
+	var file bytes.Buffer
+	for _, line := range []string{
+		`{"time":"2024-01-01T10:00:00Z","direction":"client-to-server","message":{"jsonrpc":"2.0","id":1,"method":"shutdown"}}`,
+		`{"time":"2024-01-01T10:00:00.25Z","direction":"server-to-client","message":{"jsonrpc":"2.0","id":1,"result":null}}`,
+	} {
+		file.WriteString(line + "\n")
+	}
+
+	var output bytes.Buffer
+	if err := run([]string{"-recording", "-summary"}, &file, &output); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+	if len(lines) != 2 || strings.Join(strings.Fields(lines[1]), " ") != "shutdown 1 0 250ms 250ms 250ms 250ms" {
+		t.Fatalf("Unexpected summary: %s", output.String())
+	}
+}
+
+func TestRunInvalidArguments(t *testing.T) {
+	if err := run([]string{"-direction", "sideways"}, nil, &bytes.Buffer{}); err == nil {
+		t.Fatal("Expected an invalid direction to fail")
+	}
+	if err := run([]string{"a", "b", "c"}, nil, &bytes.Buffer{}); err == nil {
+		t.Fatal("Expected more than two streams to fail")
+	}
+	if err := run([]string{"-", "-"}, nil, &bytes.Buffer{}); err == nil {
+		t.Fatal("Expected stdin as both streams to fail")
+	}
+}
+
+func TestRunFilesInOrder(t *testing.T) {
+	dir := t.TempDir()
+	client := filepath.Join(dir, "client")
+	server := filepath.Join(dir, "server")
+
+	// The client's file starts with its response to the server's request.
+	if err := os.WriteFile(client, framed(t,
+		`{"jsonrpc":"2.0","id":1,"result":[]}`,
+		`{"jsonrpc":"2.0","id":1,"method":"shutdown"}`,
+	), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(server, framed(t,
+		`{"jsonrpc":"2.0","id":1,"method":"workspace/configuration","params":{"items":[]}}`,
+		`{"jsonrpc":"2.0","id":1,"result":null}`,
+	), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	for range 10 {
+		var output bytes.Buffer
+		if err := run([]string{"-body=false", client, server}, nil, &output); err != nil {
+			t.Fatal(err)
+		}
+
+		expected := strings.Join([]string{
+			"server-to-client request workspace/configuration #1 81B",
+			"client-to-server response workspace/configuration #1 36B",
+			"client-to-server request shutdown #1 44B",
+			"server-to-client response shutdown #1 38B",
+		}, "\n") + "\n"
+
+		if output.String() != expected {
+			t.Fatalf("Expected responses after their requests without times, got %s", output.String())
+		}
+	}
+}
+
+func TestMergerHoldsResponses(t *testing.T) {
+	var output bytes.Buffer
+	merger := newMerger(newInspector(&output), []recording.Direction{recording.ClientToServer, recording.ServerToClient}, true)
+	now := time.Now()
+
+	// The server's response is read before the client's request.
+	merger.push(read{direction: recording.ServerToClient, time: now, content: []byte(`{"jsonrpc":"2.0","id":1,"result":null}`)})
+	if output.Len() != 0 {
+		t.Fatalf("Expected the response to be held back, got %s", output.String())
+	}
+
+	merger.push(read{direction: recording.ClientToServer, time: now.Add(time.Millisecond), content: []byte(`{"jsonrpc":"2.0","id":1,"method":"shutdown"}`)})
+
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+	if len(lines) != 2 || !strings.Contains(lines[0], "request shutdown #1") || !strings.HasSuffix(lines[1], "response shutdown #1 38B") {
+		t.Fatalf("Expected the response after its request without a latency, got %s", output.String())
+	}
+
+	// A response to a request that wasn't read waits for the other stream.
+	output.Reset()
+	merger.push(read{direction: recording.ServerToClient, time: time.Now(), content: []byte(`{"jsonrpc":"2.0","id":2,"result":null}`)})
+	if output.Len() != 0 {
+		t.Fatalf("Expected the response to be held back, got %s", output.String())
+	}
+
+	merger.end(recording.ClientToServer)
+	if !strings.Contains(output.String(), "response #2") {
+		t.Fatalf("Expected the response once the other stream ended, got %s", output.String())
+	}
+}
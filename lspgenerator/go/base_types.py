@@ -40,8 +40,8 @@ def generate_base_types(
 						f"func (t *{alias.name}) UnmarshalJSON(x []byte) error {{",
 						f"	return (*{resolved_type})(t).UnmarshalJSON(x)",
 						"}",
-						f"func (t *{alias.name}) MarshalJSON() ([]byte, error) {{",
-						f"	return (*{resolved_type})(t).MarshalJSON()",
+						f"func (t {alias.name}) MarshalJSON() ([]byte, error) {{",
+						f"	return {resolved_type}(t).MarshalJSON()",
 						"}",
 					],
 				),
@@ -0,0 +1,105 @@
+from generator import model
+
+from .type_resolver import TypeResolver, is_null
+from .utils import join, method_to_camel_case
+
+
+def generate_calls(
+	spec: model.LSPModel,
+	type_resolver: TypeResolver,
+) -> str:
+	"""
+	Generates a typed method on Conn for every request and notification. Methods are named
+	after the message type without its Request/Notification suffix, unless that name is
+	shared by a request and a notification (e.g. window/showMessageRequest and window/showMessage),
+	in which case both keep their full type name.
+	"""
+	requests = sorted(spec.requests, key=lambda x: x.method)
+	notifications = sorted(spec.notifications, key=lambda x: x.method)
+
+	names: dict[str, int] = {}
+	for message in [*requests, *notifications]:
+		name = _short_name(message.typeName)
+		names[name] = names.get(name, 0) + 1
+
+	def method_name(type_name: str) -> str:
+		name = _short_name(type_name)
+		return name if names[name] == 1 else type_name
+
+	result = [
+		"package protocol",
+		"import (",
+		'	"context"',
+		")",
+	]
+
+	for request in requests:
+		constant = f"{method_to_camel_case(request.method)}Method"
+		param_type = type_resolver.resolve(request.params) if request.params else None
+		arguments = "ctx context.Context"
+		params = "nil"
+
+		if param_type:
+			arguments += f", params {param_type}"
+			params = "params"
+
+		if not request.result or is_null(request.result):
+			result.append(
+				join(
+					[
+						f"// Sends the {request.method} request and waits for its response.",
+						f"func (c *Conn) {method_name(request.typeName)}({arguments}) error {{",
+						f"	return c.Call(ctx, {constant}, {params}, nil)",
+						"}",
+					],
+				),
+			)
+			continue
+
+		result_type = type_resolver.resolve(request.result, True)
+		result.append(
+			join(
+				[
+					f"// Sends the {request.method} request and waits for its result.",
+					f"func (c *Conn) {method_name(request.typeName)}({arguments}) ({result_type}, error) {{",
+					f"	var result {result_type}",
+					f"	err := c.Call(ctx, {constant}, {params}, &result)",
+					"	return result, err",
+					"}",
+				],
+			),
+		)
+
+	for notification in notifications:
+		constant = f"{method_to_camel_case(notification.method.replace('$', 'Optional'))}Method"
+		param_type = (
+			type_resolver.resolve(notification.params)
+			if notification.params
+			else None
+		)
+		arguments = "ctx context.Context"
+		params = "nil"
+
+		if param_type:
+			arguments += f", params {param_type}"
+			params = "params"
+
+		result.append(
+			join(
+				[
+					f"// Sends the {notification.method} notification.",
+					f"func (c *Conn) {method_name(notification.typeName)}({arguments}) error {{",
+					f"	return c.Notify(ctx, {constant}, {params})",
+					"}",
+				],
+			),
+		)
+
+	return join(result)
+
+
+def _short_name(type_name: str) -> str:
+	for suffix in ("Request", "Notification"):
+		if type_name.endswith(suffix):
+			return type_name[: -len(suffix)]
+	return type_name
@@ -0,0 +1,360 @@
+from __future__ import annotations
+
+import importlib.resources
+import itertools
+import json
+import os
+import pathlib
+import re
+from typing import NamedTuple
+
+from generator import model
+
+from .calls import _short_name
+from .enums import generate_enums
+from .structs import generate_structs
+from .type_resolver import TypeResolver, is_null
+from .utils import join, lines_to_comments, method_to_camel_case
+
+PROTOCOL_IMPORT = "github.com/myleshyson/lsprotocol-go/protocol"
+
+# Set by the magefile's GenerateExtension target. The generator's command line has no
+# options for plugins, so the mode is passed through the environment.
+EXTENSION_ENV = "LSPGENERATOR_GO_EXTENSION"
+
+
+class ExtensionTypeResolver(TypeResolver):
+	"""
+	Resolves the types of an extension's metaModel. Types the extension doesn't define itself,
+	and the generic helpers (Or2, Tuple, LSPObject, ...), refer to the protocol package.
+	"""
+
+	def __init__(self, spec: model.LSPModel) -> None:
+		super().__init__(spec)
+		self.local_types = {
+			some.name
+			for some in itertools.chain(
+				spec.structures,
+				spec.enumerations,
+				spec.typeAliases,
+			)
+		}
+
+	def qualify(self, name: str) -> str:
+		return f"protocol.{name}"
+
+	def resolve(
+		self,
+		lsp_type,
+		is_optional: bool = False,
+	) -> str:
+		if isinstance(lsp_type, (model.ReferenceType, model.ReferenceMapKeyType)):
+			if lsp_type.name.lower() == "lspany":
+				return "any"
+			if lsp_type.name not in self.local_types:
+				return self.qualify(lsp_type.name)
+		return super().resolve(lsp_type, is_optional)
+
+	def _resolve_base_type(self, type: str):
+		resolved = super()._resolve_base_type(type)
+		if resolved in ("DocumentUri", "URI"):
+			return self.qualify(resolved)
+		return resolved
+
+
+class Types(NamedTuple):
+	"""
+	The types parent classes are looked up in.
+	"""
+
+	structures: list[model.Structure]
+	enumerations: list[model.Enum]
+	typeAliases: list[model.TypeAlias]
+
+
+def is_extension() -> bool:
+	"""
+	Reports whether an extension package was asked for, rather than the protocol package.
+	"""
+	return os.environ.get(EXTENSION_ENV) == "1"
+
+
+def package_name(output_dir: str) -> str:
+	return re.sub(r"[^a-z0-9_]", "", pathlib.Path(output_dir).resolve().name.lower())
+
+
+def generate_extension(
+	spec: model.LSPModel,
+	output_dir: str,
+) -> None:
+	"""
+	Generates a package for a custom metaModel, with its types, the extension methods
+	to pass to protocol.ConnOptions.Extensions, and typed handlers.
+	"""
+	package = package_name(output_dir)
+	type_resolver = ExtensionTypeResolver(spec)
+	protocol_spec = _protocol_spec()
+	lookup = Types(
+		structures=[*spec.structures, *protocol_spec.structures],
+		enumerations=[*spec.enumerations, *protocol_spec.enumerations],
+		typeAliases=[*spec.typeAliases, *protocol_spec.typeAliases],
+	)
+	code = {
+		"types.go": join(
+			generate_header(package, ["bytes", "encoding/json", "fmt"])
+			+ [
+				"var (",
+				"	_ = bytes.NewReader",
+				"	_ = json.Marshal",
+				"	_ = fmt.Errorf",
+				"	_ protocol.Message",
+				")",
+			]
+			+ _generate_aliases(spec, type_resolver)
+			+ generate_structs(spec, type_resolver, lookup)  # pyright: ignore
+			+ generate_enums(spec, type_resolver),
+		),
+		"methods.go": join(
+			generate_header(package, ["context"])
+			+ _generate_methods(spec, type_resolver),
+		),
+	}
+	output_path = pathlib.Path(output_dir)
+
+	if not output_path.exists():
+		output_path.mkdir(parents=True, exist_ok=True)
+
+	for file_name, content in code.items():
+		pathlib.Path(output_dir, file_name).write_text(
+			content,
+			encoding="utf-8",
+		)
+
+
+def generate_header(package: str, imports: list[str]) -> list[str]:
+	return [
+		f"package {package}\n\n",
+		"import (",
+		*[f'\t"{name}"' for name in imports],
+		"",
+		f'\t"{PROTOCOL_IMPORT}"',
+		")",
+	]
+
+
+def _protocol_spec() -> model.LSPModel:
+	"""
+	Loads the protocol's own metaModel, so extension structs can extend protocol structs.
+	"""
+	content = (importlib.resources.files("generator") / "lsp.json").read_text(
+		encoding="utf-8",
+	)
+	return model.create_lsp_model([json.loads(content)])
+
+
+def _generate_aliases(
+	spec: model.LSPModel,
+	type_resolver: TypeResolver,
+) -> list[str]:
+	result = []
+
+	for alias in sorted(spec.typeAliases, key=lambda x: x.name):
+		resolved_type = type_resolver.resolve(alias.type)
+		result.append(lines_to_comments(alias.documentation))
+		result.append(f"type {alias.name} {resolved_type}\n")
+
+		# Aliases that point to an Or generic type, need to update their unmarshal type.
+		if re.match(r"^protocol\.Or\d\[", resolved_type):
+			result.append(
+				join(
+					[
+						f"func (t *{alias.name}) UnmarshalJSON(x []byte) error {{",
+						f"	return (*{resolved_type})(t).UnmarshalJSON(x)",
+						"}",
+						f"func (t {alias.name}) MarshalJSON() ([]byte, error) {{",
+						f"	return {resolved_type}(t).MarshalJSON()",
+						"}",
+					],
+				),
+			)
+
+	return result
+
+
+def _constant(method: str) -> str:
+	return f"{method_to_camel_case(method.replace('$', 'Optional'))}Method"
+
+
+def _handler_names(
+	messages: list[model.Request | model.Notification],
+) -> dict[str, str]:
+	"""
+	Returns the Handlers field of each message by its type name. Fields are named after the
+	message type without its Request/Notification suffix, unless that name is shared by a
+	request and a notification, in which case both keep their full type name. Type names,
+	method constants or fields that still collide fail the generation, since they would
+	not compile.
+	"""
+	short_names: dict[str, int] = {}
+	for message in messages:
+		name = _short_name(message.typeName)
+		short_names[name] = short_names.get(name, 0) + 1
+
+	names = {
+		message.typeName: (
+			_short_name(message.typeName)
+			if short_names[_short_name(message.typeName)] == 1
+			else message.typeName
+		)
+		for message in messages
+	}
+
+	for kind, values in (
+		("type name", [message.typeName for message in messages]),
+		("method constant", [_constant(message.method) for message in messages]),
+		("handler", list(names.values())),
+	):
+		duplicates = sorted({value for value in values if values.count(value) > 1})
+		if duplicates:
+			raise ValueError(
+				f"Duplicate {kind} in extension: {', '.join(duplicates)}",
+			)
+
+	return names
+
+
+def _generate_methods(
+	spec: model.LSPModel,
+	type_resolver: TypeResolver,
+) -> list[str]:
+	"""
+	Generates a method constant and an extension method for every request and notification,
+	the Extensions list, and a Handlers struct with a typed handler per method.
+	"""
+	requests = sorted(spec.requests, key=lambda x: x.method)
+	notifications = sorted(spec.notifications, key=lambda x: x.method)
+	handler_names = _handler_names([*requests, *notifications])
+
+	constants = ["const ("]
+	methods = []
+	extensions = []
+	handlers = []
+	cases = []
+
+	for request in requests:
+		constant = _constant(request.method)
+		name = handler_names[request.typeName]
+		param_type = type_resolver.resolve(request.params) if request.params else None
+		result_type = (
+			type_resolver.resolve(request.result, True)
+			if request.result and not is_null(request.result)
+			else None
+		)
+
+		constants.append(
+			f'\t{constant} protocol.MethodKind = "{request.method}"',
+		)
+		methods.append(lines_to_comments(request.documentation))
+		methods.append(
+			f"var {request.typeName} = protocol.RegisterRequest[{param_type or 'any'}, {result_type or 'any'}]({constant})\n",
+		)
+		extensions.append(f"\t{request.typeName},")
+
+		arguments = "ctx context.Context"
+		params = "ctx"
+		if param_type:
+			arguments += f", params {param_type}"
+			params += ", request.Params"
+
+		handlers.append(
+			f"\t{name} func({arguments}) ({result_type}, error)"
+			if result_type
+			else f"\t{name} func({arguments}) error",
+		)
+		cases.append(
+			join(
+				[
+					f"\t\t\tcase {constant}:",
+					f"\t\t\t\tif {'request' if param_type else '_'}, ok := message.(protocol.ExtensionRequest[{param_type or 'any'}]); ok && h.{name} != nil {{",
+					f"\t\t\t\t\treturn h.{name}({params})"
+					if result_type
+					else f"\t\t\t\t\treturn nil, h.{name}({params})",
+					"\t\t\t\t}",
+				],
+			),
+		)
+
+	for notification in notifications:
+		constant = _constant(notification.method)
+		name = handler_names[notification.typeName]
+		param_type = (
+			type_resolver.resolve(notification.params)
+			if notification.params
+			else None
+		)
+
+		constants.append(
+			f'\t{constant} protocol.MethodKind = "{notification.method}"',
+		)
+		methods.append(lines_to_comments(notification.documentation))
+		methods.append(
+			f"var {notification.typeName} = protocol.RegisterNotification[{param_type or 'any'}]({constant})\n",
+		)
+		extensions.append(f"\t{notification.typeName},")
+
+		arguments = "ctx context.Context"
+		params = "ctx"
+		if param_type:
+			arguments += f", params {param_type}"
+			params += ", notification.Params"
+
+		handlers.append(f"\t{name} func({arguments}) error")
+		cases.append(
+			join(
+				[
+					f"\t\t\tcase {constant}:",
+					f"\t\t\t\tif {'notification' if param_type else '_'}, ok := message.(protocol.ExtensionNotification[{param_type or 'any'}]); ok && h.{name} != nil {{",
+					f"\t\t\t\t\treturn nil, h.{name}({params})",
+					"\t\t\t\t}",
+				],
+			),
+		)
+
+	constants.append(")")
+
+	return [
+		join(constants),
+		*methods,
+		join(
+			[
+				"// The extension's methods, to pass to protocol.ConnOptions.Extensions or protocol.DecodeMessage.",
+				"var Extensions = []protocol.Extension{",
+				*extensions,
+				"}",
+			],
+		),
+		join(
+			[
+				"// Handlers handles the extension's methods. Messages for methods without a handler",
+				"// are passed on to the next handler.",
+				"type Handlers struct {",
+				*handlers,
+				"}",
+			],
+		),
+		join(
+			[
+				"// Returns middleware that handles the extension's methods with h.",
+				"func (h Handlers) Middleware() protocol.Middleware {",
+				"\treturn func(next protocol.Handler) protocol.Handler {",
+				"\t\treturn func(ctx context.Context, message protocol.IncomingMessage) (any, error) {",
+				"\t\t\tswitch message.GetMethod() {",
+				*cases,
+				"\t\t\t}",
+				"\t\t\treturn next(ctx, message)",
+				"\t\t}",
+				"\t}",
+				"}",
+			],
+		),
+	]
@@ -5,7 +5,9 @@ import pathlib
 from generator import model
 
 from .base_types import generate_base_types
+from .calls import generate_calls
 from .enums import generate_enums
+from .extension import generate_extension, is_extension
 from .notifications import generate_notifications
 from .or_types import generate_or_types
 from .registry import generate_registry
@@ -22,6 +24,10 @@ def generate_from_spec(
 	test_dir: str,
 ) -> None:
 	"""Generate the code for the given spec."""
+	if is_extension():
+		generate_extension(spec, output_dir)
+		return
+
 	type_resolver = TypeResolver(spec)
 	header = generate_header()
 	base_types = generate_base_types(spec, type_resolver)
@@ -42,6 +48,7 @@ def generate_from_spec(
 		),
 		"types_test.go": generate_tests(spec),
 		"registry.go": generate_registry(spec),
+		"calls.go": generate_calls(spec, type_resolver),
 	}
 	output_path = pathlib.Path(output_dir)
 	test_path = pathlib.Path(test_dir)
@@ -1,6 +1,12 @@
+import itertools
+
 from generator import model
 
-from .utils import join
+from .utils import introduced_in, join
+
+# The latest released version of the protocol. Anything newer in the metaModel is still
+# being specified.
+LATEST_RELEASE = (3, 17)
 
 
 def generate_registry(spec: model.LSPModel) -> str:
@@ -45,5 +51,147 @@ def generate_registry(spec: model.LSPModel) -> str:
 		],
 	)
 	result.append("}")
+	result.extend(_generate_response_registry(spec))
+	result.extend(_generate_method_versions(spec))
+	result.extend(_generate_type_versions(spec))
+	result.extend(_generate_registration_options_registry(spec))
 
 	return join(result)
+
+
+def _generate_response_registry(spec: model.LSPModel) -> list[str]:
+	"""
+	Maps every request method to a decoder for its response.
+	"""
+	result = [
+		"var ResponseRegistry = map[string]func([]byte) (Message, error) {",
+	]
+
+	result.extend(
+		[
+			join(
+				[
+					f'	"{request.method}": func(data []byte) (Message, error) {{',
+					f"		var message {request.typeName.replace('Request', 'Response')}",
+					"		if err := json.Unmarshal(data, &message); err != nil {",
+					"			return nil, err",
+					"		}",
+					"		return message, nil",
+					"	},",
+				],
+			)
+			for request in spec.requests
+		],
+	)
+	result.append("}")
+
+	return result
+
+
+def _generate_method_versions(spec: model.LSPModel) -> list[str]:
+	"""
+	Maps methods that were added after the first version of the protocol, or are still
+	proposed, to the version they were added in.
+	"""
+	result = [
+		"var MethodVersions = map[string]MethodVersion{",
+	]
+
+	for message in itertools.chain(spec.requests, spec.notifications):
+		since = introduced_in(message.since)
+
+		if not since and not message.proposed:
+			continue
+
+		fields = []
+		if since:
+			fields.append(f'Since: "{since}"')
+		if message.proposed:
+			fields.append("Proposed: true")
+
+		result.append(f'	"{message.method}": {{{", ".join(fields)}}},')
+	result.append("}")
+
+	return result
+
+
+def _generate_type_versions(spec: model.LSPModel) -> list[str]:
+	"""
+	Maps structures and enumerations that were added after the first version of the protocol,
+	or are still proposed, to the version they were added in, so the alternatives of a union
+	can be checked like fields.
+
+	Type aliases are left out, and so are types of unreleased versions that aren't proposed:
+	the metaModel dates aliases and named literals (e.g. Pattern or PrepareRenamePlaceholder)
+	by when they were named, not by when older versions started sending them inline.
+	"""
+	result = [
+		"var TypeVersions = map[string]MethodVersion{",
+	]
+
+	for some in sorted(
+		itertools.chain(spec.structures, spec.enumerations),
+		key=lambda x: x.name,
+	):
+		since = introduced_in(some.since)
+
+		if not since and not some.proposed:
+			continue
+
+		if since and _is_unreleased(since) and not some.proposed:
+			continue
+
+		fields = []
+		if since:
+			fields.append(f'Since: "{since}"')
+		if some.proposed:
+			fields.append("Proposed: true")
+
+		result.append(f'	"{some.name}": {{{", ".join(fields)}}},')
+	result.append("}")
+
+	return result
+
+
+def _is_unreleased(since: str) -> bool:
+	major, minor = (int(part) for part in since.split(".")[:2])
+	return (major, minor) > LATEST_RELEASE
+
+
+def _generate_registration_options_registry(spec: model.LSPModel) -> list[str]:
+	"""
+	Maps every registration method to a decoder for its registration options. Several
+	requests can share a registration method (e.g. the semantic token requests all
+	register through textDocument/semanticTokens), so each method is only emitted once.
+	"""
+	result = [
+		"var RegistrationOptionsRegistry = map[string]func([]byte) (any, error) {",
+	]
+	seen: set[str] = set()
+
+	for message in itertools.chain(spec.requests, spec.notifications):
+		if not isinstance(message.registrationOptions, model.ReferenceType):
+			continue
+
+		method = message.registrationMethod or message.method
+
+		if method in seen:
+			continue
+		seen.add(method)
+
+		result.append(
+			join(
+				[
+					f'	"{method}": func(data []byte) (any, error) {{',
+					f"		var options {message.registrationOptions.name}",
+					"		if err := json.Unmarshal(data, &options); err != nil {",
+					"			return nil, err",
+					"		}",
+					"		return options, nil",
+					"	},",
+				],
+			),
+		)
+	result.append("}")
+
+	return result
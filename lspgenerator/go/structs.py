@@ -7,6 +7,7 @@ from generator import model
 from .type_resolver import TypeResolver
 from .utils import (
 	capitalize,
+	introduced_in,
 	join,
 	lines_to_comments,
 )
@@ -15,17 +16,19 @@ from .utils import (
 def generate_structs(
 	spec: model.LSPModel,
 	type_resolver: TypeResolver,
+	lookup: model.LSPModel | None = None,
 ) -> list[str]:
 	"""
 	Since the LSP specification relies on inheritance for object definitions while Go lacks this feature, we flatten the hierarchy by
 	including all properties from parent classes directly in each struct definition.
+	Parent classes are looked up in lookup when given, so extensions can extend protocol types.
 	"""
 	results = []
 	structs = sorted(spec.structures, key=lambda s: s.name)
 
 	for struct in structs:
 		properties: dict[str, model.Property] = {}
-		_get_extended_properties(struct, properties, [struct.name], spec)
+		_get_extended_properties(struct, properties, [struct.name], lookup or spec)
 
 		# Sort properties by their dict key (property name)
 		properties = {key: properties[key] for key in sorted(properties.keys())}
@@ -36,7 +39,7 @@ def generate_structs(
 				join(
 					[
 						lines_to_comments(struct.documentation),
-						f"type {struct.name} LSPObject",
+						f"type {struct.name} {type_resolver.qualify('LSPObject')}",
 					],
 				),
 			)
@@ -70,6 +73,8 @@ def generate_structs(
 				property_type,
 				property.name,
 				property.optional,
+				introduced_in(property.since),
+				property.proposed,
 			)
 
 			property_string = (
@@ -6,6 +6,14 @@ class LspTypeError(Exception):
 		super().__init__(f"Unsupported type: {message}")
 
 
+def is_null(lsp_type) -> bool:
+	"""
+	Reports whether a type is null itself, e.g. the result of a request that has none.
+	Types that are only nullable, such as Position | null, are not.
+	"""
+	return isinstance(lsp_type, model.BaseType) and lsp_type.name == "null"
+
+
 class TypeResolver:
 	def __init__(self, spec: model.LSPModel) -> None:
 		self.spec = spec
@@ -34,11 +42,19 @@ class TypeResolver:
 			and is_optional
 		)
 
+	def qualify(self, name: str) -> str:
+		"""
+		Returns how to refer to a type defined in the protocol package, e.g. Or2 or LSPObject.
+		"""
+		return name
+
 	def json_mapping(
 		self,
 		type: str,
 		field: str,
 		optional: bool | None = False,
+		since: str | None = None,
+		proposed: bool | None = False,
 	) -> str:
 		optional = optional or False
 
@@ -47,7 +63,15 @@ class TypeResolver:
 		if not optional:
 			omit_type = ""
 
-		return f'`json:"{field}{omit_type}"`'
+		tags = f'json:"{field}{omit_type}"'
+
+		# version tags let Features check and downgrade values for older clients
+		if since:
+			tags += f' since:"{since}"'
+		if proposed:
+			tags += ' proposed:"true"'
+
+		return f"`{tags}`"
 
 	def resolve(
 		self,
@@ -97,9 +121,9 @@ class TypeResolver:
 		if isinstance(lsp_type, model.ReferenceMapKeyType):
 			return f"{type_name}" if type_name else ""
 		if isinstance(lsp_type, model.LiteralType):
-			return "LSPObject"
+			return self.qualify("LSPObject")
 		if isinstance(lsp_type, model.TupleType):
-			return f"Tuple[{', '.join(self.resolve(field, is_optional) for field in lsp_type.items)}]"
+			return f"{self.qualify('Tuple')}[{', '.join(self.resolve(field, is_optional) for field in lsp_type.items)}]"
 		if isinstance(lsp_type, model.EnumValueType):
 			return self._resolve_base_type(lsp_type.name)
 		raise LspTypeError(lsp_type)
@@ -123,9 +147,9 @@ class TypeResolver:
 		self.max_or_length = max(self.max_or_length, len(types))
 
 		if pointer:
-			return f"NullableOr{len(types)}[{', '.join(types)}]"
+			return f"{self.qualify(f'NullableOr{len(types)}')}[{', '.join(types)}]"
 
-		return f"Or{len(types)}[{', '.join(types)}]"
+		return f"{self.qualify(f'Or{len(types)}')}[{', '.join(types)}]"
 
 	def _resolve_base_type(self, type: str):
 		match type.lower():
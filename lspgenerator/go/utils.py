@@ -31,6 +31,21 @@ def method_to_camel_case(value: str) -> str:
 	return "".join(capitalize(section) for section in value.split("/"))
 
 
+def introduced_in(since: str | None) -> str | None:
+	"""
+	Returns the version an item was introduced in from its since tag. Tags that describe a
+	later change to the item (e.g. "3.17.0 - support for relative patterns") return None.
+	"""
+	if not since:
+		return None
+
+	match = re.fullmatch(
+		r"(?:version )?(\d+\.\d+(?:\.\d+)?)\.?(?: - proposed)?",
+		since.strip(),
+	)
+	return match.group(1) if match else None
+
+
 def lines_to_comments(lines: str | None, pad: int = 0) -> str:
 	"""
 	Helper function to take a comment block and split it into properly indented string
@@ -13,6 +13,10 @@ import (
 	"github.com/magefile/mage/sh"
 )
 
+// Tells the go plugin to generate an extension package. Keep in sync with
+// EXTENSION_ENV in lspgenerator/go/extension.py.
+const extensionEnv = "LSPGENERATOR_GO_EXTENSION"
+
 // Default target to run when none is specified
 // If not set, running mage will list available targets
 // var Default = Build
@@ -49,7 +53,55 @@ func Generate() error {
 
 	fmt.Println("generating python files...")
 	cmd := exec.Command("uv", "run", "--directory", lspgendir, "python", "-m", "generator", "--plugin", "go", "--output-dir", protocol)
-	return cmd.Run()
+
+	if err := cmd.Run(); err != nil {
+		return err
+	}
+
+	fmt.Println("generating versioned packages...")
+
+	if err := sh.Run("go", "run", "./protocol/internal/versiongen", "-protocol", protocol); err != nil {
+		return err
+	}
+
+	fmt.Println("generating extension fixture...")
+	fixture := filepath.Join(protocol, "internal", "acme")
+
+	return generateExtension(lspgendir, filepath.Join(fixture, "acme.json"), fixture)
+}
+
+// Generates the types, extension methods and typed handlers of a custom
+// metaModel file into a separate package, e.g.
+// `mage generateExtension acme.json ./acme`. The package is named after the
+// output directory and reuses the protocol package's types.
+func GenerateExtension(model string, output string) error {
+	mg.Deps(Init)
+	lspgendir, err := lspgeneratorDir()
+
+	if err != nil {
+		return err
+	}
+
+	model, err = filepath.Abs(model)
+
+	if err != nil {
+		return err
+	}
+
+	output, err = filepath.Abs(output)
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("generating extension files...")
+	return generateExtension(lspgendir, model, output)
+}
+
+// Runs the go plugin in extension mode, which it is told through the
+// environment since the generator has no options for plugins.
+func generateExtension(lspgendir string, model string, output string) error {
+	return sh.RunWithV(map[string]string{extensionEnv: "1"}, "uv", "run", "--directory", lspgendir, "python", "-m", "generator", "--plugin", "go", "--model", model, "--output-dir", output)
 }
 
 func Test() error {
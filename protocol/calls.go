@@ -0,0 +1,500 @@
+package protocol
+import (
+	"context"
+)
+// Sends the callHierarchy/incomingCalls request and waits for its result.
+func (c *Conn) CallHierarchyIncomingCalls(ctx context.Context, params CallHierarchyIncomingCallsParams) (*[]CallHierarchyIncomingCall, error) {
+	var result *[]CallHierarchyIncomingCall
+	err := c.Call(ctx, CallHierarchyIncomingCallsMethod, params, &result)
+	return result, err
+}
+// Sends the callHierarchy/outgoingCalls request and waits for its result.
+func (c *Conn) CallHierarchyOutgoingCalls(ctx context.Context, params CallHierarchyOutgoingCallsParams) (*[]CallHierarchyOutgoingCall, error) {
+	var result *[]CallHierarchyOutgoingCall
+	err := c.Call(ctx, CallHierarchyOutgoingCallsMethod, params, &result)
+	return result, err
+}
+// Sends the client/registerCapability request and waits for its response.
+func (c *Conn) Registration(ctx context.Context, params RegistrationParams) error {
+	return c.Call(ctx, ClientRegisterCapabilityMethod, params, nil)
+}
+// Sends the client/unregisterCapability request and waits for its response.
+func (c *Conn) Unregistration(ctx context.Context, params UnregistrationParams) error {
+	return c.Call(ctx, ClientUnregisterCapabilityMethod, params, nil)
+}
+// Sends the codeAction/resolve request and waits for its result.
+func (c *Conn) CodeActionResolve(ctx context.Context, params CodeAction) (CodeAction, error) {
+	var result CodeAction
+	err := c.Call(ctx, CodeActionResolveMethod, params, &result)
+	return result, err
+}
+// Sends the codeLens/resolve request and waits for its result.
+func (c *Conn) CodeLensResolve(ctx context.Context, params CodeLens) (CodeLens, error) {
+	var result CodeLens
+	err := c.Call(ctx, CodeLensResolveMethod, params, &result)
+	return result, err
+}
+// Sends the completionItem/resolve request and waits for its result.
+func (c *Conn) CompletionResolve(ctx context.Context, params CompletionItem) (CompletionItem, error) {
+	var result CompletionItem
+	err := c.Call(ctx, CompletionItemResolveMethod, params, &result)
+	return result, err
+}
+// Sends the documentLink/resolve request and waits for its result.
+func (c *Conn) DocumentLinkResolve(ctx context.Context, params DocumentLink) (DocumentLink, error) {
+	var result DocumentLink
+	err := c.Call(ctx, DocumentLinkResolveMethod, params, &result)
+	return result, err
+}
+// Sends the initialize request and waits for its result.
+func (c *Conn) Initialize(ctx context.Context, params InitializeParams) (InitializeResult, error) {
+	var result InitializeResult
+	err := c.Call(ctx, InitializeMethod, params, &result)
+	return result, err
+}
+// Sends the inlayHint/resolve request and waits for its result.
+func (c *Conn) InlayHintResolve(ctx context.Context, params InlayHint) (InlayHint, error) {
+	var result InlayHint
+	err := c.Call(ctx, InlayHintResolveMethod, params, &result)
+	return result, err
+}
+// Sends the shutdown request and waits for its response.
+func (c *Conn) Shutdown(ctx context.Context) error {
+	return c.Call(ctx, ShutdownMethod, nil, nil)
+}
+// Sends the textDocument/codeAction request and waits for its result.
+func (c *Conn) CodeAction(ctx context.Context, params CodeActionParams) (*[]Or2[Command, CodeAction], error) {
+	var result *[]Or2[Command, CodeAction]
+	err := c.Call(ctx, TextDocumentCodeActionMethod, params, &result)
+	return result, err
+}
+// Sends the textDocument/codeLens request and waits for its result.
+func (c *Conn) CodeLens(ctx context.Context, params CodeLensParams) (*[]CodeLens, error) {
+	var result *[]CodeLens
+	err := c.Call(ctx, TextDocumentCodeLensMethod, params, &result)
+	return result, err
+}
+// Sends the textDocument/colorPresentation request and waits for its result.
+func (c *Conn) ColorPresentation(ctx context.Context, params ColorPresentationParams) ([]ColorPresentation, error) {
+	var result []ColorPresentation
+	err := c.Call(ctx, TextDocumentColorPresentationMethod, params, &result)
+	return result, err
+}
+// Sends the textDocument/completion request and waits for its result.
+func (c *Conn) Completion(ctx context.Context, params CompletionParams) (NullableOr2[[]CompletionItem, CompletionList], error) {
+	var result NullableOr2[[]CompletionItem, CompletionList]
+	err := c.Call(ctx, TextDocumentCompletionMethod, params, &result)
+	return result, err
+}
+// Sends the textDocument/declaration request and waits for its result.
+func (c *Conn) Declaration(ctx context.Context, params DeclarationParams) (NullableOr2[Declaration, []DeclarationLink], error) {
+	var result NullableOr2[Declaration, []DeclarationLink]
+	err := c.Call(ctx, TextDocumentDeclarationMethod, params, &result)
+	return result, err
+}
+// Sends the textDocument/definition request and waits for its result.
+func (c *Conn) Definition(ctx context.Context, params DefinitionParams) (NullableOr2[Definition, []DefinitionLink], error) {
+	var result NullableOr2[Definition, []DefinitionLink]
+	err := c.Call(ctx, TextDocumentDefinitionMethod, params, &result)
+	return result, err
+}
+// Sends the textDocument/diagnostic request and waits for its result.
+func (c *Conn) DocumentDiagnostic(ctx context.Context, params DocumentDiagnosticParams) (DocumentDiagnosticReport, error) {
+	var result DocumentDiagnosticReport
+	err := c.Call(ctx, TextDocumentDiagnosticMethod, params, &result)
+	return result, err
+}
+// Sends the textDocument/documentColor request and waits for its result.
+func (c *Conn) DocumentColor(ctx context.Context, params DocumentColorParams) ([]ColorInformation, error) {
+	var result []ColorInformation
+	err := c.Call(ctx, TextDocumentDocumentColorMethod, params, &result)
+	return result, err
+}
+// Sends the textDocument/documentHighlight request and waits for its result.
+func (c *Conn) DocumentHighlight(ctx context.Context, params DocumentHighlightParams) (*[]DocumentHighlight, error) {
+	var result *[]DocumentHighlight
+	err := c.Call(ctx, TextDocumentDocumentHighlightMethod, params, &result)
+	return result, err
+}
+// Sends the textDocument/documentLink request and waits for its result.
+func (c *Conn) DocumentLink(ctx context.Context, params DocumentLinkParams) (*[]DocumentLink, error) {
+	var result *[]DocumentLink
+	err := c.Call(ctx, TextDocumentDocumentLinkMethod, params, &result)
+	return result, err
+}
+// Sends the textDocument/documentSymbol request and waits for its result.
+func (c *Conn) DocumentSymbol(ctx context.Context, params DocumentSymbolParams) (NullableOr2[[]SymbolInformation, []DocumentSymbol], error) {
+	var result NullableOr2[[]SymbolInformation, []DocumentSymbol]
+	err := c.Call(ctx, TextDocumentDocumentSymbolMethod, params, &result)
+	return result, err
+}
+// Sends the textDocument/foldingRange request and waits for its result.
+func (c *Conn) FoldingRange(ctx context.Context, params FoldingRangeParams) (*[]FoldingRange, error) {
+	var result *[]FoldingRange
+	err := c.Call(ctx, TextDocumentFoldingRangeMethod, params, &result)
+	return result, err
+}
+// Sends the textDocument/formatting request and waits for its result.
+func (c *Conn) DocumentFormatting(ctx context.Context, params DocumentFormattingParams) (*[]TextEdit, error) {
+	var result *[]TextEdit
+	err := c.Call(ctx, TextDocumentFormattingMethod, params, &result)
+	return result, err
+}
+// Sends the textDocument/hover request and waits for its result.
+func (c *Conn) Hover(ctx context.Context, params HoverParams) (*Hover, error) {
+	var result *Hover
+	err := c.Call(ctx, TextDocumentHoverMethod, params, &result)
+	return result, err
+}
+// Sends the textDocument/implementation request and waits for its result.
+func (c *Conn) Implementation(ctx context.Context, params ImplementationParams) (NullableOr2[Definition, []DefinitionLink], error) {
+	var result NullableOr2[Definition, []DefinitionLink]
+	err := c.Call(ctx, TextDocumentImplementationMethod, params, &result)
+	return result, err
+}
+// Sends the textDocument/inlayHint request and waits for its result.
+func (c *Conn) InlayHint(ctx context.Context, params InlayHintParams) (*[]InlayHint, error) {
+	var result *[]InlayHint
+	err := c.Call(ctx, TextDocumentInlayHintMethod, params, &result)
+	return result, err
+}
+// Sends the textDocument/inlineCompletion request and waits for its result.
+func (c *Conn) InlineCompletion(ctx context.Context, params InlineCompletionParams) (NullableOr2[InlineCompletionList, []InlineCompletionItem], error) {
+	var result NullableOr2[InlineCompletionList, []InlineCompletionItem]
+	err := c.Call(ctx, TextDocumentInlineCompletionMethod, params, &result)
+	return result, err
+}
+// Sends the textDocument/inlineValue request and waits for its result.
+func (c *Conn) InlineValue(ctx context.Context, params InlineValueParams) (*[]InlineValue, error) {
+	var result *[]InlineValue
+	err := c.Call(ctx, TextDocumentInlineValueMethod, params, &result)
+	return result, err
+}
+// Sends the textDocument/linkedEditingRange request and waits for its result.
+func (c *Conn) LinkedEditingRange(ctx context.Context, params LinkedEditingRangeParams) (*LinkedEditingRanges, error) {
+	var result *LinkedEditingRanges
+	err := c.Call(ctx, TextDocumentLinkedEditingRangeMethod, params, &result)
+	return result, err
+}
+// Sends the textDocument/moniker request and waits for its result.
+func (c *Conn) Moniker(ctx context.Context, params MonikerParams) (*[]Moniker, error) {
+	var result *[]Moniker
+	err := c.Call(ctx, TextDocumentMonikerMethod, params, &result)
+	return result, err
+}
+// Sends the textDocument/onTypeFormatting request and waits for its result.
+func (c *Conn) DocumentOnTypeFormatting(ctx context.Context, params DocumentOnTypeFormattingParams) (*[]TextEdit, error) {
+	var result *[]TextEdit
+	err := c.Call(ctx, TextDocumentOnTypeFormattingMethod, params, &result)
+	return result, err
+}
+// Sends the textDocument/prepareCallHierarchy request and waits for its result.
+func (c *Conn) CallHierarchyPrepare(ctx context.Context, params CallHierarchyPrepareParams) (*[]CallHierarchyItem, error) {
+	var result *[]CallHierarchyItem
+	err := c.Call(ctx, TextDocumentPrepareCallHierarchyMethod, params, &result)
+	return result, err
+}
+// Sends the textDocument/prepareRename request and waits for its result.
+func (c *Conn) PrepareRename(ctx context.Context, params PrepareRenameParams) (*PrepareRenameResult, error) {
+	var result *PrepareRenameResult
+	err := c.Call(ctx, TextDocumentPrepareRenameMethod, params, &result)
+	return result, err
+}
+// Sends the textDocument/prepareTypeHierarchy request and waits for its result.
+func (c *Conn) TypeHierarchyPrepare(ctx context.Context, params TypeHierarchyPrepareParams) (*[]TypeHierarchyItem, error) {
+	var result *[]TypeHierarchyItem
+	err := c.Call(ctx, TextDocumentPrepareTypeHierarchyMethod, params, &result)
+	return result, err
+}
+// Sends the textDocument/rangeFormatting request and waits for its result.
+func (c *Conn) DocumentRangeFormatting(ctx context.Context, params DocumentRangeFormattingParams) (*[]TextEdit, error) {
+	var result *[]TextEdit
+	err := c.Call(ctx, TextDocumentRangeFormattingMethod, params, &result)
+	return result, err
+}
+// Sends the textDocument/rangesFormatting request and waits for its result.
+func (c *Conn) DocumentRangesFormatting(ctx context.Context, params DocumentRangesFormattingParams) (*[]TextEdit, error) {
+	var result *[]TextEdit
+	err := c.Call(ctx, TextDocumentRangesFormattingMethod, params, &result)
+	return result, err
+}
+// Sends the textDocument/references request and waits for its result.
+func (c *Conn) References(ctx context.Context, params ReferenceParams) (*[]Location, error) {
+	var result *[]Location
+	err := c.Call(ctx, TextDocumentReferencesMethod, params, &result)
+	return result, err
+}
+// Sends the textDocument/rename request and waits for its result.
+func (c *Conn) Rename(ctx context.Context, params RenameParams) (*WorkspaceEdit, error) {
+	var result *WorkspaceEdit
+	err := c.Call(ctx, TextDocumentRenameMethod, params, &result)
+	return result, err
+}
+// Sends the textDocument/selectionRange request and waits for its result.
+func (c *Conn) SelectionRange(ctx context.Context, params SelectionRangeParams) (*[]SelectionRange, error) {
+	var result *[]SelectionRange
+	err := c.Call(ctx, TextDocumentSelectionRangeMethod, params, &result)
+	return result, err
+}
+// Sends the textDocument/semanticTokens/full request and waits for its result.
+func (c *Conn) SemanticTokens(ctx context.Context, params SemanticTokensParams) (*SemanticTokens, error) {
+	var result *SemanticTokens
+	err := c.Call(ctx, TextDocumentSemanticTokensFullMethod, params, &result)
+	return result, err
+}
+// Sends the textDocument/semanticTokens/full/delta request and waits for its result.
+func (c *Conn) SemanticTokensDelta(ctx context.Context, params SemanticTokensDeltaParams) (NullableOr2[SemanticTokens, SemanticTokensDelta], error) {
+	var result NullableOr2[SemanticTokens, SemanticTokensDelta]
+	err := c.Call(ctx, TextDocumentSemanticTokensFullDeltaMethod, params, &result)
+	return result, err
+}
+// Sends the textDocument/semanticTokens/range request and waits for its result.
+func (c *Conn) SemanticTokensRange(ctx context.Context, params SemanticTokensRangeParams) (*SemanticTokens, error) {
+	var result *SemanticTokens
+	err := c.Call(ctx, TextDocumentSemanticTokensRangeMethod, params, &result)
+	return result, err
+}
+// Sends the textDocument/signatureHelp request and waits for its result.
+func (c *Conn) SignatureHelp(ctx context.Context, params SignatureHelpParams) (*SignatureHelp, error) {
+	var result *SignatureHelp
+	err := c.Call(ctx, TextDocumentSignatureHelpMethod, params, &result)
+	return result, err
+}
+// Sends the textDocument/typeDefinition request and waits for its result.
+func (c *Conn) TypeDefinition(ctx context.Context, params TypeDefinitionParams) (NullableOr2[Definition, []DefinitionLink], error) {
+	var result NullableOr2[Definition, []DefinitionLink]
+	err := c.Call(ctx, TextDocumentTypeDefinitionMethod, params, &result)
+	return result, err
+}
+// Sends the textDocument/willSaveWaitUntil request and waits for its result.
+func (c *Conn) WillSaveTextDocumentWaitUntil(ctx context.Context, params WillSaveTextDocumentParams) (*[]TextEdit, error) {
+	var result *[]TextEdit
+	err := c.Call(ctx, TextDocumentWillSaveWaitUntilMethod, params, &result)
+	return result, err
+}
+// Sends the typeHierarchy/subtypes request and waits for its result.
+func (c *Conn) TypeHierarchySubtypes(ctx context.Context, params TypeHierarchySubtypesParams) (*[]TypeHierarchyItem, error) {
+	var result *[]TypeHierarchyItem
+	err := c.Call(ctx, TypeHierarchySubtypesMethod, params, &result)
+	return result, err
+}
+// Sends the typeHierarchy/supertypes request and waits for its result.
+func (c *Conn) TypeHierarchySupertypes(ctx context.Context, params TypeHierarchySupertypesParams) (*[]TypeHierarchyItem, error) {
+	var result *[]TypeHierarchyItem
+	err := c.Call(ctx, TypeHierarchySupertypesMethod, params, &result)
+	return result, err
+}
+// Sends the window/showDocument request and waits for its result.
+func (c *Conn) ShowDocument(ctx context.Context, params ShowDocumentParams) (ShowDocumentResult, error) {
+	var result ShowDocumentResult
+	err := c.Call(ctx, WindowShowDocumentMethod, params, &result)
+	return result, err
+}
+// Sends the window/showMessageRequest request and waits for its result.
+func (c *Conn) ShowMessageRequest(ctx context.Context, params ShowMessageRequestParams) (*MessageActionItem, error) {
+	var result *MessageActionItem
+	err := c.Call(ctx, WindowShowMessageRequestMethod, params, &result)
+	return result, err
+}
+// Sends the window/workDoneProgress/create request and waits for its response.
+func (c *Conn) WorkDoneProgressCreate(ctx context.Context, params WorkDoneProgressCreateParams) error {
+	return c.Call(ctx, WindowWorkDoneProgressCreateMethod, params, nil)
+}
+// Sends the workspace/applyEdit request and waits for its result.
+func (c *Conn) ApplyWorkspaceEdit(ctx context.Context, params ApplyWorkspaceEditParams) (ApplyWorkspaceEditResult, error) {
+	var result ApplyWorkspaceEditResult
+	err := c.Call(ctx, WorkspaceApplyEditMethod, params, &result)
+	return result, err
+}
+// Sends the workspace/codeLens/refresh request and waits for its response.
+func (c *Conn) CodeLensRefresh(ctx context.Context) error {
+	return c.Call(ctx, WorkspaceCodeLensRefreshMethod, nil, nil)
+}
+// Sends the workspace/configuration request and waits for its result.
+func (c *Conn) Configuration(ctx context.Context, params ConfigurationParams) ([]any, error) {
+	var result []any
+	err := c.Call(ctx, WorkspaceConfigurationMethod, params, &result)
+	return result, err
+}
+// Sends the workspace/diagnostic request and waits for its result.
+func (c *Conn) WorkspaceDiagnostic(ctx context.Context, params WorkspaceDiagnosticParams) (WorkspaceDiagnosticReport, error) {
+	var result WorkspaceDiagnosticReport
+	err := c.Call(ctx, WorkspaceDiagnosticMethod, params, &result)
+	return result, err
+}
+// Sends the workspace/diagnostic/refresh request and waits for its response.
+func (c *Conn) DiagnosticRefresh(ctx context.Context) error {
+	return c.Call(ctx, WorkspaceDiagnosticRefreshMethod, nil, nil)
+}
+// Sends the workspace/executeCommand request and waits for its result.
+func (c *Conn) ExecuteCommand(ctx context.Context, params ExecuteCommandParams) (*any, error) {
+	var result *any
+	err := c.Call(ctx, WorkspaceExecuteCommandMethod, params, &result)
+	return result, err
+}
+// Sends the workspace/foldingRange/refresh request and waits for its response.
+func (c *Conn) FoldingRangeRefresh(ctx context.Context) error {
+	return c.Call(ctx, WorkspaceFoldingRangeRefreshMethod, nil, nil)
+}
+// Sends the workspace/inlayHint/refresh request and waits for its response.
+func (c *Conn) InlayHintRefresh(ctx context.Context) error {
+	return c.Call(ctx, WorkspaceInlayHintRefreshMethod, nil, nil)
+}
+// Sends the workspace/inlineValue/refresh request and waits for its response.
+func (c *Conn) InlineValueRefresh(ctx context.Context) error {
+	return c.Call(ctx, WorkspaceInlineValueRefreshMethod, nil, nil)
+}
+// Sends the workspace/semanticTokens/refresh request and waits for its response.
+func (c *Conn) SemanticTokensRefresh(ctx context.Context) error {
+	return c.Call(ctx, WorkspaceSemanticTokensRefreshMethod, nil, nil)
+}
+// Sends the workspace/symbol request and waits for its result.
+func (c *Conn) WorkspaceSymbol(ctx context.Context, params WorkspaceSymbolParams) (NullableOr2[[]SymbolInformation, []WorkspaceSymbol], error) {
+	var result NullableOr2[[]SymbolInformation, []WorkspaceSymbol]
+	err := c.Call(ctx, WorkspaceSymbolMethod, params, &result)
+	return result, err
+}
+// Sends the workspace/textDocumentContent request and waits for its result.
+func (c *Conn) TextDocumentContent(ctx context.Context, params TextDocumentContentParams) (TextDocumentContentResult, error) {
+	var result TextDocumentContentResult
+	err := c.Call(ctx, WorkspaceTextDocumentContentMethod, params, &result)
+	return result, err
+}
+// Sends the workspace/textDocumentContent/refresh request and waits for its response.
+func (c *Conn) TextDocumentContentRefresh(ctx context.Context, params TextDocumentContentRefreshParams) error {
+	return c.Call(ctx, WorkspaceTextDocumentContentRefreshMethod, params, nil)
+}
+// Sends the workspace/willCreateFiles request and waits for its result.
+func (c *Conn) WillCreateFiles(ctx context.Context, params CreateFilesParams) (*WorkspaceEdit, error) {
+	var result *WorkspaceEdit
+	err := c.Call(ctx, WorkspaceWillCreateFilesMethod, params, &result)
+	return result, err
+}
+// Sends the workspace/willDeleteFiles request and waits for its result.
+func (c *Conn) WillDeleteFiles(ctx context.Context, params DeleteFilesParams) (*WorkspaceEdit, error) {
+	var result *WorkspaceEdit
+	err := c.Call(ctx, WorkspaceWillDeleteFilesMethod, params, &result)
+	return result, err
+}
+// Sends the workspace/willRenameFiles request and waits for its result.
+func (c *Conn) WillRenameFiles(ctx context.Context, params RenameFilesParams) (*WorkspaceEdit, error) {
+	var result *WorkspaceEdit
+	err := c.Call(ctx, WorkspaceWillRenameFilesMethod, params, &result)
+	return result, err
+}
+// Sends the workspace/workspaceFolders request and waits for its result.
+func (c *Conn) WorkspaceFolders(ctx context.Context) (*[]WorkspaceFolder, error) {
+	var result *[]WorkspaceFolder
+	err := c.Call(ctx, WorkspaceWorkspaceFoldersMethod, nil, &result)
+	return result, err
+}
+// Sends the workspaceSymbol/resolve request and waits for its result.
+func (c *Conn) WorkspaceSymbolResolve(ctx context.Context, params WorkspaceSymbol) (WorkspaceSymbol, error) {
+	var result WorkspaceSymbol
+	err := c.Call(ctx, WorkspaceSymbolResolveMethod, params, &result)
+	return result, err
+}
+// Sends the $/cancelRequest notification.
+func (c *Conn) Cancel(ctx context.Context, params CancelParams) error {
+	return c.Notify(ctx, OptionalCancelRequestMethod, params)
+}
+// Sends the $/logTrace notification.
+func (c *Conn) LogTrace(ctx context.Context, params LogTraceParams) error {
+	return c.Notify(ctx, OptionalLogTraceMethod, params)
+}
+// Sends the $/progress notification.
+func (c *Conn) Progress(ctx context.Context, params ProgressParams) error {
+	return c.Notify(ctx, OptionalProgressMethod, params)
+}
+// Sends the $/setTrace notification.
+func (c *Conn) SetTrace(ctx context.Context, params SetTraceParams) error {
+	return c.Notify(ctx, OptionalSetTraceMethod, params)
+}
+// Sends the exit notification.
+func (c *Conn) Exit(ctx context.Context) error {
+	return c.Notify(ctx, ExitMethod, nil)
+}
+// Sends the initialized notification.
+func (c *Conn) Initialized(ctx context.Context, params InitializedParams) error {
+	return c.Notify(ctx, InitializedMethod, params)
+}
+// Sends the notebookDocument/didChange notification.
+func (c *Conn) DidChangeNotebookDocument(ctx context.Context, params DidChangeNotebookDocumentParams) error {
+	return c.Notify(ctx, NotebookDocumentDidChangeMethod, params)
+}
+// Sends the notebookDocument/didClose notification.
+func (c *Conn) DidCloseNotebookDocument(ctx context.Context, params DidCloseNotebookDocumentParams) error {
+	return c.Notify(ctx, NotebookDocumentDidCloseMethod, params)
+}
+// Sends the notebookDocument/didOpen notification.
+func (c *Conn) DidOpenNotebookDocument(ctx context.Context, params DidOpenNotebookDocumentParams) error {
+	return c.Notify(ctx, NotebookDocumentDidOpenMethod, params)
+}
+// Sends the notebookDocument/didSave notification.
+func (c *Conn) DidSaveNotebookDocument(ctx context.Context, params DidSaveNotebookDocumentParams) error {
+	return c.Notify(ctx, NotebookDocumentDidSaveMethod, params)
+}
+// Sends the telemetry/event notification.
+func (c *Conn) TelemetryEvent(ctx context.Context) error {
+	return c.Notify(ctx, TelemetryEventMethod, nil)
+}
+// Sends the textDocument/didChange notification.
+func (c *Conn) DidChangeTextDocument(ctx context.Context, params DidChangeTextDocumentParams) error {
+	return c.Notify(ctx, TextDocumentDidChangeMethod, params)
+}
+// Sends the textDocument/didClose notification.
+func (c *Conn) DidCloseTextDocument(ctx context.Context, params DidCloseTextDocumentParams) error {
+	return c.Notify(ctx, TextDocumentDidCloseMethod, params)
+}
+// Sends the textDocument/didOpen notification.
+func (c *Conn) DidOpenTextDocument(ctx context.Context, params DidOpenTextDocumentParams) error {
+	return c.Notify(ctx, TextDocumentDidOpenMethod, params)
+}
+// Sends the textDocument/didSave notification.
+func (c *Conn) DidSaveTextDocument(ctx context.Context, params DidSaveTextDocumentParams) error {
+	return c.Notify(ctx, TextDocumentDidSaveMethod, params)
+}
+// Sends the textDocument/publishDiagnostics notification.
+func (c *Conn) PublishDiagnostics(ctx context.Context, params PublishDiagnosticsParams) error {
+	return c.Notify(ctx, TextDocumentPublishDiagnosticsMethod, params)
+}
+// Sends the textDocument/willSave notification.
+func (c *Conn) WillSaveTextDocument(ctx context.Context, params WillSaveTextDocumentParams) error {
+	return c.Notify(ctx, TextDocumentWillSaveMethod, params)
+}
+// Sends the window/logMessage notification.
+func (c *Conn) LogMessage(ctx context.Context, params LogMessageParams) error {
+	return c.Notify(ctx, WindowLogMessageMethod, params)
+}
+// Sends the window/showMessage notification.
+func (c *Conn) ShowMessageNotification(ctx context.Context, params ShowMessageParams) error {
+	return c.Notify(ctx, WindowShowMessageMethod, params)
+}
+// Sends the window/workDoneProgress/cancel notification.
+func (c *Conn) WorkDoneProgressCancel(ctx context.Context, params WorkDoneProgressCancelParams) error {
+	return c.Notify(ctx, WindowWorkDoneProgressCancelMethod, params)
+}
+// Sends the workspace/didChangeConfiguration notification.
+func (c *Conn) DidChangeConfiguration(ctx context.Context, params DidChangeConfigurationParams) error {
+	return c.Notify(ctx, WorkspaceDidChangeConfigurationMethod, params)
+}
+// Sends the workspace/didChangeWatchedFiles notification.
+func (c *Conn) DidChangeWatchedFiles(ctx context.Context, params DidChangeWatchedFilesParams) error {
+	return c.Notify(ctx, WorkspaceDidChangeWatchedFilesMethod, params)
+}
+// Sends the workspace/didChangeWorkspaceFolders notification.
+func (c *Conn) DidChangeWorkspaceFolders(ctx context.Context, params DidChangeWorkspaceFoldersParams) error {
+	return c.Notify(ctx, WorkspaceDidChangeWorkspaceFoldersMethod, params)
+}
+// Sends the workspace/didCreateFiles notification.
+func (c *Conn) DidCreateFiles(ctx context.Context, params CreateFilesParams) error {
+	return c.Notify(ctx, WorkspaceDidCreateFilesMethod, params)
+}
+// Sends the workspace/didDeleteFiles notification.
+func (c *Conn) DidDeleteFiles(ctx context.Context, params DeleteFilesParams) error {
+	return c.Notify(ctx, WorkspaceDidDeleteFilesMethod, params)
+}
+// Sends the workspace/didRenameFiles notification.
+func (c *Conn) DidRenameFiles(ctx context.Context, params RenameFilesParams) error {
+	return c.Notify(ctx, WorkspaceDidRenameFilesMethod, params)
+}
\ No newline at end of file
@@ -0,0 +1,198 @@
+// Package client drives a language server from Go: it launches the server,
+// performs the initialize handshake, and exposes the typed requests of
+// protocol.Conn.
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"time"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+	"github.com/myleshyson/lsprotocol-go/protocol/transport"
+)
+
+// Options configures the initialize request and the handling of messages the
+// server sends to the client.
+type Options struct {
+	Capabilities          protocol.ClientCapabilities
+	ClientInfo            *protocol.ClientInfo
+	RootUri               *protocol.DocumentUri
+	WorkspaceFolders      []protocol.WorkspaceFolder
+	InitializationOptions any
+	Trace                 *protocol.TraceValue
+	// Handles requests and notifications sent by the server.
+	Handler protocol.Handler
+	// How long Close waits for the server process to exit before killing it.
+	// Defaults to five seconds.
+	ExitTimeout time.Duration
+}
+
+// Client is a connection to an initialized language server. Typed requests and
+// notifications are available through the embedded connection.
+type Client struct {
+	*protocol.Conn
+	cmd          *exec.Cmd
+	options      Options
+	result       protocol.InitializeResult
+	documents    *Documents
+	runErr       chan error
+	processState chan error
+}
+
+// Starts the server with cmd, talking to it over its stdin and stdout, and
+// performs the initialize handshake.
+func Start(ctx context.Context, cmd *exec.Cmd, options Options) (*Client, error) {
+	stdin, err := cmd.StdinPipe()
+
+	if err != nil {
+		return nil, err
+	}
+
+	// The read end of stdout is owned here rather than created with
+	// cmd.StdoutPipe, because Wait closes the pipes it created and could cut
+	// off the last messages the server wrote before the connection read them.
+	stdout, stdoutWriter, err := os.Pipe()
+
+	if err != nil {
+		stdin.Close()
+		return nil, err
+	}
+	cmd.Stdout = stdoutWriter
+
+	err = cmd.Start()
+	// The server has its own copy of the write end, so stdout ends when it exits.
+	stdoutWriter.Close()
+
+	if err != nil {
+		stdin.Close()
+		stdout.Close()
+		return nil, err
+	}
+
+	processState := make(chan error, 1)
+	go func() {
+		processState <- cmd.Wait()
+	}()
+
+	client, err := connect(ctx, transport.ReadWriteCloser(stdout, stdin), cmd, processState, options)
+
+	if err != nil {
+		cmd.Process.Kill()
+		<-processState
+		stdout.Close()
+		return nil, err
+	}
+
+	return client, nil
+}
+
+// Performs the initialize handshake with a server over an existing connection.
+func New(ctx context.Context, rwc io.ReadWriteCloser, options Options) (*Client, error) {
+	return connect(ctx, rwc, nil, nil, options)
+}
+
+func connect(ctx context.Context, rwc io.ReadWriteCloser, cmd *exec.Cmd, processState chan error, options Options) (*Client, error) {
+	if options.ExitTimeout <= 0 {
+		options.ExitTimeout = 5 * time.Second
+	}
+
+	client := &Client{
+		Conn:         protocol.NewConn(rwc, protocol.ConnOptions{Handler: options.Handler}),
+		cmd:          cmd,
+		options:      options,
+		runErr:       make(chan error, 1),
+		processState: processState,
+	}
+
+	go func() {
+		client.runErr <- client.Conn.Run(context.Background())
+	}()
+
+	processId := int32(os.Getpid())
+	params := protocol.InitializeParams{
+		Capabilities:          options.Capabilities,
+		ClientInfo:            options.ClientInfo,
+		InitializationOptions: options.InitializationOptions,
+		ProcessId:             &processId,
+		RootUri:               options.RootUri,
+		Trace:                 options.Trace,
+	}
+
+	if options.WorkspaceFolders != nil {
+		params.WorkspaceFolders = &options.WorkspaceFolders
+	}
+
+	result, err := client.Initialize(ctx, params)
+
+	if err != nil {
+		client.Conn.Close()
+		return nil, fmt.Errorf("initialize failed: %w", err)
+	}
+	client.result = result
+	client.documents = NewDocuments(client.Conn, result.Capabilities)
+
+	if err := client.Initialized(ctx, protocol.InitializedParams{}); err != nil {
+		client.Conn.Close()
+		return nil, err
+	}
+
+	return client, nil
+}
+
+// Returns the capabilities the server answered the initialize request with.
+func (c *Client) ServerCapabilities() protocol.ServerCapabilities {
+	return c.result.Capabilities
+}
+
+// Returns the synchronizer for the documents the client has open.
+func (c *Client) Documents() *Documents {
+	return c.documents
+}
+
+// Returns the server info the server answered the initialize request with, if any.
+func (c *Client) ServerInfo() *protocol.ServerInfo {
+	return c.result.ServerInfo
+}
+
+// Sends the shutdown request and exit notification, waits for the server
+// process to exit and for the connection to read the rest of its output, and
+// closes the connection. The process is killed if it does not exit within the
+// exit timeout.
+func (c *Client) Close(ctx context.Context) error {
+	shutdownErr := c.Shutdown(ctx)
+
+	if shutdownErr == nil {
+		shutdownErr = c.Exit(ctx)
+	}
+
+	if c.processState == nil {
+		c.Conn.Close()
+		<-c.runErr
+		return shutdownErr
+	}
+
+	var exitErr error
+
+	select {
+	case exitErr = <-c.processState:
+	case <-time.After(c.options.ExitTimeout):
+		c.cmd.Process.Kill()
+		exitErr = errors.Join(errors.New("server did not exit, killed it"), <-c.processState)
+	}
+
+	// Processes the server started may still hold its stdout open.
+	select {
+	case <-c.runErr:
+		c.Conn.Close()
+	case <-time.After(c.options.ExitTimeout):
+		c.Conn.Close()
+		<-c.runErr
+	}
+
+	return errors.Join(shutdownErr, exitErr)
+}
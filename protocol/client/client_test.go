@@ -0,0 +1,141 @@
+package client
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+	"github.com/myleshyson/lsprotocol-go/protocol/transport"
+)
+
+// When set, the test binary acts as a stand-in language server over stdio.
+const standInServerEnv = "LSPROTOCOL_CLIENT_STAND_IN_SERVER"
+
+// The number of messages the stand-in server logs before it exits.
+const exitLogMessages = 500
+
+func TestMain(m *testing.M) {
+	if os.Getenv(standInServerEnv) != "" {
+		os.Exit(runStandInServer())
+	}
+
+	os.Exit(m.Run())
+}
+
+func runStandInServer() int {
+	shutdown := false
+	exitCode := make(chan int, 1)
+	conn := protocol.NewConn(transport.Stdio(), protocol.ConnOptions{
+		Handler: func(ctx context.Context, message protocol.IncomingMessage) (any, error) {
+			switch message := message.(type) {
+			case protocol.InitializeRequest:
+				return protocol.InitializeResult{
+					Capabilities: protocol.ServerCapabilities{
+						HoverProvider: &protocol.Or2[bool, protocol.HoverOptions]{Value: true},
+					},
+					ServerInfo: &protocol.ServerInfo{Name: message.Params.ClientInfo.Name + "-server"},
+				}, nil
+			case protocol.HoverRequest:
+				return protocol.Hover{
+					Contents: protocol.Or3[protocol.MarkupContent, protocol.MarkedString, []protocol.MarkedString]{
+						Value: protocol.MarkupContent{Kind: protocol.MarkupKindMarkdown, Value: "hover"},
+					},
+				}, nil
+			case protocol.ShutdownRequest:
+				shutdown = true
+				return nil, nil
+			case protocol.ExitNotification:
+				// Logs right before exiting, which the client must still read.
+				for i := range exitLogMessages {
+					protocol.ConnFromContext(ctx).LogMessage(ctx, protocol.LogMessageParams{Type: protocol.MessageTypeLog, Message: strconv.Itoa(i) + strings.Repeat(" ", 4096)})
+				}
+				if shutdown {
+					exitCode <- 0
+				} else {
+					exitCode <- 1
+				}
+			}
+			return nil, nil
+		},
+	})
+
+	go conn.Run(context.Background())
+
+	return <-exitCode
+}
+
+func startStandInServer(t *testing.T, handler protocol.Handler) *Client {
+	cmd := exec.Command(os.Args[0])
+	cmd.Env = append(os.Environ(), standInServerEnv+"=1")
+	cmd.Stderr = os.Stderr
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client, err := Start(ctx, cmd, Options{
+		ClientInfo: &protocol.ClientInfo{Name: "test"},
+		Handler:    handler,
+		Capabilities: protocol.ClientCapabilities{
+			TextDocument: &protocol.TextDocumentClientCapabilities{
+				Hover: &protocol.HoverClientCapabilities{},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client
+}
+
+func TestHandshake(t *testing.T) {
+	client := startStandInServer(t, nil)
+
+	if client.ServerInfo() == nil || client.ServerInfo().Name != "test-server" {
+		t.Fatalf("Unexpected server info: %v", client.ServerInfo())
+	}
+
+	hoverProvider := client.ServerCapabilities().HoverProvider
+	if hoverProvider == nil || hoverProvider.Value != true {
+		t.Fatalf("Expected hover provider, got %v", hoverProvider)
+	}
+
+	hover, err := client.Hover(context.Background(), protocol.HoverParams{
+		TextDocument: protocol.TextDocumentIdentifier{Uri: "file:///a.go"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if content, ok := hover.Contents.Value.(protocol.MarkupContent); !ok || content.Value != "hover" {
+		t.Fatalf("Unexpected hover: %v", hover.Contents.Value)
+	}
+
+	if err := client.Close(context.Background()); err != nil {
+		t.Fatalf("Expected server to exit cleanly, got %s", err)
+	}
+}
+
+func TestCloseReadsOutputBeforeExit(t *testing.T) {
+	var logged atomic.Int32
+	client := startStandInServer(t, func(ctx context.Context, message protocol.IncomingMessage) (any, error) {
+		if _, ok := message.(protocol.LogMessageNotification); ok {
+			logged.Add(1)
+		}
+		return nil, nil
+	})
+
+	if err := client.Close(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if logged.Load() != exitLogMessages {
+		t.Fatalf("Expected the %d messages logged before exit, got %d", exitLogMessages, logged.Load())
+	}
+}
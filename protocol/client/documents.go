@@ -0,0 +1,301 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+)
+
+// Document is the client's copy of an open text document.
+type Document struct {
+	Uri        protocol.DocumentUri
+	LanguageId protocol.LanguageKind
+	Version    int32
+	Text       string
+}
+
+// Documents keeps the documents a client has open in sync with the server. It
+// sends didOpen, didChange, willSave, willSaveWaitUntil, didSave and didClose
+// as the server's TextDocumentSyncOptions ask for, and numbers the versions.
+type Documents struct {
+	conn     *protocol.Conn
+	options  protocol.TextDocumentSyncOptions
+	encoding protocol.PositionEncodingKind
+
+	mu   sync.Mutex
+	open map[protocol.DocumentUri]*Document
+}
+
+// Creates a synchronizer for a server that answered initialize with capabilities.
+func NewDocuments(conn *protocol.Conn, capabilities protocol.ServerCapabilities) *Documents {
+	documents := &Documents{
+		conn:     conn,
+		options:  syncOptions(capabilities),
+		encoding: protocol.PositionEncodingKindUTF16,
+		open:     map[protocol.DocumentUri]*Document{},
+	}
+
+	if capabilities.PositionEncoding != nil {
+		documents.encoding = *capabilities.PositionEncoding
+	}
+
+	return documents
+}
+
+// Normalizes the textDocumentSync capability, which can be a sync kind or options.
+// A sync kind other than None implies open, close and save notifications.
+func syncOptions(capabilities protocol.ServerCapabilities) protocol.TextDocumentSyncOptions {
+	if capabilities.TextDocumentSync == nil {
+		return protocol.TextDocumentSyncOptions{}
+	}
+
+	switch value := capabilities.TextDocumentSync.Value.(type) {
+	case protocol.TextDocumentSyncOptions:
+		return value
+	case protocol.TextDocumentSyncKind:
+		if value == protocol.TextDocumentSyncKindNone {
+			return protocol.TextDocumentSyncOptions{}
+		}
+		return protocol.TextDocumentSyncOptions{
+			OpenClose: true,
+			Change:    &value,
+			Save:      &protocol.Or2[bool, protocol.SaveOptions]{Value: true},
+		}
+	}
+
+	return protocol.TextDocumentSyncOptions{}
+}
+
+// Returns the change notifications the server asked for.
+func (d *Documents) SyncKind() protocol.TextDocumentSyncKind {
+	if d.options.Change == nil {
+		return protocol.TextDocumentSyncKindNone
+	}
+
+	return *d.options.Change
+}
+
+// Returns a copy of an open document.
+func (d *Documents) Get(uri protocol.DocumentUri) (Document, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	document, exists := d.open[uri]
+
+	if !exists {
+		return Document{}, false
+	}
+
+	return *document, true
+}
+
+// Opens a document at version 1.
+func (d *Documents) Open(ctx context.Context, uri protocol.DocumentUri, languageId protocol.LanguageKind, text string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, exists := d.open[uri]; exists {
+		return fmt.Errorf("document already open: %s", uri)
+	}
+
+	document := &Document{Uri: uri, LanguageId: languageId, Version: 1, Text: text}
+	d.open[uri] = document
+
+	if !d.options.OpenClose {
+		return nil
+	}
+
+	return d.conn.DidOpenTextDocument(ctx, protocol.DidOpenTextDocumentParams{
+		TextDocument: protocol.TextDocumentItem{
+			Uri:        uri,
+			LanguageId: languageId,
+			Version:    document.Version,
+			Text:       text,
+		},
+	})
+}
+
+// Applies local edits to an open document and sends them in the server's sync
+// kind. As in a WorkspaceEdit, all edits refer to the current text and must
+// not overlap.
+func (d *Documents) Edit(ctx context.Context, uri protocol.DocumentUri, edits []protocol.TextEdit) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	document, exists := d.open[uri]
+
+	if !exists {
+		return fmt.Errorf("document not open: %s", uri)
+	}
+
+	return d.edit(ctx, document, edits)
+}
+
+func (d *Documents) edit(ctx context.Context, document *Document, edits []protocol.TextEdit) error {
+	text, err := protocol.ApplyTextEdits(document.Text, edits, d.encoding)
+
+	if err != nil {
+		return err
+	}
+
+	var changes []protocol.TextDocumentContentChangeEvent
+
+	switch d.SyncKind() {
+	case protocol.TextDocumentSyncKindFull:
+		changes = []protocol.TextDocumentContentChangeEvent{
+			{Value: protocol.TextDocumentContentChangeWholeDocument{Text: text}},
+		}
+	case protocol.TextDocumentSyncKindIncremental:
+		changes = incrementalChanges(edits)
+	}
+
+	document.Text = text
+	document.Version++
+
+	if changes == nil {
+		return nil
+	}
+
+	return d.conn.DidChangeTextDocument(ctx, protocol.DidChangeTextDocumentParams{
+		TextDocument: protocol.VersionedTextDocumentIdentifier{
+			Uri:     document.Uri,
+			Version: document.Version,
+		},
+		ContentChanges: changes,
+	})
+}
+
+// Turns edits that all refer to the same text into change events, which each
+// apply to the result of the previous one. Applying the edits from the end of
+// the document to the start keeps the ranges of the remaining edits valid.
+func incrementalChanges(edits []protocol.TextEdit) []protocol.TextDocumentContentChangeEvent {
+	sorted := slices.Clone(edits)
+	slices.SortStableFunc(sorted, func(a, b protocol.TextEdit) int {
+		if a.Range.Start.Line != b.Range.Start.Line {
+			return int(a.Range.Start.Line) - int(b.Range.Start.Line)
+		}
+		return int(a.Range.Start.Character) - int(b.Range.Start.Character)
+	})
+	slices.Reverse(sorted)
+
+	changes := make([]protocol.TextDocumentContentChangeEvent, 0, len(sorted))
+
+	for _, edit := range sorted {
+		changes = append(changes, protocol.TextDocumentContentChangeEvent{
+			Value: protocol.TextDocumentContentChangePartial{Range: edit.Range, Text: edit.NewText},
+		})
+	}
+
+	return changes
+}
+
+// Saves an open document. Edits returned by willSaveWaitUntil are applied, and
+// sent as a change, before didSave. The documents aren't locked while waiting
+// for the server, so handlers can use them, and the edits fail to apply if the
+// document changed in the meantime.
+func (d *Documents) Save(ctx context.Context, uri protocol.DocumentUri, reason protocol.TextDocumentSaveReason) error {
+	document, exists := d.Get(uri)
+
+	if !exists {
+		return fmt.Errorf("document not open: %s", uri)
+	}
+
+	params := protocol.WillSaveTextDocumentParams{
+		TextDocument: protocol.TextDocumentIdentifier{Uri: uri},
+		Reason:       reason,
+	}
+
+	if d.options.WillSave {
+		if err := d.conn.WillSaveTextDocument(ctx, params); err != nil {
+			return err
+		}
+	}
+
+	if d.options.WillSaveWaitUntil {
+		edits, err := d.conn.WillSaveTextDocumentWaitUntil(ctx, params)
+
+		if err != nil {
+			return err
+		}
+
+		if edits != nil && len(*edits) > 0 {
+			if document, err = d.editVersion(ctx, uri, document.Version, *edits); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.options.Save == nil {
+		return nil
+	}
+
+	save := protocol.DidSaveTextDocumentParams{
+		TextDocument: protocol.TextDocumentIdentifier{Uri: uri},
+	}
+
+	switch value := d.options.Save.Value.(type) {
+	case bool:
+		if !value {
+			return nil
+		}
+	case protocol.SaveOptions:
+		if value.IncludeText {
+			// DidSaveTextDocumentParams omits empty text, which would read as
+			// not included.
+			return d.conn.Notify(ctx, protocol.TextDocumentDidSaveMethod, savedText{TextDocument: save.TextDocument, Text: document.Text})
+		}
+	}
+
+	return d.conn.DidSaveTextDocument(ctx, save)
+}
+
+// The params of didSave with text that is included even when empty.
+type savedText struct {
+	Text         string                          `json:"text"`
+	TextDocument protocol.TextDocumentIdentifier `json:"textDocument"`
+}
+
+// Applies edits to an open document if it is still at version, and returns a
+// copy of the edited document.
+func (d *Documents) editVersion(ctx context.Context, uri protocol.DocumentUri, version int32, edits []protocol.TextEdit) (Document, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	document, exists := d.open[uri]
+
+	if !exists {
+		return Document{}, fmt.Errorf("document closed while saving: %s", uri)
+	}
+
+	if document.Version != version {
+		return Document{}, fmt.Errorf("document changed while saving: %s", uri)
+	}
+
+	if err := d.edit(ctx, document, edits); err != nil {
+		return Document{}, err
+	}
+
+	return *document, nil
+}
+
+// Closes an open document.
+func (d *Documents) Close(ctx context.Context, uri protocol.DocumentUri) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, exists := d.open[uri]; !exists {
+		return fmt.Errorf("document not open: %s", uri)
+	}
+	delete(d.open, uri)
+
+	if !d.options.OpenClose {
+		return nil
+	}
+
+	return d.conn.DidCloseTextDocument(ctx, protocol.DidCloseTextDocumentParams{
+		TextDocument: protocol.TextDocumentIdentifier{Uri: uri},
+	})
+}
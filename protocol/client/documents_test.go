@@ -0,0 +1,304 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+)
+
+// Starts an in-process server answering initialize with capabilities, and
+// returns a client connected to it along with the messages the server received.
+func newInProcessClient(t *testing.T, capabilities protocol.ServerCapabilities, handler protocol.Handler) (*Client, chan protocol.IncomingMessage) {
+	clientSide, serverSide := net.Pipe()
+	received := make(chan protocol.IncomingMessage, 100)
+
+	server := protocol.NewConn(serverSide, protocol.ConnOptions{
+		Handler: func(ctx context.Context, message protocol.IncomingMessage) (any, error) {
+			switch message.(type) {
+			case protocol.InitializeRequest:
+				return protocol.InitializeResult{Capabilities: capabilities}, nil
+			case protocol.InitializedNotification:
+				return nil, nil
+			}
+			received <- message
+			if handler != nil {
+				return handler(ctx, message)
+			}
+			return nil, nil
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go server.Run(ctx)
+
+	client, err := New(context.Background(), clientSide, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client, received
+}
+
+func syncCapabilities(options protocol.TextDocumentSyncOptions) protocol.ServerCapabilities {
+	return protocol.ServerCapabilities{
+		TextDocumentSync: &protocol.Or2[protocol.TextDocumentSyncOptions, protocol.TextDocumentSyncKind]{Value: options},
+	}
+}
+
+func editAt(line, start, end uint32, text string) protocol.TextEdit {
+	return protocol.TextEdit{
+		Range: protocol.Range{
+			Start: protocol.Position{Line: line, Character: start},
+			End:   protocol.Position{Line: line, Character: end},
+		},
+		NewText: text,
+	}
+}
+
+func TestDocumentsIncremental(t *testing.T) {
+	incremental := protocol.TextDocumentSyncKindIncremental
+	client, received := newInProcessClient(t, syncCapabilities(protocol.TextDocumentSyncOptions{
+		OpenClose: true,
+		Change:    &incremental,
+	}), nil)
+	ctx := context.Background()
+	documents := client.Documents()
+
+	if err := documents.Open(ctx, "file:///a.go", protocol.LanguageKindGo, "hello world"); err != nil {
+		t.Fatal(err)
+	}
+
+	open := (<-received).(protocol.DidOpenTextDocumentNotification)
+	if open.Params.TextDocument.Version != 1 || open.Params.TextDocument.Text != "hello world" {
+		t.Fatalf("Unexpected didOpen: %v", open.Params)
+	}
+
+	if err := documents.Edit(ctx, "file:///a.go", []protocol.TextEdit{editAt(0, 0, 5, "goodbye"), editAt(0, 11, 11, "!")}); err != nil {
+		t.Fatal(err)
+	}
+
+	change := (<-received).(protocol.DidChangeTextDocumentNotification)
+	if change.Params.TextDocument.Version != 2 || len(change.Params.ContentChanges) != 2 {
+		t.Fatalf("Unexpected didChange: %v", change.Params)
+	}
+
+	// The server applying the changes in order must end up with the client's text.
+	text, err := protocol.ApplyContentChanges("hello world", change.Params.ContentChanges, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	document, _ := documents.Get("file:///a.go")
+	if text != "goodbye world!" || document.Text != text {
+		t.Fatalf("Expected both sides to have %q, got %q and %q", "goodbye world!", text, document.Text)
+	}
+
+	if err := documents.Close(ctx, "file:///a.go"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := (<-received).(protocol.DidCloseTextDocumentNotification); !ok {
+		t.Fatal("Expected didClose")
+	}
+}
+
+func TestDocumentsFullWithWillSaveWaitUntil(t *testing.T) {
+	full := protocol.TextDocumentSyncKindFull
+	client, received := newInProcessClient(t, protocol.ServerCapabilities{
+		TextDocumentSync: &protocol.Or2[protocol.TextDocumentSyncOptions, protocol.TextDocumentSyncKind]{Value: protocol.TextDocumentSyncOptions{
+			OpenClose:         true,
+			Change:            &full,
+			WillSave:          true,
+			WillSaveWaitUntil: true,
+			Save:              &protocol.Or2[bool, protocol.SaveOptions]{Value: protocol.SaveOptions{IncludeText: true}},
+		}},
+	}, func(ctx context.Context, message protocol.IncomingMessage) (any, error) {
+		if _, ok := message.(protocol.WillSaveTextDocumentWaitUntilRequest); ok {
+			return []protocol.TextEdit{editAt(0, 0, 0, "package a\n")}, nil
+		}
+		return nil, nil
+	})
+	ctx := context.Background()
+	documents := client.Documents()
+
+	if err := documents.Open(ctx, "file:///a.go", protocol.LanguageKindGo, "func main() {}"); err != nil {
+		t.Fatal(err)
+	}
+	<-received
+
+	if err := documents.Edit(ctx, "file:///a.go", []protocol.TextEdit{editAt(0, 5, 9, "run")}); err != nil {
+		t.Fatal(err)
+	}
+
+	change := (<-received).(protocol.DidChangeTextDocumentNotification)
+	whole, ok := change.Params.ContentChanges[0].Value.(protocol.TextDocumentContentChangeWholeDocument)
+	if !ok || whole.Text != "func run() {}" {
+		t.Fatalf("Expected full document change, got %v", change.Params.ContentChanges)
+	}
+
+	if err := documents.Save(ctx, "file:///a.go", protocol.TextDocumentSaveReasonManual); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := (<-received).(protocol.WillSaveTextDocumentNotification); !ok {
+		t.Fatal("Expected willSave")
+	}
+	if _, ok := (<-received).(protocol.WillSaveTextDocumentWaitUntilRequest); !ok {
+		t.Fatal("Expected willSaveWaitUntil")
+	}
+
+	change = (<-received).(protocol.DidChangeTextDocumentNotification)
+	if change.Params.TextDocument.Version != 3 {
+		t.Fatalf("Expected version 3 for the willSaveWaitUntil edits, got %d", change.Params.TextDocument.Version)
+	}
+
+	save := (<-received).(protocol.DidSaveTextDocumentNotification)
+	if save.Params.Text != "package a\nfunc run() {}" {
+		t.Fatalf("Expected didSave to include the edited text, got %q", save.Params.Text)
+	}
+}
+
+func TestDocumentsSyncKindNone(t *testing.T) {
+	client, received := newInProcessClient(t, protocol.ServerCapabilities{}, nil)
+	ctx := context.Background()
+	documents := client.Documents()
+
+	if err := documents.Open(ctx, "file:///a.go", protocol.LanguageKindGo, "a"); err != nil {
+		t.Fatal(err)
+	}
+	if err := documents.Edit(ctx, "file:///a.go", []protocol.TextEdit{editAt(0, 1, 1, "b")}); err != nil {
+		t.Fatal(err)
+	}
+	if err := documents.Save(ctx, "file:///a.go", protocol.TextDocumentSaveReasonManual); err != nil {
+		t.Fatal(err)
+	}
+
+	// Round trip a request, so any notification sent before it has arrived.
+	if _, err := client.Hover(ctx, protocol.HoverParams{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if message := <-received; message.GetMethod() != protocol.TextDocumentHoverMethod {
+		t.Fatalf("Expected no sync notifications, got %s", message.GetMethod())
+	}
+
+	if document, _ := documents.Get("file:///a.go"); document.Text != "ab" || document.Version != 2 {
+		t.Fatalf("Unexpected document: %v", document)
+	}
+}
+
+func TestDocumentsSaveUnlocked(t *testing.T) {
+	clientSide, serverSide := net.Pipe()
+
+	server := protocol.NewConn(serverSide, protocol.ConnOptions{
+		Handler: func(ctx context.Context, message protocol.IncomingMessage) (any, error) {
+			switch message.(type) {
+			case protocol.InitializeRequest:
+				return protocol.InitializeResult{Capabilities: syncCapabilities(protocol.TextDocumentSyncOptions{WillSaveWaitUntil: true})}, nil
+			case protocol.WillSaveTextDocumentWaitUntilRequest:
+				// The client's handler reads the document while the client waits.
+				if _, err := protocol.ConnFromContext(ctx).ShowMessageRequest(ctx, protocol.ShowMessageRequestParams{Type: protocol.MessageTypeInfo, Message: "saving"}); err != nil {
+					return nil, err
+				}
+				return []protocol.TextEdit{editAt(0, 0, 0, "package a\n")}, nil
+			}
+			return nil, nil
+		},
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+	go server.Run(ctx)
+
+	var client *Client
+	var read atomic.Bool
+
+	client, err := New(ctx, clientSide, Options{
+		Handler: func(ctx context.Context, message protocol.IncomingMessage) (any, error) {
+			if _, ok := message.(protocol.ShowMessageRequest); ok {
+				_, exists := client.Documents().Get("file:///a.go")
+				read.Store(exists)
+			}
+			return nil, nil
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.Documents().Open(ctx, "file:///a.go", protocol.LanguageKindGo, "func main() {}"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.Documents().Save(ctx, "file:///a.go", protocol.TextDocumentSaveReasonManual); err != nil {
+		t.Fatal(err)
+	}
+
+	if !read.Load() {
+		t.Fatal("Expected the handler to read the document during the save")
+	}
+	if document, _ := client.Documents().Get("file:///a.go"); document.Text != "package a\nfunc main() {}" || document.Version != 2 {
+		t.Fatalf("Expected the willSaveWaitUntil edits to be applied, got %+v", document)
+	}
+}
+
+func TestDocumentsSaveEmptyText(t *testing.T) {
+	clientSide, serverSide := net.Pipe()
+	var read bytes.Buffer
+	received := make(chan protocol.IncomingMessage, 10)
+
+	server := protocol.NewConn(recordingConn{Conn: serverSide, read: &read}, protocol.ConnOptions{
+		Handler: func(ctx context.Context, message protocol.IncomingMessage) (any, error) {
+			switch message.(type) {
+			case protocol.InitializeRequest:
+				return protocol.InitializeResult{Capabilities: syncCapabilities(protocol.TextDocumentSyncOptions{
+					Save: &protocol.Or2[bool, protocol.SaveOptions]{Value: protocol.SaveOptions{IncludeText: true}},
+				})}, nil
+			case protocol.DidSaveTextDocumentNotification:
+				received <- message
+			}
+			return nil, nil
+		},
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+	go server.Run(ctx)
+
+	client, err := New(ctx, clientSide, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.Documents().Open(ctx, "file:///a.go", protocol.LanguageKindGo, ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Documents().Save(ctx, "file:///a.go", protocol.TextDocumentSaveReasonManual); err != nil {
+		t.Fatal(err)
+	}
+
+	<-received
+
+	if !strings.Contains(read.String(), `"text":""`) {
+		t.Fatalf("Expected didSave to include the empty text, got %s", read.String())
+	}
+}
+
+// Keeps what is read from a connection.
+type recordingConn struct {
+	net.Conn
+	read *bytes.Buffer
+}
+
+func (c recordingConn) Read(p []byte) (int, error) {
+	n, err := c.Conn.Read(p)
+	c.read.Write(p[:n])
+	return n, err
+}
@@ -0,0 +1,431 @@
+// Package conformance checks streams of messages, live or recorded, for
+// violations of the protocol, such as requests sent before initialize
+// completed or results the client's capabilities don't allow.
+package conformance
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+	"github.com/myleshyson/lsprotocol-go/protocol/recording"
+)
+
+// Rule is a requirement of the protocol that a message violated.
+type Rule string
+
+const (
+	// A message was sent before the server answered initialize, other than the
+	// ones the protocol allows during initialize.
+	RuleNotInitialized Rule = "not-initialized"
+	// The client sent a message other than exit after shutdown.
+	RuleAfterShutdown Rule = "after-shutdown"
+	// A request reused the id of an earlier request sent in the same direction.
+	RuleDuplicateID Rule = "duplicate-id"
+	// A response answered a request that was never sent, or already answered.
+	RuleUnknownResponse Rule = "unknown-response"
+	// A didChange notification didn't increase the version of its document.
+	RuleVersionOrder Rule = "version-order"
+	// A document was opened twice, or changed or closed without being open.
+	RuleDocumentState Rule = "document-state"
+	// A message uses a feature the client's capabilities don't allow.
+	RuleCapability Rule = "capability"
+	// A message could not be decoded.
+	RuleInvalidMessage Rule = "invalid-message"
+)
+
+// Violation is a message that violated a rule of the protocol.
+type Violation struct {
+	// The index of the message in the stream, starting at 0.
+	Index     int
+	Direction recording.Direction
+	Method    protocol.MethodKind
+	Rule      Rule
+	Detail    string
+}
+
+func (v Violation) String() string {
+	return fmt.Sprintf("#%d %s %s: %s: %s", v.Index, v.Direction, v.Method, v.Rule, v.Detail)
+}
+
+// Report is the result of checking a stream of messages.
+type Report struct {
+	// The number of messages checked.
+	Messages   int
+	Violations []Violation
+}
+
+// Reports whether the stream had no violations.
+func (r Report) OK() bool {
+	return len(r.Violations) == 0
+}
+
+func (r Report) String() string {
+	lines := []string{fmt.Sprintf("%d messages, %d violations", r.Messages, len(r.Violations))}
+
+	for _, violation := range r.Violations {
+		lines = append(lines, violation.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Methods the server may send while it handles initialize.
+var duringInitialize = []protocol.MethodKind{
+	protocol.WindowShowMessageMethod,
+	protocol.WindowLogMessageMethod,
+	protocol.TelemetryEventMethod,
+	protocol.WindowShowMessageRequestMethod,
+	protocol.OptionalProgressMethod,
+}
+
+// Checker checks the messages of one session, in the order they were sent.
+// It can be fed typed messages with Check, recorded entries with CheckEntry,
+// or a Recorder's output, since it is an io.Writer of recording entries.
+type Checker struct {
+	mu sync.Mutex
+
+	messages     int
+	violations   []Violation
+	capabilities protocol.ClientCapabilities
+	initializing bool
+	initialized  bool
+	shutdown     bool
+	// The methods of requests waiting for a response, by direction and id.
+	pending map[string]protocol.MethodKind
+	// The ids of every request sent, by direction and id.
+	ids map[string]bool
+	// The versions of the open documents.
+	documents map[protocol.DocumentUri]int32
+	// Recorder output that doesn't end in a newline yet.
+	partial []byte
+}
+
+// Creates a checker for a new session.
+func NewChecker() *Checker {
+	return &Checker{
+		pending:   map[string]protocol.MethodKind{},
+		ids:       map[string]bool{},
+		documents: map[protocol.DocumentUri]int32{},
+	}
+}
+
+// Returns the report of the messages checked so far.
+func (c *Checker) Report() Report {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return Report{Messages: c.messages, Violations: slices.Clone(c.violations)}
+}
+
+// Checks a message sent in direction.
+func (c *Checker) Check(direction recording.Direction, message protocol.Message) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.check(direction, message)
+}
+
+// Decodes and checks a recorded message. Responses are decoded with the typed
+// response of the request they answer.
+func (c *Checker) CheckEntry(entry recording.Entry, extensions ...protocol.Extension) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.checkEntry(entry, extensions)
+}
+
+// Checks the recording entries in p, one per line, e.g. the output of a
+// recording.Recorder to check a session live.
+func (c *Checker) Write(p []byte) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.partial = append(c.partial, p...)
+
+	for {
+		line, rest, found := bytes.Cut(c.partial, []byte("\n"))
+
+		if !found {
+			break
+		}
+		c.partial = rest
+
+		var entry recording.Entry
+
+		if err := json.Unmarshal(line, &entry); err != nil {
+			return len(p), err
+		}
+
+		c.checkEntry(entry, nil)
+	}
+
+	return len(p), nil
+}
+
+func (c *Checker) checkEntry(entry recording.Entry, extensions []protocol.Extension) {
+	var envelope struct {
+		ID     json.RawMessage `json:"id"`
+		Method string          `json:"method"`
+	}
+
+	var message protocol.Message
+	err := json.Unmarshal(entry.Message, &envelope)
+
+	if err == nil && envelope.Method == "" {
+		method := c.pending[string(entry.Direction.Reverse())+compact(envelope.ID)]
+		message, err = protocol.DecodeResponse(method, entry.Message, extensions...)
+	} else if err == nil {
+		framed, _ := protocol.EncodeMessage(entry.Message)
+		message, err = protocol.DecodeMessage(framed, extensions...)
+	}
+
+	if err != nil {
+		c.messages++
+		c.violate(entry.Direction, protocol.MethodKind(envelope.Method), RuleInvalidMessage, err.Error())
+
+		// Keeps track of invalid requests, so their responses are still decoded.
+		if envelope.Method != "" && len(envelope.ID) > 0 && string(envelope.ID) != "null" {
+			c.pending[string(entry.Direction)+compact(envelope.ID)] = protocol.MethodKind(envelope.Method)
+		}
+		return
+	}
+
+	c.check(entry.Direction, message)
+}
+
+func (c *Checker) check(direction recording.Direction, message protocol.Message) {
+	c.messages++
+
+	if response, ok := message.(protocol.Response); ok {
+		c.checkResponse(direction, response)
+		return
+	}
+
+	incoming, ok := message.(protocol.IncomingMessage)
+
+	if !ok {
+		return
+	}
+
+	method := incoming.GetMethod()
+
+	if direction == recording.ClientToServer {
+		c.checkClientState(method)
+	} else if !c.initialized && !slices.Contains(duringInitialize, method) {
+		c.violate(direction, method, RuleNotInitialized, "sent before the initialize response")
+	}
+
+	if _, isRequest := message.(protocol.Request); isRequest {
+		if id, exists := messageID(message); exists {
+			key := string(direction) + id
+
+			if c.ids[key] {
+				c.violate(direction, method, RuleDuplicateID, "id "+id+" was already used")
+			}
+			c.ids[key] = true
+			c.pending[key] = method
+		}
+	}
+
+	c.checkMessage(direction, message)
+}
+
+// Checks the initialize and shutdown sequence of messages from the client.
+func (c *Checker) checkClientState(method protocol.MethodKind) {
+	switch {
+	case method == protocol.ExitMethod:
+	case c.shutdown:
+		c.violate(recording.ClientToServer, method, RuleAfterShutdown, "sent after shutdown")
+	case method == protocol.InitializeMethod:
+		if c.initializing || c.initialized {
+			c.violate(recording.ClientToServer, method, RuleNotInitialized, "initialize was already sent")
+		}
+		c.initializing = true
+	case !c.initialized && method != protocol.OptionalCancelRequestMethod:
+		c.violate(recording.ClientToServer, method, RuleNotInitialized, "sent before the initialize response")
+	case method == protocol.ShutdownMethod:
+		c.shutdown = true
+	}
+}
+
+func (c *Checker) checkResponse(direction recording.Direction, response protocol.Response) {
+	id, exists := messageID(response)
+
+	if !exists {
+		// Errors for messages whose id couldn't be read have a null id.
+		return
+	}
+
+	key := string(direction.Reverse()) + id
+	method, pending := c.pending[key]
+
+	if !pending {
+		c.violate(direction, "", RuleUnknownResponse, "no request with id "+id+" is waiting for a response")
+		return
+	}
+	delete(c.pending, key)
+
+	if method == protocol.InitializeMethod && direction == recording.ServerToClient {
+		c.initializing = false
+		c.initialized = true
+	}
+
+	c.checkResult(direction, method, response)
+}
+
+// Checks the notifications and requests that depend on the session's state.
+func (c *Checker) checkMessage(direction recording.Direction, message protocol.Message) {
+	switch message := message.(type) {
+	case protocol.InitializeRequest:
+		c.capabilities = message.Params.Capabilities
+	case protocol.DidOpenTextDocumentNotification:
+		uri := message.Params.TextDocument.Uri
+
+		if _, open := c.documents[uri]; open {
+			c.violate(direction, message.Method, RuleDocumentState, string(uri)+" is already open")
+		}
+		c.documents[uri] = message.Params.TextDocument.Version
+	case protocol.DidChangeTextDocumentNotification:
+		uri := message.Params.TextDocument.Uri
+		version, open := c.documents[uri]
+
+		if !open {
+			c.violate(direction, message.Method, RuleDocumentState, string(uri)+" is not open")
+		} else if message.Params.TextDocument.Version <= version {
+			c.violate(direction, message.Method, RuleVersionOrder, fmt.Sprintf("version %d of %s is not greater than %d", message.Params.TextDocument.Version, uri, version))
+		}
+		c.documents[uri] = message.Params.TextDocument.Version
+	case protocol.DidCloseTextDocumentNotification:
+		uri := message.Params.TextDocument.Uri
+
+		if _, open := c.documents[uri]; !open {
+			c.violate(direction, message.Method, RuleDocumentState, string(uri)+" is not open")
+		}
+		delete(c.documents, uri)
+	case protocol.ConfigurationRequest:
+		if workspace := c.capabilities.Workspace; workspace == nil || !workspace.Configuration {
+			c.violate(direction, message.Method, RuleCapability, "the client doesn't support workspace/configuration")
+		}
+	case protocol.ApplyWorkspaceEditRequest:
+		if workspace := c.capabilities.Workspace; workspace == nil || !workspace.ApplyEdit {
+			c.violate(direction, message.Method, RuleCapability, "the client doesn't support workspace/applyEdit")
+		}
+	}
+}
+
+// Checks that a result is allowed by the client's capabilities.
+func (c *Checker) checkResult(direction recording.Direction, method protocol.MethodKind, response protocol.Response) {
+	textDocument := c.capabilities.TextDocument
+
+	if textDocument == nil {
+		textDocument = &protocol.TextDocumentClientCapabilities{}
+	}
+
+	links := func(result any, linkSupport bool) {
+		if value := reflect.ValueOf(result); value.Kind() == reflect.Slice && value.Len() > 0 && !linkSupport {
+			c.violate(direction, method, RuleCapability, "LocationLink results require linkSupport")
+		}
+	}
+
+	switch response := response.(type) {
+	case protocol.DefinitionResponse:
+		links(response.Result.Value, textDocument.Definition != nil && textDocument.Definition.LinkSupport)
+	case protocol.DeclarationResponse:
+		if _, isLinks := response.Result.Value.([]protocol.DeclarationLink); isLinks {
+			links(response.Result.Value, textDocument.Declaration != nil && textDocument.Declaration.LinkSupport)
+		}
+	case protocol.TypeDefinitionResponse:
+		links(response.Result.Value, textDocument.TypeDefinition != nil && textDocument.TypeDefinition.LinkSupport)
+	case protocol.ImplementationResponse:
+		links(response.Result.Value, textDocument.Implementation != nil && textDocument.Implementation.LinkSupport)
+	case protocol.DocumentSymbolResponse:
+		symbols, hierarchical := response.Result.Value.([]protocol.DocumentSymbol)
+		if hierarchical && len(symbols) > 0 && (textDocument.DocumentSymbol == nil || !textDocument.DocumentSymbol.HierarchicalDocumentSymbolSupport) {
+			c.violate(direction, method, RuleCapability, "DocumentSymbol results require hierarchicalDocumentSymbolSupport")
+		}
+	case protocol.HoverResponse:
+		if response.Result == nil || textDocument.Hover == nil || len(textDocument.Hover.ContentFormat) == 0 {
+			return
+		}
+		if content, ok := response.Result.Contents.Value.(protocol.MarkupContent); ok && !slices.Contains(textDocument.Hover.ContentFormat, content.Kind) {
+			c.violate(direction, method, RuleCapability, fmt.Sprintf("hover content of kind %s is not in contentFormat", content.Kind))
+		}
+	}
+}
+
+func (c *Checker) violate(direction recording.Direction, method protocol.MethodKind, rule Rule, detail string) {
+	c.violations = append(c.violations, Violation{
+		Index:     c.messages - 1,
+		Direction: direction,
+		Method:    method,
+		Rule:      rule,
+		Detail:    detail,
+	})
+}
+
+// Returns the id of a request or response as json.
+func messageID(message any) (string, bool) {
+	value := reflect.ValueOf(message)
+
+	if value.Kind() != reflect.Struct {
+		return "", false
+	}
+
+	for _, name := range []string{"ID", "Id"} {
+		field := value.FieldByName(name)
+
+		if !field.IsValid() || field.Kind() != reflect.Struct {
+			continue
+		}
+
+		id := field.FieldByName("Value")
+
+		if !id.IsValid() || id.IsNil() {
+			return "", false
+		}
+
+		content, err := json.Marshal(id.Interface())
+
+		return string(content), err == nil
+	}
+
+	return "", false
+}
+
+// Normalizes a raw jsonrpc id, so ids can be compared regardless of whitespace.
+func compact(id json.RawMessage) string {
+	var buffer bytes.Buffer
+
+	if err := json.Compact(&buffer, id); err != nil {
+		return string(id)
+	}
+
+	return buffer.String()
+}
+
+// Helper function that checks the messages of a recording.
+func CheckEntries(entries []recording.Entry, extensions ...protocol.Extension) Report {
+	checker := NewChecker()
+
+	for _, entry := range entries {
+		checker.CheckEntry(entry, extensions...)
+	}
+
+	return checker.Report()
+}
+
+// Test helper that fails t with the report's violations, if there are any.
+func Require(t testing.TB, report Report) {
+	t.Helper()
+
+	if !report.OK() {
+		t.Fatalf("Expected the messages to conform to the protocol, got %s", report)
+	}
+}
@@ -0,0 +1,152 @@
+package conformance
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+	"github.com/myleshyson/lsprotocol-go/protocol/recording"
+)
+
+func rules(report Report) []Rule {
+	var found []Rule
+
+	for _, violation := range report.Violations {
+		found = append(found, violation.Rule)
+	}
+
+	return found
+}
+
+func initialize(checker *Checker, capabilities protocol.ClientCapabilities) {
+	checker.Check(recording.ClientToServer, protocol.InitializeRequest{
+		ID:     protocol.Or2[string, int32]{Value: int32(1)},
+		Method: protocol.InitializeMethod,
+		Params: protocol.InitializeParams{Capabilities: capabilities},
+	})
+	checker.Check(recording.ServerToClient, protocol.InitializeResponse{Id: protocol.Or2[int32, string]{Value: int32(1)}})
+	checker.Check(recording.ClientToServer, protocol.InitializedNotification{Method: protocol.InitializedMethod})
+}
+
+func didOpen(uri protocol.DocumentUri, version int32) protocol.DidOpenTextDocumentNotification {
+	return protocol.DidOpenTextDocumentNotification{
+		Method: protocol.TextDocumentDidOpenMethod,
+		Params: protocol.DidOpenTextDocumentParams{
+			TextDocument: protocol.TextDocumentItem{Uri: uri, Version: version},
+		},
+	}
+}
+
+func didChange(uri protocol.DocumentUri, version int32) protocol.DidChangeTextDocumentNotification {
+	return protocol.DidChangeTextDocumentNotification{
+		Method: protocol.TextDocumentDidChangeMethod,
+		Params: protocol.DidChangeTextDocumentParams{
+			TextDocument: protocol.VersionedTextDocumentIdentifier{Uri: uri, Version: version},
+		},
+	}
+}
+
+func TestCheckConformingSession(t *testing.T) {
+	checker := NewChecker()
+	initialize(checker, protocol.ClientCapabilities{})
+
+	checker.Check(recording.ClientToServer, didOpen("file:///a.go", 1))
+	checker.Check(recording.ClientToServer, didChange("file:///a.go", 2))
+	checker.Check(recording.ClientToServer, protocol.ShutdownRequest{ID: protocol.Or2[string, int32]{Value: int32(2)}, Method: protocol.ShutdownMethod})
+	checker.Check(recording.ServerToClient, protocol.ShutdownResponse{Id: protocol.Or2[int32, string]{Value: int32(2)}})
+	checker.Check(recording.ClientToServer, protocol.ExitNotification{Method: protocol.ExitMethod})
+
+	report := checker.Report()
+	Require(t, report)
+
+	if report.Messages != 8 {
+		t.Fatalf("Expected 8 messages, got %d", report.Messages)
+	}
+}
+
+func TestCheckViolations(t *testing.T) {
+	checker := NewChecker()
+
+	checker.Check(recording.ClientToServer, didOpen("file:///a.go", 1))
+	initialize(checker, protocol.ClientCapabilities{})
+	checker.Check(recording.ClientToServer, didOpen("file:///a.go", 1))
+	checker.Check(recording.ClientToServer, didChange("file:///a.go", 1))
+	checker.Check(recording.ClientToServer, didChange("file:///b.go", 1))
+	checker.Check(recording.ClientToServer, protocol.ShutdownRequest{ID: protocol.Or2[string, int32]{Value: int32(1)}, Method: protocol.ShutdownMethod})
+	checker.Check(recording.ServerToClient, protocol.HoverResponse{Id: protocol.Or2[int32, string]{Value: int32(7)}})
+	checker.Check(recording.ClientToServer, didChange("file:///a.go", 3))
+
+	report := checker.Report()
+	expected := []Rule{
+		RuleNotInitialized,
+		RuleDocumentState,
+		RuleVersionOrder,
+		RuleDocumentState,
+		RuleDuplicateID,
+		RuleUnknownResponse,
+		RuleAfterShutdown,
+	}
+
+	if !slices.Equal(rules(report), expected) {
+		t.Fatalf("Expected violations %v, got %s", expected, report)
+	}
+	if report.Violations[0].Index != 0 || report.Violations[2].Index != 5 || report.Violations[2].Method != protocol.TextDocumentDidChangeMethod {
+		t.Fatalf("Expected violations to point at their messages, got %s", report)
+	}
+}
+
+func TestCheckCapabilities(t *testing.T) {
+	checker := NewChecker()
+	initialize(checker, protocol.ClientCapabilities{
+		TextDocument: &protocol.TextDocumentClientCapabilities{
+			Hover: &protocol.HoverClientCapabilities{ContentFormat: []protocol.MarkupKind{protocol.MarkupKindPlainText}},
+		},
+	})
+
+	checker.Check(recording.ClientToServer, protocol.DefinitionRequest{ID: protocol.Or2[string, int32]{Value: "a"}, Method: protocol.TextDocumentDefinitionMethod})
+	checker.Check(recording.ServerToClient, protocol.DefinitionResponse{
+		Id:     protocol.Or2[int32, string]{Value: "a"},
+		Result: protocol.NullableOr2[protocol.Definition, []protocol.DefinitionLink]{Value: []protocol.DefinitionLink{{TargetUri: "file:///a.go"}}},
+	})
+	checker.Check(recording.ClientToServer, protocol.HoverRequest{ID: protocol.Or2[string, int32]{Value: "b"}, Method: protocol.TextDocumentHoverMethod})
+	checker.Check(recording.ServerToClient, protocol.HoverResponse{
+		Id: protocol.Or2[int32, string]{Value: "b"},
+		Result: &protocol.Hover{Contents: protocol.Or3[protocol.MarkupContent, protocol.MarkedString, []protocol.MarkedString]{
+			Value: protocol.MarkupContent{Kind: protocol.MarkupKindMarkdown},
+		}},
+	})
+	checker.Check(recording.ServerToClient, protocol.ConfigurationRequest{ID: protocol.Or2[string, int32]{Value: int32(1)}, Method: protocol.WorkspaceConfigurationMethod})
+
+	report := checker.Report()
+
+	if !slices.Equal(rules(report), []Rule{RuleCapability, RuleCapability, RuleCapability}) {
+		t.Fatalf("Expected 3 capability violations, got %s", report)
+	}
+	if !strings.Contains(report.Violations[0].Detail, "linkSupport") {
+		t.Fatalf("Expected the definition to violate linkSupport, got %s", report.Violations[0])
+	}
+}
+
+func TestCheckRecorderOutput(t *testing.T) {
+	checker := NewChecker()
+	lines := strings.Join([]string{
+		`{"time":"2024-01-01T00:00:00Z","direction":"client-to-server","message":{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"processId":null,"rootUri":null,"capabilities":{}}}}`,
+		`{"time":"2024-01-01T00:00:00Z","direction":"server-to-client","message":{"jsonrpc":"2.0","id":1,"result":{"capabilities":{}}}}`,
+		`{"time":"2024-01-01T00:00:00Z","direction":"client-to-server","message":{"jsonrpc":"2.0","id":2,"method":"textDocument/definition","params":{"textDocument":{"uri":"file:///a.go"},"position":{"line":0,"character":0}}}}`,
+		`{"time":"2024-01-01T00:00:00Z","direction":"server-to-client","message":{"jsonrpc":"2.0","id":2,"result":[{"targetUri":"file:///b.go","targetRange":{"start":{"line":0,"character":0},"end":{"line":0,"character":1}},"targetSelectionRange":{"start":{"line":0,"character":0},"end":{"line":0,"character":1}}}]}}`,
+	}, "\n") + "\n"
+
+	// Writes may split entries, so lines are buffered until complete.
+	for chunk := range slices.Chunk([]byte(lines), 50) {
+		if _, err := checker.Write(chunk); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	report := checker.Report()
+
+	if report.Messages != 4 || !slices.Equal(rules(report), []Rule{RuleCapability}) {
+		t.Fatalf("Expected the definition response to violate linkSupport, got %s", report)
+	}
+}
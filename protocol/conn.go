@@ -0,0 +1,533 @@
+package protocol
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"sync"
+	"sync/atomic"
+)
+
+// Returned by calls on a connection that has been closed.
+var ErrConnClosed = errors.New("connection closed")
+
+// Handler handles the requests and notifications received by a connection.
+// For requests, the returned result or error is sent back as the response.
+// For notifications, the result is ignored.
+//
+// Errors that are a ResponseError are sent as is. Any other error is sent
+// with ErrorCodesInternalError.
+type Handler func(ctx context.Context, message IncomingMessage) (any, error)
+
+// ConnOptions configures a Conn.
+type ConnOptions struct {
+	// Handles incoming requests and notifications. Requests are answered with
+	// ErrorCodesMethodNotFound when it is nil.
+	Handler Handler
+	// Wraps the Handler, with the first middleware being the outermost one.
+	Middleware []Middleware
+	// Wraps the requests and notifications sent with Call and Notify, with
+	// the first middleware being the outermost one.
+	OutgoingMiddleware []OutgoingMiddleware
+	// Decides which messages are handled in order and which concurrently.
+	Scheduler Scheduler
+	// Methods that are not part of the protocol, which incoming requests and
+	// notifications are decoded for.
+	Extensions []Extension
+	// Traces the messages sent and received, following $/setTrace.
+	Tracer *Tracer
+}
+
+// Conn is a jsonrpc connection that can be used from either side of the
+// protocol. It sends requests and notifications, matches responses to the
+// requests that were sent, and passes incoming requests and notifications to
+// its Handler as its Scheduler decides. By default, they are handled one at a
+// time, in the order they were received.
+//
+// $/cancelRequest notifications are handled by the connection, by cancelling
+// the context of the request they refer to.
+type Conn struct {
+	rwc        io.ReadWriteCloser
+	options    ConnOptions
+	extensions map[string]Extension
+	send       Sender
+
+	writeMu sync.Mutex
+	nextID  atomic.Int32
+
+	mu       sync.Mutex
+	pending  map[string]chan *wireMessage
+	inFlight map[string]*inFlightRequest
+	queue    []incoming
+	queued   chan struct{}
+	closing  bool
+
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// A request or notification waiting to be handled.
+type incoming struct {
+	id      json.RawMessage
+	message IncomingMessage
+}
+
+// A request that is being handled.
+type inFlightRequest struct {
+	cancel context.CancelCauseFunc
+	// The text document the request targets, if it is handled concurrently
+	// and should be cancelled once the document changes.
+	document DocumentUri
+}
+
+// The envelope of any message read from the connection.
+type wireMessage struct {
+	JsonRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method,omitempty"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *ResponseError  `json:"error,omitempty"`
+}
+
+type wireRequest struct {
+	JsonRPC string     `json:"jsonrpc"`
+	ID      any        `json:"id,omitempty"`
+	Method  MethodKind `json:"method"`
+	Params  any        `json:"params,omitempty"`
+}
+
+type wireResponse struct {
+	JsonRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *ResponseError  `json:"error,omitempty"`
+}
+
+type connContextKey struct{}
+
+// Creates a connection over rwc. Nothing is read until Run is called.
+func NewConn(rwc io.ReadWriteCloser, options ConnOptions) *Conn {
+	if options.Handler != nil {
+		options.Handler = Chain(options.Handler, options.Middleware...)
+	}
+
+	conn := &Conn{
+		rwc:        rwc,
+		options:    options,
+		extensions: extensionsByMethod(options.Extensions),
+		pending:    map[string]chan *wireMessage{},
+		inFlight:   map[string]*inFlightRequest{},
+		queued:     make(chan struct{}, 1),
+		done:       make(chan struct{}),
+	}
+	conn.send = ChainOutgoing(conn.sendMessage, options.OutgoingMiddleware...)
+
+	return conn
+}
+
+// Returns the connection that is handling the request or notification ctx was
+// passed to a Handler for.
+func ConnFromContext(ctx context.Context) *Conn {
+	conn, _ := ctx.Value(connContextKey{}).(*Conn)
+
+	return conn
+}
+
+// Reads and handles messages until the connection is closed, the other side
+// hangs up, or ctx is done. It returns nil when the connection ended normally.
+func (c *Conn) Run(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(context.WithValue(ctx, connContextKey{}, c))
+	defer cancel()
+
+	stop := context.AfterFunc(ctx, func() {
+		c.Close()
+	})
+	defer stop()
+
+	var dispatching sync.WaitGroup
+	dispatching.Add(1)
+	go func() {
+		defer dispatching.Done()
+		c.dispatch(ctx)
+	}()
+
+	scanner := NewScanner(c.rwc)
+
+	for scanner.Scan() {
+		c.read(ctx, scanner.Bytes())
+	}
+
+	err := scanner.Err()
+
+	c.mu.Lock()
+	closing := c.closing
+	c.mu.Unlock()
+
+	c.Close()
+	cancel()
+	dispatching.Wait()
+
+	if closing || errors.Is(err, io.EOF) {
+		return nil
+	}
+
+	return err
+}
+
+// Closes the connection. Pending calls fail with ErrConnClosed.
+func (c *Conn) Close() error {
+	var err error
+
+	c.closeOnce.Do(func() {
+		c.mu.Lock()
+		c.closing = true
+		c.mu.Unlock()
+
+		err = c.rwc.Close()
+		close(c.done)
+	})
+
+	return err
+}
+
+// Returns a channel that is closed once the connection is closed.
+func (c *Conn) Done() <-chan struct{} {
+	return c.done
+}
+
+// Sends a request and waits for its response. The result of the response is
+// unmarshalled into result, unless it is nil. An error response is returned
+// as a *ResponseError.
+//
+// When ctx is done before the response arrives, a $/cancelRequest notification
+// is sent for the request and ctx's error is returned.
+func (c *Conn) Call(ctx context.Context, method MethodKind, params any, result any) error {
+	return c.send(ctx, Outgoing{Method: method, Params: params, Request: true, Result: result})
+}
+
+// Sends a notification.
+func (c *Conn) Notify(ctx context.Context, method MethodKind, params any) error {
+	return c.send(ctx, Outgoing{Method: method, Params: params})
+}
+
+// Sends a message past the outgoing middleware.
+func (c *Conn) sendMessage(ctx context.Context, message Outgoing) error {
+	if !message.Request {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		return c.write(wireRequest{JsonRPC: "2.0", Method: message.Method, Params: message.Params})
+	}
+
+	id := c.nextID.Add(1)
+	key := strconv.Itoa(int(id))
+	responses := make(chan *wireMessage, 1)
+
+	c.mu.Lock()
+	if c.closing {
+		c.mu.Unlock()
+		return ErrConnClosed
+	}
+	c.pending[key] = responses
+	c.mu.Unlock()
+
+	defer func() {
+		c.mu.Lock()
+		delete(c.pending, key)
+		c.mu.Unlock()
+	}()
+
+	if err := c.write(wireRequest{JsonRPC: "2.0", ID: id, Method: message.Method, Params: message.Params}); err != nil {
+		return err
+	}
+
+	select {
+	case response := <-responses:
+		if response.Error != nil {
+			return response.Error
+		}
+		if message.Result == nil || len(response.Result) == 0 {
+			return nil
+		}
+		return json.Unmarshal(response.Result, message.Result)
+	case <-ctx.Done():
+		c.Notify(context.Background(), OptionalCancelRequestMethod, CancelParams{Id: Or2[int32, string]{Value: id}})
+		return ctx.Err()
+	case <-c.done:
+		return ErrConnClosed
+	}
+}
+
+func (c *Conn) write(message any) error {
+	if c.options.Tracer != nil {
+		c.options.Tracer.sending(c, message)
+	}
+
+	content, err := EncodeMessage(message)
+
+	if err != nil {
+		return err
+	}
+
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	select {
+	case <-c.done:
+		return ErrConnClosed
+	default:
+	}
+
+	_, err = c.rwc.Write(content)
+
+	return err
+}
+
+// Answers a message that could not be decoded. As jsonrpc requires, messages
+// whose id could not be read are answered with a null id, while notifications
+// with an unknown method or invalid params are not answered.
+func (c *Conn) replyDecodeError(err *DecodeError) error {
+	id := err.ID
+
+	if len(id) == 0 {
+		if err.Code != ErrorCodesParseError && err.Code != ErrorCodesInvalidRequest {
+			return nil
+		}
+		id = json.RawMessage("null")
+	}
+
+	return c.reply(id, nil, Error(int32(err.Code), err.Err))
+}
+
+func (c *Conn) reply(id json.RawMessage, result any, err error) error {
+	response := wireResponse{JsonRPC: "2.0", ID: id}
+
+	if err != nil {
+		response.Error = toResponseError(err)
+		return c.write(response)
+	}
+
+	content, err := json.Marshal(result)
+
+	if err != nil {
+		response.Error = toResponseError(err)
+		return c.write(response)
+	}
+	response.Result = content
+
+	return c.write(response)
+}
+
+// Handles a single framed message read from the connection.
+func (c *Conn) read(ctx context.Context, data []byte) {
+	_, contentLength, content, err := SplitMessage(data)
+
+	if err != nil {
+		c.replyDecodeError(&DecodeError{Code: ErrorCodesParseError, Err: err})
+		return
+	}
+	content = content[:contentLength]
+
+	wire, err := decodeEnvelope(content)
+
+	if err != nil {
+		c.replyDecodeError(err.(*DecodeError))
+		return
+	}
+
+	if c.options.Tracer != nil {
+		c.options.Tracer.received(c, wire)
+	}
+
+	if wire.Method == "" {
+		// The request is removed before its response is delivered, so a
+		// duplicate response can't block the reader on the full channel.
+		c.mu.Lock()
+		responses, exists := c.pending[idKey(wire.ID)]
+		delete(c.pending, idKey(wire.ID))
+		c.mu.Unlock()
+
+		if exists {
+			responses <- &wire
+		}
+		return
+	}
+
+	message, err := decodeIncoming(wire, content, c.extensions)
+
+	if err != nil {
+		c.replyDecodeError(err.(*DecodeError))
+		return
+	}
+
+	if cancel, ok := message.(CancelNotification); ok {
+		c.cancel(cancel.Params.Id)
+		return
+	}
+
+	c.mu.Lock()
+	c.queue = append(c.queue, incoming{id: wire.ID, message: message.(IncomingMessage)})
+	c.mu.Unlock()
+
+	select {
+	case c.queued <- struct{}{}:
+	default:
+	}
+}
+
+// Handles queued messages until ctx is done. Messages the scheduler handles in
+// order are handled right here. Others are handed to a worker, once one is
+// free, so they still start in order.
+func (c *Conn) dispatch(ctx context.Context) {
+	scheduler := c.options.Scheduler
+	workers := make(chan struct{}, max(scheduler.Workers, 1))
+	var running sync.WaitGroup
+	defer running.Wait()
+
+	for {
+		c.mu.Lock()
+		if len(c.queue) == 0 {
+			c.mu.Unlock()
+			select {
+			case <-ctx.Done():
+				return
+			case <-c.queued:
+				continue
+			}
+		}
+		next := c.queue[0]
+		c.queue = c.queue[1:]
+		c.mu.Unlock()
+
+		if scheduler.CancelOnChange {
+			if uri, changed := changedDocument(next.message); changed {
+				c.cancelDocument(uri)
+			}
+		}
+
+		if scheduler.serial(next.message) {
+			c.handle(ctx, next, false)
+			continue
+		}
+
+		select {
+		case workers <- struct{}{}:
+		case <-ctx.Done():
+			return
+		}
+
+		running.Add(1)
+		go func() {
+			defer running.Done()
+			defer func() { <-workers }()
+			c.handle(ctx, next, scheduler.cancels(next.message))
+		}()
+	}
+}
+
+func (c *Conn) handle(ctx context.Context, next incoming, cancelOnChange bool) {
+	if len(next.id) == 0 {
+		if c.options.Handler != nil {
+			c.options.Handler(ctx, next.message)
+		}
+		return
+	}
+
+	if c.options.Handler == nil {
+		c.reply(next.id, nil, Error(int32(ErrorCodesMethodNotFound), errors.New("method not found: "+string(next.message.GetMethod()))))
+		return
+	}
+
+	key := idKey(next.id)
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	request := &inFlightRequest{cancel: cancel}
+
+	if cancelOnChange {
+		request.document, _ = TargetDocument(next.message)
+	}
+
+	c.mu.Lock()
+	c.inFlight[key] = request
+	c.mu.Unlock()
+
+	defer func() {
+		c.mu.Lock()
+		delete(c.inFlight, key)
+		c.mu.Unlock()
+	}()
+
+	result, err := c.options.Handler(ctx, next.message)
+
+	if errors.Is(context.Cause(ctx), errContentModified) {
+		result, err = nil, Error(int32(LSPErrorCodesContentModified), errContentModified)
+	} else if err != nil && ctx.Err() != nil && toResponseError(err).Code == int32(ErrorCodesInternalError) {
+		err = Error(int32(LSPErrorCodesRequestCancelled), err)
+	}
+
+	c.reply(next.id, result, err)
+}
+
+func (c *Conn) cancel(id Or2[int32, string]) {
+	raw, err := json.Marshal(id)
+
+	if err != nil {
+		return
+	}
+
+	c.mu.Lock()
+	request, exists := c.inFlight[idKey(raw)]
+	c.mu.Unlock()
+
+	if exists {
+		request.cancel(nil)
+	}
+}
+
+// Cancels the in-flight requests that target a document that changed.
+func (c *Conn) cancelDocument(uri DocumentUri) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, request := range c.inFlight {
+		if request.document != "" && request.document == uri {
+			request.cancel(errContentModified)
+		}
+	}
+}
+
+// Normalizes a raw jsonrpc id, so ids can be compared regardless of whitespace.
+func idKey(id json.RawMessage) string {
+	var buffer bytes.Buffer
+
+	if err := json.Compact(&buffer, id); err != nil {
+		return string(id)
+	}
+
+	return buffer.String()
+}
+
+func toResponseError(err error) *ResponseError {
+	var pointer *ResponseError
+
+	if errors.As(err, &pointer) {
+		return pointer
+	}
+
+	var value ResponseError
+
+	if errors.As(err, &value) {
+		return &value
+	}
+
+	return &ResponseError{
+		Code:    int32(ErrorCodesInternalError),
+		Message: err.Error(),
+	}
+}
@@ -0,0 +1,269 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// Connects two Conns over an in-memory pipe, and runs them until the test ends.
+func connPair(t *testing.T, client ConnOptions, server ConnOptions) (*Conn, *Conn) {
+	clientSide, serverSide := net.Pipe()
+	clientConn := NewConn(clientSide, client)
+	serverConn := NewConn(serverSide, server)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	go clientConn.Run(ctx)
+	go serverConn.Run(ctx)
+
+	return clientConn, serverConn
+}
+
+func TestConnCall(t *testing.T) {
+	client, _ := connPair(t, ConnOptions{}, ConnOptions{
+		Handler: func(ctx context.Context, message IncomingMessage) (any, error) {
+			request, ok := message.(HoverRequest)
+			if !ok {
+				return nil, Error(int32(ErrorCodesMethodNotFound), errors.New("unexpected request"))
+			}
+			return Hover{
+				Contents: Or3[MarkupContent, MarkedString, []MarkedString]{
+					Value: MarkupContent{Kind: MarkupKindPlainText, Value: string(request.Params.TextDocument.Uri)},
+				},
+			}, nil
+		},
+	})
+
+	hover, err := client.Hover(context.Background(), HoverParams{
+		TextDocument: TextDocumentIdentifier{Uri: "file:///a.go"},
+		Position:     Position{Line: 1, Character: 2},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	content, ok := hover.Contents.Value.(MarkupContent)
+	if !ok || content.Value != "file:///a.go" {
+		t.Fatalf("Unexpected hover: %v", hover.Contents.Value)
+	}
+}
+
+func TestConnResponseError(t *testing.T) {
+	client, _ := connPair(t, ConnOptions{}, ConnOptions{
+		Handler: func(ctx context.Context, message IncomingMessage) (any, error) {
+			return nil, Error(int32(LSPErrorCodesRequestFailed), errors.New("failed"))
+		},
+	})
+
+	err := client.Shutdown(context.Background())
+
+	var responseError *ResponseError
+	if !errors.As(err, &responseError) || responseError.Code != int32(LSPErrorCodesRequestFailed) {
+		t.Fatalf("Expected RequestFailed response error, got %v", err)
+	}
+}
+
+func TestConnMethodNotFound(t *testing.T) {
+	client, _ := connPair(t, ConnOptions{}, ConnOptions{})
+
+	err := client.Call(context.Background(), "acme/unknown", nil, nil)
+
+	var responseError *ResponseError
+	if !errors.As(err, &responseError) || responseError.Code != int32(ErrorCodesMethodNotFound) {
+		t.Fatalf("Expected MethodNotFound response error, got %v", err)
+	}
+}
+
+func TestConnNotificationsInOrder(t *testing.T) {
+	received := make(chan int32, 10)
+
+	client, _ := connPair(t, ConnOptions{}, ConnOptions{
+		Handler: func(ctx context.Context, message IncomingMessage) (any, error) {
+			if change, ok := message.(DidChangeTextDocumentNotification); ok {
+				received <- change.Params.TextDocument.Version
+			}
+			return nil, nil
+		},
+	})
+
+	for version := range int32(10) {
+		err := client.DidChangeTextDocument(context.Background(), DidChangeTextDocumentParams{
+			TextDocument:   VersionedTextDocumentIdentifier{Uri: "file:///a.go", Version: version},
+			ContentChanges: []TextDocumentContentChangeEvent{},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for version := range int32(10) {
+		if got := <-received; got != version {
+			t.Fatalf("Expected version %d, got %d", version, got)
+		}
+	}
+}
+
+func TestConnCancel(t *testing.T) {
+	started := make(chan struct{})
+	cancelled := make(chan error, 1)
+
+	client, _ := connPair(t, ConnOptions{}, ConnOptions{
+		Handler: func(ctx context.Context, message IncomingMessage) (any, error) {
+			close(started)
+			<-ctx.Done()
+			cancelled <- ctx.Err()
+			return nil, ctx.Err()
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-started
+		cancel()
+	}()
+
+	if err := client.Shutdown(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context canceled, got %v", err)
+	}
+
+	select {
+	case err := <-cancelled:
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("Expected handler context to be cancelled, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected $/cancelRequest to cancel the handler")
+	}
+}
+
+func TestConnCallbackDuringRequest(t *testing.T) {
+	client, _ := connPair(t, ConnOptions{
+		Handler: func(ctx context.Context, message IncomingMessage) (any, error) {
+			return []any{"value"}, nil
+		},
+	}, ConnOptions{
+		Handler: func(ctx context.Context, message IncomingMessage) (any, error) {
+			// Ask the client for configuration while handling its request.
+			result, err := ConnFromContext(ctx).Configuration(ctx, ConfigurationParams{Items: []ConfigurationItem{}})
+			if err != nil {
+				return nil, err
+			}
+			return result[0], nil
+		},
+	})
+
+	var result string
+	if err := client.Call(context.Background(), WorkspaceExecuteCommandMethod, ExecuteCommandParams{Command: "x"}, &result); err != nil {
+		t.Fatal(err)
+	}
+
+	if result != "value" {
+		t.Fatalf("Expected value, got %s", result)
+	}
+}
+
+func TestConnClose(t *testing.T) {
+	started := make(chan struct{})
+	client, _ := connPair(t, ConnOptions{}, ConnOptions{
+		Handler: func(ctx context.Context, message IncomingMessage) (any, error) {
+			close(started)
+			<-ctx.Done()
+			return nil, nil
+		},
+	})
+
+	go func() {
+		<-started
+		client.Close()
+	}()
+
+	if err := client.Shutdown(context.Background()); !errors.Is(err, ErrConnClosed) {
+		t.Fatalf("Expected ErrConnClosed, got %v", err)
+	}
+
+	if err := client.Shutdown(context.Background()); !errors.Is(err, ErrConnClosed) {
+		t.Fatalf("Expected ErrConnClosed after close, got %v", err)
+	}
+}
+
+func TestConnAnswersInvalidMessages(t *testing.T) {
+	clientSide, serverSide := net.Pipe()
+	server := NewConn(serverSide, ConnOptions{
+		Handler: func(ctx context.Context, message IncomingMessage) (any, error) {
+			return nil, nil
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go server.Run(ctx)
+
+	scanner := NewScanner(clientSide)
+
+	cases := []struct {
+		content  string
+		expected string
+	}{
+		{`{"jsonrpc": "2.0", "id": 1, "method": `, `{"jsonrpc":"2.0","id":null,"error":{"code":-32700,"message":"invalid json"}}`},
+		{`{"jsonrpc": "2.0", "id": 1}`, `{"jsonrpc":"2.0","id":1,"error":{"code":-32600,"message":"missing method"}}`},
+		// Notifications with an unknown method are not answered.
+		{`{"jsonrpc": "2.0", "method": "acme/unknown"}`, ""},
+		{`{"jsonrpc": "2.0", "id": 2, "method": "acme/unknown"}`, `{"jsonrpc":"2.0","id":2,"error":{"code":-32601,"message":"method not found: acme/unknown"}}`},
+	}
+
+	for _, c := range cases {
+		message := "Content-Length: " + strconv.Itoa(len(c.content)) + "\r\n\r\n" + c.content
+		if _, err := clientSide.Write([]byte(message)); err != nil {
+			t.Fatal(err)
+		}
+		if c.expected == "" {
+			continue
+		}
+
+		if !scanner.Scan() {
+			t.Fatal(scanner.Err())
+		}
+		_, _, content, _ := SplitMessage(scanner.Bytes())
+		if string(content) != c.expected {
+			t.Fatalf("Expected %s, got %s", c.expected, content)
+		}
+	}
+}
+
+func TestConnDuplicateResponses(t *testing.T) {
+	clientSide, serverSide := net.Pipe()
+	client := NewConn(clientSide, ConnOptions{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go client.Run(ctx)
+
+	// Answers every request three times at once.
+	go func() {
+		scanner := NewScanner(serverSide)
+		for scanner.Scan() {
+			_, _, content, _ := SplitMessage(scanner.Bytes())
+			wire, err := decodeEnvelope(content)
+			if err != nil {
+				return
+			}
+			response, _ := EncodeMessage(wireResponse{JsonRPC: "2.0", ID: wire.ID, Result: []byte("null")})
+			serverSide.Write(append(append(response, response...), response...))
+		}
+	}()
+
+	for range 3 {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		err := client.Shutdown(ctx)
+		cancel()
+
+		if err != nil {
+			t.Fatalf("Expected duplicate responses to be ignored, got %s", err)
+		}
+	}
+}
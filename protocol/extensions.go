@@ -0,0 +1,212 @@
+package protocol
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Extension describes a method that is not part of the protocol, such as
+// rust-analyzer/expandMacro. Extensions are created with RegisterRequest and
+// RegisterNotification, and passed to the connections and decoders that should
+// know about them, so independent connections can support different methods.
+type Extension interface {
+	// Returns the method the extension describes.
+	ExtensionMethod() MethodKind
+	decode(data []byte) (Message, error)
+	decodeResponse(data []byte) (Message, error)
+}
+
+// ExtensionRequest is a request for an extension method.
+type ExtensionRequest[P any] struct {
+	JsonRPC string             `json:"jsonrpc"`
+	ID      Or2[string, int32] `json:"id"`
+	Method  MethodKind         `json:"method"`
+	Params  P                  `json:"params"`
+}
+
+func (t ExtensionRequest[P]) isMessage()            {}
+func (t ExtensionRequest[P]) isRequest()            {}
+func (t ExtensionRequest[P]) GetMethod() MethodKind { return t.Method }
+func (t ExtensionRequest[P]) GetParams() any        { return t.Params }
+
+// ExtensionNotification is a notification for an extension method.
+type ExtensionNotification[P any] struct {
+	JsonRPC string     `json:"jsonrpc"`
+	Method  MethodKind `json:"method"`
+	Params  P          `json:"params"`
+}
+
+func (t ExtensionNotification[P]) isMessage()            {}
+func (t ExtensionNotification[P]) isNotification()       {}
+func (t ExtensionNotification[P]) GetMethod() MethodKind { return t.Method }
+func (t ExtensionNotification[P]) GetParams() any        { return t.Params }
+
+// ExtensionResponse is the response to a request for an extension method.
+type ExtensionResponse[R any] struct {
+	JsonRPC string             `json:"jsonrpc"`
+	Id      Or2[int32, string] `json:"id"`
+	Result  R                  `json:"result,omitzero"`
+	Error   *ResponseError     `json:"error,omitzero"`
+}
+
+func (t ExtensionResponse[R]) isMessage()  {}
+func (t ExtensionResponse[R]) isResponse() {}
+
+// RequestMethod is an extension request method with params P and result R.
+type RequestMethod[P, R any] struct {
+	method MethodKind
+}
+
+// Creates an extension request method, whose requests are decoded as an
+// ExtensionRequest[P] and responses as an ExtensionResponse[R].
+func RegisterRequest[P, R any](method MethodKind) RequestMethod[P, R] {
+	return RequestMethod[P, R]{method: method}
+}
+
+func (m RequestMethod[P, R]) ExtensionMethod() MethodKind {
+	return m.method
+}
+
+// Sends a request for the method over conn and waits for its result.
+func (m RequestMethod[P, R]) Call(ctx context.Context, conn *Conn, params P) (R, error) {
+	var result R
+	err := conn.Call(ctx, m.method, params, &result)
+	return result, err
+}
+
+func (m RequestMethod[P, R]) decode(data []byte) (Message, error) {
+	var message ExtensionRequest[P]
+
+	if err := decodeExtension(data, &message, true); err != nil {
+		return nil, err
+	}
+
+	return message, nil
+}
+
+func (m RequestMethod[P, R]) decodeResponse(data []byte) (Message, error) {
+	var message ExtensionResponse[R]
+
+	if err := decodeExtensionResponse(data, &message); err != nil {
+		return nil, err
+	}
+
+	return message, nil
+}
+
+// NotificationMethod is an extension notification method with params P.
+type NotificationMethod[P any] struct {
+	method MethodKind
+}
+
+// Creates an extension notification method, whose notifications are decoded
+// as an ExtensionNotification[P].
+func RegisterNotification[P any](method MethodKind) NotificationMethod[P] {
+	return NotificationMethod[P]{method: method}
+}
+
+func (m NotificationMethod[P]) ExtensionMethod() MethodKind {
+	return m.method
+}
+
+// Sends a notification for the method over conn.
+func (m NotificationMethod[P]) Notify(ctx context.Context, conn *Conn, params P) error {
+	return conn.Notify(ctx, m.method, params)
+}
+
+func (m NotificationMethod[P]) decode(data []byte) (Message, error) {
+	var message ExtensionNotification[P]
+
+	if err := decodeExtension(data, &message, false); err != nil {
+		return nil, err
+	}
+
+	return message, nil
+}
+
+func (m NotificationMethod[P]) decodeResponse(data []byte) (Message, error) {
+	return nil, fmt.Errorf("%s is a notification and has no response", m.method)
+}
+
+// Decodes a request or notification with the checks of the generated
+// messages: the method and jsonrpc fields are required, an id is required if
+// and only if it is a request, and unknown fields are rejected.
+func decodeExtension(data []byte, message any, isRequest bool) error {
+	var fields map[string]json.RawMessage
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	if _, exists := fields["method"]; !exists {
+		return fmt.Errorf("Missing required request field: method")
+	}
+
+	if _, exists := fields["id"]; exists != isRequest {
+		if isRequest {
+			return fmt.Errorf("Missing required request field: id")
+		}
+		return fmt.Errorf("unexpected id in notification")
+	}
+
+	if _, exists := fields["jsonrpc"]; !exists {
+		return fmt.Errorf("Missing required request field: jsonrpc")
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	return decoder.Decode(message)
+}
+
+// Decodes a response with the checks of the generated responses, which
+// require the id and jsonrpc fields.
+func decodeExtensionResponse(data []byte, message any) error {
+	var fields map[string]json.RawMessage
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	_, idExists := fields["id"]
+	_, jsonrpcExists := fields["jsonrpc"]
+
+	if !idExists || !jsonrpcExists {
+		return fmt.Errorf("response must have an id and jsonrpc field")
+	}
+
+	return json.Unmarshal(data, message)
+}
+
+// Indexes extensions by method. Later extensions replace earlier ones for the
+// same method.
+func extensionsByMethod(extensions []Extension) map[string]Extension {
+	if len(extensions) == 0 {
+		return nil
+	}
+
+	methods := make(map[string]Extension, len(extensions))
+
+	for _, extension := range extensions {
+		methods[string(extension.ExtensionMethod())] = extension
+	}
+
+	return methods
+}
+
+// Helper function that decodes the content of a response to a request for
+// method. Methods that are not part of the protocol are decoded with the
+// matching extension.
+func DecodeResponse(method MethodKind, content []byte, extensions ...Extension) (Message, error) {
+	if decode, exists := ResponseRegistry[string(method)]; exists {
+		return decode(content)
+	}
+
+	if extension, exists := extensionsByMethod(extensions)[string(method)]; exists {
+		return extension.decodeResponse(content)
+	}
+
+	return nil, fmt.Errorf("method not found: %s", method)
+}
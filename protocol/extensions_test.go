@@ -0,0 +1,126 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+)
+
+type indexStatusParams struct {
+	Workspace string `json:"workspace"`
+}
+
+type indexStatus struct {
+	Indexed int `json:"indexed"`
+}
+
+var (
+	indexStatusMethod   = RegisterRequest[indexStatusParams, indexStatus]("acme/indexStatus")
+	indexProgressMethod = RegisterNotification[indexStatus]("acme/indexProgress")
+)
+
+func TestExtensionRequestAndNotification(t *testing.T) {
+	notifications := make(chan ExtensionNotification[indexStatus], 1)
+
+	client, _ := connPair(t, ConnOptions{}, ConnOptions{
+		Handler: func(ctx context.Context, message IncomingMessage) (any, error) {
+			switch message := message.(type) {
+			case ExtensionRequest[indexStatusParams]:
+				if message.Params.Workspace != "acme" {
+					return nil, errors.New("unexpected workspace")
+				}
+				return indexStatus{Indexed: 42}, nil
+			case ExtensionNotification[indexStatus]:
+				notifications <- message
+			}
+			return nil, nil
+		},
+		Extensions: []Extension{indexStatusMethod, indexProgressMethod},
+	})
+
+	status, err := indexStatusMethod.Call(context.Background(), client, indexStatusParams{Workspace: "acme"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Indexed != 42 {
+		t.Fatalf("Expected 42 indexed, got %d", status.Indexed)
+	}
+
+	if err := indexProgressMethod.Notify(context.Background(), client, indexStatus{Indexed: 7}); err != nil {
+		t.Fatal(err)
+	}
+	if notification := <-notifications; notification.Params.Indexed != 7 || notification.GetMethod() != "acme/indexProgress" {
+		t.Fatalf("Unexpected notification: %+v", notification)
+	}
+}
+
+func TestExtensionsArePerConnection(t *testing.T) {
+	client, _ := connPair(t, ConnOptions{}, ConnOptions{
+		Handler: func(ctx context.Context, message IncomingMessage) (any, error) {
+			return nil, nil
+		},
+	})
+
+	_, err := indexStatusMethod.Call(context.Background(), client, indexStatusParams{})
+
+	var responseError *ResponseError
+	if !errors.As(err, &responseError) || responseError.Code != int32(ErrorCodesMethodNotFound) {
+		t.Fatalf("Expected MethodNotFound without the extension, got %v", err)
+	}
+}
+
+func TestDecodeExtensionMessages(t *testing.T) {
+	content := `{"jsonrpc": "2.0", "id": 1, "method": "acme/indexStatus", "params": {"workspace": "acme"}}`
+	message := []byte("Content-Length: " + strconv.Itoa(len(content)) + "\r\n\r\n" + content)
+
+	if _, err := DecodeMessage(message); err == nil {
+		t.Fatal("Expected an unknown method without the extension")
+	}
+
+	decoded, err := DecodeMessage(message, indexStatusMethod)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if request, ok := decoded.(ExtensionRequest[indexStatusParams]); !ok || request.Params.Workspace != "acme" {
+		t.Fatalf("Unexpected message: %#v", decoded)
+	}
+
+	response, err := DecodeResponse("acme/indexStatus", []byte(`{"jsonrpc": "2.0", "id": 1, "result": {"indexed": 3}}`), indexStatusMethod)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.(ExtensionResponse[indexStatus]).Result.Indexed != 3 {
+		t.Fatalf("Unexpected response: %#v", response)
+	}
+
+	response, err = DecodeResponse(ShutdownMethod, []byte(`{"jsonrpc": "2.0", "id": 1, "result": null}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := response.(ShutdownResponse); !ok {
+		t.Fatalf("Expected a ShutdownResponse, got %T", response)
+	}
+}
+
+func TestDecodeExtensionMessagesStrictly(t *testing.T) {
+	for _, content := range []string{
+		`{"id": 1, "method": "acme/indexStatus", "params": {"workspace": "acme"}}`,
+		`{"jsonrpc": "2.0", "method": "acme/indexStatus", "params": {"workspace": "acme"}}`,
+		`{"jsonrpc": "2.0", "id": 1, "method": "acme/indexStatus", "params": {"workspace": "acme"}, "extra": true}`,
+		`{"jsonrpc": "2.0", "id": 1, "method": "acme/indexStatus", "params": {"workspace": "acme", "extra": true}}`,
+		`{"jsonrpc": "2.0", "id": 1, "method": "acme/indexProgress", "params": {"indexed": 1}}`,
+		`{"method": "acme/indexProgress", "params": {"indexed": 1}}`,
+		`{"jsonrpc": "2.0", "method": "acme/indexProgress", "params": {"indexed": 1}, "extra": true}`,
+	} {
+		message := []byte("Content-Length: " + strconv.Itoa(len(content)) + "\r\n\r\n" + content)
+
+		if decoded, err := DecodeMessage(message, indexStatusMethod, indexProgressMethod); err == nil {
+			t.Fatalf("Expected %s to fail to decode, got %#v", content, decoded)
+		}
+	}
+
+	if _, err := DecodeResponse("acme/indexStatus", []byte(`{"id": 1, "result": {"indexed": 3}}`), indexStatusMethod); err == nil {
+		t.Fatal("Expected a response without jsonrpc to fail to decode")
+	}
+}
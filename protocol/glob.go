@@ -0,0 +1,213 @@
+package protocol
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// Compiled glob patterns, keyed by pattern.
+var globCache sync.Map
+
+// Helper function that reports whether path matches a glob pattern. Patterns
+// use the protocol's glob syntax:
+//   - `*` to match zero or more characters in a path segment
+//   - `?` to match on one character in a path segment
+//   - `**` to match any number of path segments, including none
+//   - `{}` to group conditions (e.g. `**/*.{ts,js}`)
+//   - `[]` to declare a range of characters to match in a path segment
+//   - `[!...]` to negate a range of characters to match in a path segment
+func MatchPattern(pattern Pattern, path string) bool {
+	compiled, ok := globCache.Load(pattern)
+
+	if !ok {
+		expression, err := regexp.Compile(globToRegexp(string(pattern)))
+
+		if err != nil {
+			return false
+		}
+		compiled, _ = globCache.LoadOrStore(pattern, expression)
+	}
+
+	return compiled.(*regexp.Regexp).MatchString(path)
+}
+
+// Helper function that reports whether the path of uri matches a GlobPattern.
+// A RelativePattern only matches paths below its base uri, and is matched
+// against the part of the path that is relative to it.
+func MatchGlobPattern(pattern GlobPattern, uri string) bool {
+	path := uriPath(uri)
+
+	switch pattern := pattern.Value.(type) {
+	case Pattern:
+		return MatchPattern(pattern, path)
+	case RelativePattern:
+		var base string
+
+		switch baseUri := pattern.BaseUri.Value.(type) {
+		case WorkspaceFolder:
+			base = uriPath(string(baseUri.Uri))
+		case URI:
+			base = uriPath(string(baseUri))
+		}
+
+		base = strings.TrimSuffix(base, "/") + "/"
+		relative, found := strings.CutPrefix(path, base)
+
+		return found && MatchPattern(pattern.Pattern, relative)
+	}
+
+	return false
+}
+
+// Helper function that reports whether a notebook matches the notebook of a
+// notebook selector. A string matches the notebook type, with "*" matching
+// every notebook.
+func MatchNotebookFilter(filter Or2[string, NotebookDocumentFilter], notebookType string, uri URI) bool {
+	switch filter := filter.Value.(type) {
+	case string:
+		return filter == "*" || filter == notebookType
+	case NotebookDocumentFilter:
+		var filterType, scheme string
+		var pattern *GlobPattern
+
+		switch filter := filter.Value.(type) {
+		case NotebookDocumentFilterNotebookType:
+			filterType, scheme, pattern = filter.NotebookType, filter.Scheme, filter.Pattern
+		case NotebookDocumentFilterScheme:
+			filterType, scheme, pattern = filter.NotebookType, filter.Scheme, filter.Pattern
+		case NotebookDocumentFilterPattern:
+			filterType, scheme, pattern = filter.NotebookType, filter.Scheme, &filter.Pattern
+		default:
+			return false
+		}
+
+		if filterType != "" && filterType != "*" && filterType != notebookType {
+			return false
+		}
+
+		if scheme != "" {
+			parsed, err := url.Parse(string(uri))
+
+			if err != nil || parsed.Scheme != scheme {
+				return false
+			}
+		}
+
+		return pattern == nil || MatchGlobPattern(*pattern, string(uri))
+	}
+
+	return false
+}
+
+// Helper function that reports whether a notebook matches an entry of a
+// notebook selector. An entry that lists cells only matches notebooks with a
+// cell in one of its languages, with "*" matching every language. A missing
+// notebook filter matches every notebook.
+func MatchNotebookSelector(selector Or2[NotebookDocumentFilterWithNotebook, NotebookDocumentFilterWithCells], notebookType string, uri URI, cellLanguages []LanguageKind) bool {
+	var notebook *Or2[string, NotebookDocumentFilter]
+	var cells []NotebookCellLanguage
+
+	switch selector := selector.Value.(type) {
+	case NotebookDocumentFilterWithNotebook:
+		notebook, cells = &selector.Notebook, selector.Cells
+	case NotebookDocumentFilterWithCells:
+		notebook, cells = selector.Notebook, selector.Cells
+	default:
+		return false
+	}
+
+	if notebook != nil && !MatchNotebookFilter(*notebook, notebookType, uri) {
+		return false
+	}
+
+	if len(cells) == 0 {
+		return true
+	}
+
+	for _, cell := range cells {
+		for _, language := range cellLanguages {
+			if cell.Language == "*" || cell.Language == string(language) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Returns the path of a uri, or the uri itself if it can't be parsed.
+func uriPath(uri string) string {
+	parsed, err := url.Parse(uri)
+
+	if err != nil || parsed.Path == "" {
+		return uri
+	}
+
+	return parsed.Path
+}
+
+func globToRegexp(pattern string) string {
+	var builder strings.Builder
+	builder.WriteString("^")
+	braces := 0
+
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+
+		switch c {
+		case '*':
+			if strings.HasPrefix(pattern[i:], "**") {
+				i++
+				if strings.HasPrefix(pattern[i+1:], "/") {
+					// `**/` matches any number of leading segments, including none.
+					i++
+					builder.WriteString("(?:.*/)?")
+				} else {
+					builder.WriteString(".*")
+				}
+			} else {
+				builder.WriteString("[^/]*")
+			}
+		case '?':
+			builder.WriteString("[^/]")
+		case '{':
+			braces++
+			builder.WriteString("(?:")
+		case '}':
+			if braces > 0 {
+				braces--
+				builder.WriteString(")")
+			} else {
+				builder.WriteString(`\}`)
+			}
+		case ',':
+			if braces > 0 {
+				builder.WriteString("|")
+			} else {
+				builder.WriteString(",")
+			}
+		case '[':
+			end := strings.IndexByte(pattern[i+1:], ']')
+
+			if end < 0 {
+				builder.WriteString(`\[`)
+				continue
+			}
+			class := pattern[i+1 : i+1+end]
+
+			if negated, found := strings.CutPrefix(class, "!"); found {
+				class = "^" + negated
+			}
+			builder.WriteString("[" + strings.ReplaceAll(class, `\`, `\\`) + "]")
+			i += end + 1
+		default:
+			builder.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+
+	builder.WriteString("$")
+
+	return builder.String()
+}
@@ -0,0 +1,121 @@
+package protocol
+
+import (
+	"testing"
+)
+
+func TestMatchPattern(t *testing.T) {
+	cases := []struct {
+		pattern Pattern
+		path    string
+		matches bool
+	}{
+		{"*.ipynb", "a.ipynb", true},
+		{"*.ipynb", "dir/a.ipynb", false},
+		{"**/*.ipynb", "a.ipynb", true},
+		{"**/*.ipynb", "/home/user/dir/a.ipynb", true},
+		{"**/*.{ipynb,py}", "/dir/a.py", true},
+		{"**/*.{ipynb,py}", "/dir/a.go", false},
+		{"src/**", "src/a/b.go", true},
+		{"file?.go", "file1.go", true},
+		{"file?.go", "file12.go", false},
+		{"file[0-9].go", "file1.go", true},
+		{"file[!0-9].go", "file1.go", false},
+		{"file[!0-9].go", "fileA.go", true},
+		{"a.b", "aXb", false},
+	}
+
+	for _, c := range cases {
+		if got := MatchPattern(c.pattern, c.path); got != c.matches {
+			t.Fatalf("%s on %s: expected %v, got %v", c.pattern, c.path, c.matches, got)
+		}
+	}
+}
+
+func TestMatchGlobPattern(t *testing.T) {
+	relative := GlobPattern{Value: RelativePattern{
+		BaseUri: Or2[WorkspaceFolder, URI]{Value: URI("file:///workspace/")},
+		Pattern: "notebooks/*.ipynb",
+	}}
+
+	if !MatchGlobPattern(relative, "file:///workspace/notebooks/a.ipynb") {
+		t.Fatal("Expected the relative pattern to match")
+	}
+	if MatchGlobPattern(relative, "file:///other/notebooks/a.ipynb") {
+		t.Fatal("Expected the relative pattern not to match outside of its base uri")
+	}
+	if !MatchGlobPattern(GlobPattern{Value: Pattern("**/*.ipynb")}, "file:///workspace/a.ipynb") {
+		t.Fatal("Expected the pattern to match the path of the uri")
+	}
+}
+
+func TestMatchNotebookFilter(t *testing.T) {
+	uri := URI("file:///workspace/a.ipynb")
+
+	if !MatchNotebookFilter(Or2[string, NotebookDocumentFilter]{Value: "*"}, "jupyter-notebook", uri) {
+		t.Fatal("Expected * to match every notebook")
+	}
+	if MatchNotebookFilter(Or2[string, NotebookDocumentFilter]{Value: "other"}, "jupyter-notebook", uri) {
+		t.Fatal("Expected a different notebook type not to match")
+	}
+
+	scheme := Or2[string, NotebookDocumentFilter]{Value: NotebookDocumentFilter{Value: NotebookDocumentFilterScheme{Scheme: "untitled"}}}
+
+	if MatchNotebookFilter(scheme, "jupyter-notebook", uri) {
+		t.Fatal("Expected a different scheme not to match")
+	}
+
+	pattern := Or2[string, NotebookDocumentFilter]{Value: NotebookDocumentFilter{Value: NotebookDocumentFilterPattern{
+		NotebookType: "jupyter-notebook",
+		Pattern:      GlobPattern{Value: Pattern("**/*.ipynb")},
+	}}}
+
+	if !MatchNotebookFilter(pattern, "jupyter-notebook", uri) {
+		t.Fatal("Expected the pattern filter to match")
+	}
+	if MatchNotebookFilter(pattern, "interactive", uri) {
+		t.Fatal("Expected the pattern filter not to match another notebook type")
+	}
+}
+
+func TestMatchNotebookSelector(t *testing.T) {
+	uri := URI("file:///workspace/a.ipynb")
+	python := []LanguageKind{LanguageKindPython}
+
+	cells := Or2[NotebookDocumentFilterWithNotebook, NotebookDocumentFilterWithCells]{Value: NotebookDocumentFilterWithCells{
+		Cells: []NotebookCellLanguage{{Language: "python"}},
+	}}
+
+	if !MatchNotebookSelector(cells, "jupyter-notebook", uri, python) {
+		t.Fatal("Expected a notebook with a python cell to match")
+	}
+	if MatchNotebookSelector(cells, "jupyter-notebook", uri, []LanguageKind{LanguageKindMarkdown}) {
+		t.Fatal("Expected a notebook without a python cell not to match")
+	}
+	if MatchNotebookSelector(cells, "jupyter-notebook", uri, nil) {
+		t.Fatal("Expected a notebook without cells not to match")
+	}
+
+	wildcard := Or2[NotebookDocumentFilterWithNotebook, NotebookDocumentFilterWithCells]{Value: NotebookDocumentFilterWithCells{
+		Cells: []NotebookCellLanguage{{Language: "*"}},
+	}}
+
+	if !MatchNotebookSelector(wildcard, "jupyter-notebook", uri, []LanguageKind{LanguageKindMarkdown}) {
+		t.Fatal("Expected * to match a cell of any language")
+	}
+
+	notebook := Or2[NotebookDocumentFilterWithNotebook, NotebookDocumentFilterWithCells]{Value: NotebookDocumentFilterWithNotebook{
+		Notebook: Or2[string, NotebookDocumentFilter]{Value: "jupyter-notebook"},
+		Cells:    []NotebookCellLanguage{{Language: "python"}},
+	}}
+
+	if !MatchNotebookSelector(notebook, "jupyter-notebook", uri, python) {
+		t.Fatal("Expected the notebook and cell language to match")
+	}
+	if MatchNotebookSelector(notebook, "interactive", uri, python) {
+		t.Fatal("Expected another notebook type not to match")
+	}
+	if MatchNotebookSelector(notebook, "jupyter-notebook", uri, []LanguageKind{LanguageKindGo}) {
+		t.Fatal("Expected a notebook without a python cell not to match")
+	}
+}
@@ -0,0 +1,84 @@
+package acme
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+)
+
+func TestHandlers(t *testing.T) {
+	clientSide, serverSide := net.Pipe()
+	changed := make(chan IndexStatus, 1)
+	indexed := make(chan struct{}, 1)
+
+	client := protocol.NewConn(clientSide, protocol.ConnOptions{
+		Handler: func(ctx context.Context, message protocol.IncomingMessage) (any, error) {
+			return nil, nil
+		},
+		Extensions: Extensions,
+		Middleware: []protocol.Middleware{Handlers{
+			IndexStatusNotification: func(ctx context.Context, params IndexStatus) error {
+				changed <- params
+				return nil
+			},
+			Indexed: func(ctx context.Context) error {
+				indexed <- struct{}{}
+				return nil
+			},
+		}.Middleware()},
+	})
+	server := protocol.NewConn(serverSide, protocol.ConnOptions{
+		Handler: func(ctx context.Context, message protocol.IncomingMessage) (any, error) {
+			return nil, nil
+		},
+		Extensions: Extensions,
+		Middleware: []protocol.Middleware{Handlers{
+			IndexStatusRequest: func(ctx context.Context, params IndexStatusParams) (*IndexStatus, error) {
+				if params.TextDocument.Uri == "file:///unknown.go" {
+					return nil, nil
+				}
+				return &IndexStatus{Uri: params.TextDocument.Uri, State: IndexStateIndexing}, nil
+			},
+			Reindex: func(ctx context.Context) error {
+				return nil
+			},
+		}.Middleware()},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go client.Run(ctx)
+	go server.Run(ctx)
+
+	status, err := IndexStatusRequest.Call(ctx, client, IndexStatusParams{TextDocument: protocol.TextDocumentIdentifier{Uri: "file:///a.go"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status == nil || status.State != IndexStateIndexing {
+		t.Fatalf("Unexpected status: %+v", status)
+	}
+
+	status, err = IndexStatusRequest.Call(ctx, client, IndexStatusParams{TextDocument: protocol.TextDocumentIdentifier{Uri: "file:///unknown.go"}})
+	if err != nil || status != nil {
+		t.Fatalf("Expected a null result, got %+v %v", status, err)
+	}
+
+	if _, err := ReindexRequest.Call(ctx, client, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	progress := IndexProgress{Value: "half"}
+	if err := IndexStatusNotification.Notify(ctx, server, IndexStatus{Uri: "file:///a.go", State: IndexStateDone, Progress: &progress}); err != nil {
+		t.Fatal(err)
+	}
+	if params := <-changed; params.State != IndexStateDone || params.Progress == nil || params.Progress.Value != "half" {
+		t.Fatalf("Unexpected notification: %+v", params)
+	}
+
+	if err := IndexedNotification.Notify(ctx, server, nil); err != nil {
+		t.Fatal(err)
+	}
+	<-indexed
+}
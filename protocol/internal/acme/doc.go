@@ -0,0 +1,5 @@
+// Package acme is generated from acme.json by `mage generate`, as a fixture
+// of the extension generator. Its types and methods are checked by go build,
+// go vet and its tests, so changes to the generator that break extension
+// packages are caught.
+package acme
@@ -0,0 +1,67 @@
+package acme
+
+
+import (
+	"context"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+)
+const (
+	AcmeIndexStatusMethod protocol.MethodKind = "acme/indexStatus"
+	AcmeReindexMethod protocol.MethodKind = "acme/reindex"
+	AcmeIndexStatusChangedMethod protocol.MethodKind = "acme/indexStatus/changed"
+	AcmeIndexedMethod protocol.MethodKind = "acme/indexed"
+)
+// Returns how far indexing of a document got.
+var IndexStatusRequest = protocol.RegisterRequest[IndexStatusParams, *IndexStatus](AcmeIndexStatusMethod)
+
+// Starts indexing the workspace again.
+var ReindexRequest = protocol.RegisterRequest[any, any](AcmeReindexMethod)
+
+// Sent when indexing of a document progresses.
+var IndexStatusNotification = protocol.RegisterNotification[IndexStatus](AcmeIndexStatusChangedMethod)
+
+// Sent once the workspace is indexed.
+var IndexedNotification = protocol.RegisterNotification[any](AcmeIndexedMethod)
+
+// The extension's methods, to pass to protocol.ConnOptions.Extensions or protocol.DecodeMessage.
+var Extensions = []protocol.Extension{
+	IndexStatusRequest,
+	ReindexRequest,
+	IndexStatusNotification,
+	IndexedNotification,
+}
+// Handlers handles the extension's methods. Messages for methods without a handler
+// are passed on to the next handler.
+type Handlers struct {
+	IndexStatusRequest func(ctx context.Context, params IndexStatusParams) (*IndexStatus, error)
+	Reindex func(ctx context.Context) error
+	IndexStatusNotification func(ctx context.Context, params IndexStatus) error
+	Indexed func(ctx context.Context) error
+}
+// Returns middleware that handles the extension's methods with h.
+func (h Handlers) Middleware() protocol.Middleware {
+	return func(next protocol.Handler) protocol.Handler {
+		return func(ctx context.Context, message protocol.IncomingMessage) (any, error) {
+			switch message.GetMethod() {
+			case AcmeIndexStatusMethod:
+				if request, ok := message.(protocol.ExtensionRequest[IndexStatusParams]); ok && h.IndexStatusRequest != nil {
+					return h.IndexStatusRequest(ctx, request.Params)
+				}
+			case AcmeReindexMethod:
+				if _, ok := message.(protocol.ExtensionRequest[any]); ok && h.Reindex != nil {
+					return nil, h.Reindex(ctx)
+				}
+			case AcmeIndexStatusChangedMethod:
+				if notification, ok := message.(protocol.ExtensionNotification[IndexStatus]); ok && h.IndexStatusNotification != nil {
+					return nil, h.IndexStatusNotification(ctx, notification.Params)
+				}
+			case AcmeIndexedMethod:
+				if _, ok := message.(protocol.ExtensionNotification[any]); ok && h.Indexed != nil {
+					return nil, h.Indexed(ctx)
+				}
+			}
+			return next(ctx, message)
+		}
+	}
+}
\ No newline at end of file
@@ -0,0 +1,114 @@
+package acme
+
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+)
+var (
+	_ = bytes.NewReader
+	_ = json.Marshal
+	_ = fmt.Errorf
+	_ protocol.Message
+)
+
+type IndexProgress protocol.Or2[uint32, string]
+
+func (t *IndexProgress) UnmarshalJSON(x []byte) error {
+	return (*protocol.Or2[uint32, string])(t).UnmarshalJSON(x)
+}
+func (t IndexProgress) MarshalJSON() ([]byte, error) {
+	return protocol.Or2[uint32, string](t).MarshalJSON()
+}
+
+type IndexStatus struct {
+	// How much of the document is indexed, as a percentage or a label.
+	Progress *IndexProgress `json:"progress,omitzero"`
+
+	State IndexState `json:"state"`
+
+	Uri protocol.DocumentUri `json:"uri"`
+}
+func (t *IndexStatus) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["state"]; !exists {
+		return fmt.Errorf("missing required field: state")
+	}
+	if _, exists := m["uri"]; !exists {
+		return fmt.Errorf("missing required field: uri")
+	}
+	type Alias IndexStatus
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = IndexStatus(test)
+	
+	return nil
+}
+
+type IndexStatusParams struct {
+	// The document to report on.
+	TextDocument protocol.TextDocumentIdentifier `json:"textDocument"`
+}
+func (t *IndexStatusParams) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["textDocument"]; !exists {
+		return fmt.Errorf("missing required field: textDocument")
+	}
+	type Alias IndexStatusParams
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = IndexStatusParams(test)
+	
+	return nil
+}
+// The state of a document's index.
+type IndexState string
+const (
+	IndexStateDone IndexState = "done"
+	IndexStateIndexing IndexState = "indexing"
+	IndexStatePending IndexState = "pending"
+)
+func (t IndexState) validate() error {
+	switch t {
+	case "done","indexing","pending":
+	return nil
+	}
+	return fmt.Errorf("invalid IndexState: %v", t)
+}
+
+func (t *IndexState) UnmarshalJSON(x []byte) error {
+	var test string
+	if err := json.Unmarshal(x, &test); err != nil {
+		return err
+	}
+	kind := IndexState(test)
+	if err := kind.validate(); err != nil {
+		return err
+	}
+	*t = kind
+	return nil
+}
+
+func (t *IndexState) MarshalJSON() ([]byte, error) {
+	if err := t.validate(); err != nil {
+		return nil, err
+	}
+	return json.Marshal(*t)
+}
\ No newline at end of file
@@ -0,0 +1,606 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/types"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+)
+
+// A version of the protocol, and the types it has.
+type version struct {
+	*model
+	features protocol.Features
+	// The types the version has, by name.
+	has map[string]bool
+}
+
+// A field of a struct in a version.
+type field struct {
+	name     string
+	typeName string
+	tag      string
+	doc      []string
+	json     string
+	required bool
+}
+
+func newVersion(m *model, features protocol.Features) *version {
+	v := &version{model: m, features: features, has: map[string]bool{}}
+
+	for _, name := range m.order {
+		if m.types[name].message {
+			v.has[name] = features.SupportsMethod(protocol.MethodKind(m.methods[name]))
+			continue
+		}
+
+		typeVersion, exists := protocol.TypeVersions[name]
+		v.has[name] = !exists || features.Has(typeVersion.Since, typeVersion.Proposed)
+	}
+
+	// Unions without an alternative the version has, and structs with a
+	// required field of a type it doesn't have, don't exist either.
+	for changed := true; changed; {
+		changed = false
+
+		for _, name := range m.order {
+			if v.has[name] && !v.complete(m.types[name]) {
+				v.has[name] = false
+				changed = true
+			}
+		}
+	}
+
+	// Types that are only referred to by types the version doesn't have, such
+	// as the named literals of newer structures, are left out. TypeVersions
+	// doesn't date them, since older versions had them inline.
+	referred := map[string]bool{}
+
+	for _, name := range m.order {
+		for reference := range v.references(m.types[name], true) {
+			referred[reference] = true
+		}
+	}
+
+	reached := map[string]bool{}
+	var queue []string
+
+	for _, name := range m.order {
+		if v.has[name] && (m.types[name].message || !referred[name]) {
+			reached[name] = true
+			queue = append(queue, name)
+		}
+	}
+
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+
+		for reference := range v.references(m.types[name], false) {
+			if !reached[reference] {
+				reached[reference] = true
+				queue = append(queue, reference)
+			}
+		}
+	}
+
+	v.has = reached
+
+	return v
+}
+
+// Reports whether the version has everything a type it has needs.
+func (v *version) complete(decl *declaration) bool {
+	if decl.message {
+		return true
+	}
+
+	structType, ok := decl.expr.(*ast.StructType)
+
+	if !ok {
+		_, ok := v.resolve(decl.expr, nil)
+		return ok
+	}
+
+	for _, field := range structType.Fields.List {
+		since, proposed, required := fieldTags(field)
+
+		if !required || !v.features.Has(since, proposed) {
+			continue
+		}
+
+		if _, ok := v.resolve(field.Type, nil); !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Returns the types a type refers to in the version, or in any version.
+func (v *version) references(decl *declaration, anyVersion bool) map[string]bool {
+	references := map[string]bool{}
+	resolver := v
+
+	if anyVersion {
+		resolver = &version{model: v.model, features: protocol.Features{}, has: v.model.all()}
+	}
+
+	if structType, ok := decl.expr.(*ast.StructType); ok {
+		resolver.fields(structType, references)
+		return references
+	}
+
+	resolver.resolve(decl.expr, references)
+
+	return references
+}
+
+// Returns every type of the model.
+func (m *model) all() map[string]bool {
+	all := make(map[string]bool, len(m.order))
+
+	for _, name := range m.order {
+		all[name] = true
+	}
+
+	return all
+}
+
+// Returns the fields of a struct the version has, with their types in the
+// package of the version, and adds the types they refer to to references.
+func (v *version) fields(structType *ast.StructType, references map[string]bool) []field {
+	var fields []field
+
+	for _, astField := range structType.Fields.List {
+		since, proposed, required := fieldTags(astField)
+
+		if !v.features.Has(since, proposed) {
+			continue
+		}
+
+		typeName, ok := v.resolve(astField.Type, references)
+
+		if !ok {
+			continue
+		}
+
+		tag := astField.Tag.Value
+		name, _, _ := strings.Cut(reflect.StructTag(unquote(tag)).Get("json"), ",")
+
+		for _, fieldName := range astField.Names {
+			fields = append(fields, field{
+				name:     fieldName.Name,
+				typeName: typeName,
+				tag:      tag,
+				doc:      comments(astField.Doc),
+				json:     name,
+				required: required,
+			})
+		}
+	}
+
+	return fields
+}
+
+// Returns the version and whether a field is required, from its struct tags.
+func fieldTags(field *ast.Field) (string, bool, bool) {
+	if field.Tag == nil {
+		return "", false, true
+	}
+
+	tag := reflect.StructTag(unquote(field.Tag.Value))
+	_, options, _ := strings.Cut(tag.Get("json"), ",")
+	required := !slices.Contains(strings.Split(options, ","), "omitempty") && !slices.Contains(strings.Split(options, ","), "omitzero")
+
+	return tag.Get("since"), tag.Get("proposed") == "true", required
+}
+
+// Returns a type in the package of the version, or false when the version
+// doesn't have it. Unions leave out the alternatives the version doesn't have,
+// and a union with a single alternative left is that alternative. The types
+// it refers to are added to references.
+func (v *version) resolve(expr ast.Expr, references map[string]bool) (string, bool) {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		if _, declared := v.types[expr.Name]; !declared {
+			return expr.Name, true
+		}
+		if !v.has[expr.Name] {
+			return "", false
+		}
+		if references != nil {
+			references[expr.Name] = true
+		}
+		return expr.Name, true
+	case *ast.StarExpr:
+		element, ok := v.resolve(expr.X, references)
+		return "*" + element, ok
+	case *ast.ArrayType:
+		element, ok := v.resolve(expr.Elt, references)
+		return "[]" + element, ok && expr.Len == nil
+	case *ast.MapType:
+		key, keyOk := v.resolve(expr.Key, references)
+		value, valueOk := v.resolve(expr.Value, references)
+		return "map[" + key + "]" + value, keyOk && valueOk
+	case *ast.IndexExpr:
+		return v.resolveGeneric(types.ExprString(expr.X), []ast.Expr{expr.Index}, references)
+	case *ast.IndexListExpr:
+		return v.resolveGeneric(types.ExprString(expr.X), expr.Indices, references)
+	}
+
+	return types.ExprString(expr), true
+}
+
+func (v *version) resolveGeneric(name string, arguments []ast.Expr, references map[string]bool) (string, bool) {
+	var resolved []string
+	referenced := map[string]bool{}
+
+	for _, argument := range arguments {
+		alternative := map[string]bool{}
+		typeName, ok := v.resolve(argument, alternative)
+
+		if !ok {
+			if name == "Tuple" {
+				return "", false
+			}
+			continue
+		}
+
+		resolved = append(resolved, typeName)
+		for reference := range alternative {
+			referenced[reference] = true
+		}
+	}
+
+	if len(resolved) == 0 {
+		return "", false
+	}
+
+	if references != nil {
+		for reference := range referenced {
+			references[reference] = true
+		}
+	}
+
+	nullable := strings.HasPrefix(name, "NullableOr")
+
+	switch {
+	case name == "Tuple":
+		return "protocol.Tuple[" + strings.Join(resolved, ", ") + "]", true
+	case len(resolved) > 1 && nullable:
+		return fmt.Sprintf("protocol.NullableOr%d[%s]", len(resolved), strings.Join(resolved, ", ")), true
+	case len(resolved) > 1:
+		return fmt.Sprintf("protocol.Or%d[%s]", len(resolved), strings.Join(resolved, ", ")), true
+	case nullable && !nilable(resolved[0]):
+		return "*" + resolved[0], true
+	}
+
+	return resolved[0], true
+}
+
+// Reports whether a type can be null without a pointer.
+func nilable(typeName string) bool {
+	return typeName == "any" || strings.HasPrefix(typeName, "[]") || strings.HasPrefix(typeName, "map[") || strings.HasPrefix(typeName, "*")
+}
+
+// Returns the definition of a type in the version, to tell the types that
+// changed since it.
+func (v *version) definition(decl *declaration) string {
+	structType, ok := decl.expr.(*ast.StructType)
+
+	if !ok {
+		typeName, _ := v.resolve(decl.expr, nil)
+		return typeName
+	}
+
+	var definition strings.Builder
+
+	for _, field := range v.fields(structType, nil) {
+		fmt.Fprintf(&definition, "%s %s %s\n", field.name, field.typeName, field.tag)
+	}
+
+	return definition.String()
+}
+
+// Returns the types of the version that are different from the latest ones,
+// because they or the types they refer to changed.
+func (v *version) changed(latest *version) map[string]bool {
+	changed := map[string]bool{}
+	references := map[string]map[string]bool{}
+
+	for _, name := range v.order {
+		if decl := v.types[name]; v.has[name] && !decl.message {
+			changed[name] = v.definition(decl) != latest.definition(decl)
+			references[name] = v.references(decl, false)
+		}
+	}
+
+	for propagated := true; propagated; {
+		propagated = false
+
+		for name, referenced := range references {
+			if changed[name] {
+				continue
+			}
+
+			for reference := range referenced {
+				if changed[reference] {
+					changed[name] = true
+					propagated = true
+					break
+				}
+			}
+		}
+	}
+
+	return changed
+}
+
+// Generates the files of the package of a version.
+func generate(m *model, target protocol.Version) (map[string][]byte, error) {
+	v := newVersion(m, protocol.Features{Version: target})
+	changed := v.changed(newVersion(m, protocol.Features{}))
+
+	files := map[string]string{
+		"types.go":   v.typesFile(changed),
+		"convert.go": v.convertFile(changed),
+		"version.go": v.versionFile(),
+	}
+
+	formatted := make(map[string][]byte, len(files))
+
+	for name, content := range files {
+		source, err := format.Source([]byte(content))
+
+		if err != nil {
+			return nil, fmt.Errorf("formatting %s of %s: %w", name, target, err)
+		}
+
+		formatted[name] = source
+	}
+
+	return formatted, nil
+}
+
+// Returns the constants of the enumerations the version has, by type. Methods
+// are left out when the version doesn't have them, while the values of other
+// enumerations aren't dated by the metaModel and are all kept.
+func (v *version) constants() map[string][]*constant {
+	constants := map[string][]*constant{}
+
+	for _, constant := range v.model.constants {
+		if !v.has[constant.typeName] {
+			continue
+		}
+
+		if constant.typeName == "MethodKind" && !v.features.SupportsMethod(protocol.MethodKind(unquote(constant.value))) {
+			continue
+		}
+
+		constants[constant.typeName] = append(constants[constant.typeName], constant)
+	}
+
+	return constants
+}
+
+func (v *version) typesFile(changed map[string]bool) string {
+	var file strings.Builder
+	constants := v.constants()
+	// Whether a struct is decoded by an UnmarshalJSON method, which needs more
+	// imports.
+	decoded := false
+
+	for _, name := range v.order {
+		decl := v.types[name]
+
+		if !v.has[name] || decl.message {
+			continue
+		}
+
+		writeDoc(&file, decl.doc, "")
+
+		switch structType, isStruct := decl.expr.(*ast.StructType); {
+		case !changed[name]:
+			fmt.Fprintf(&file, "type %s = protocol.%s\n\n", name, name)
+		case isStruct:
+			v.writeStruct(&file, decl, v.fields(structType, nil))
+			decoded = decoded || decl.unmarshal
+		default:
+			v.writeDefinition(&file, decl)
+		}
+
+		if values := constants[name]; len(values) > 0 {
+			file.WriteString("const (\n")
+			for _, constant := range values {
+				writeDoc(&file, constant.doc, "\t")
+				fmt.Fprintf(&file, "\t%s = protocol.%s\n", constant.name, constant.name)
+			}
+			file.WriteString(")\n\n")
+		}
+	}
+
+	imports := "import \"github.com/myleshyson/lsprotocol-go/protocol\"\n\n"
+
+	if decoded {
+		imports = "import (\n\t\"bytes\"\n\t\"encoding/json\"\n\t\"fmt\"\n\n\t\"github.com/myleshyson/lsprotocol-go/protocol\"\n)\n\n"
+	}
+
+	return fmt.Sprintf("package %s\n\n", packageName(v.features.Version)) + imports + file.String()
+}
+
+// Writes a struct of the version, which is decoded strictly like the structs
+// of the protocol package.
+func (v *version) writeStruct(file *strings.Builder, decl *declaration, fields []field) {
+	fmt.Fprintf(file, "type %s struct {\n", decl.name)
+
+	for _, field := range fields {
+		writeDoc(file, field.doc, "\t")
+		fmt.Fprintf(file, "\t%s %s %s\n", field.name, field.typeName, field.tag)
+	}
+
+	file.WriteString("}\n\n")
+
+	if !decl.unmarshal {
+		return
+	}
+
+	fmt.Fprintf(file, "func (t *%s) UnmarshalJSON(x []byte) error {\n", decl.name)
+	file.WriteString("\tvar m map[string]any\n\tif err := json.Unmarshal(x, &m); err != nil {\n\t\treturn err\n\t}\n")
+
+	for _, field := range fields {
+		if field.required {
+			fmt.Fprintf(file, "\tif _, exists := m[%q]; !exists {\n\t\treturn fmt.Errorf(\"missing required field: %s\")\n\t}\n", field.json, field.json)
+		}
+	}
+
+	fmt.Fprintf(file, "\ttype Alias %s\n\tvar test Alias\n", decl.name)
+	file.WriteString("\tdecoder := json.NewDecoder(bytes.NewReader(x))\n\tdecoder.DisallowUnknownFields()\n")
+	file.WriteString("\tif err := decoder.Decode(&test); err != nil {\n\t\treturn err\n\t}\n")
+	fmt.Fprintf(file, "\t*t = %s(test)\n\n\treturn nil\n}\n\n", decl.name)
+}
+
+// Writes a union or another type defined by a type of the version. A union
+// that is left with a single alternative is an alias of it.
+func (v *version) writeDefinition(file *strings.Builder, decl *declaration) {
+	typeName, _ := v.resolve(decl.expr, nil)
+	_, generic := decl.expr.(*ast.IndexExpr)
+	_, genericList := decl.expr.(*ast.IndexListExpr)
+	union := strings.HasPrefix(typeName, "protocol.Or") || strings.HasPrefix(typeName, "protocol.NullableOr")
+
+	switch {
+	case union:
+		fmt.Fprintf(file, "type %s %s\n\n", decl.name, typeName)
+		fmt.Fprintf(file, "func (t *%s) UnmarshalJSON(x []byte) error {\n\treturn (*%s)(t).UnmarshalJSON(x)\n}\n\n", decl.name, typeName)
+		fmt.Fprintf(file, "func (t %s) MarshalJSON() ([]byte, error) {\n\treturn %s(t).MarshalJSON()\n}\n\n", decl.name, typeName)
+	case generic || genericList:
+		fmt.Fprintf(file, "type %s = %s\n\n", decl.name, typeName)
+	default:
+		fmt.Fprintf(file, "type %s %s\n\n", decl.name, typeName)
+	}
+}
+
+func (v *version) convertFile(changed map[string]bool) string {
+	var file strings.Builder
+
+	fmt.Fprintf(&file, "package %s\n\n", packageName(v.features.Version))
+	file.WriteString("import \"github.com/myleshyson/lsprotocol-go/protocol\"\n\n")
+
+	for _, name := range v.order {
+		if !changed[name] {
+			continue
+		}
+
+		fmt.Fprintf(&file, "// Converts a %s of protocol %s to the latest version.\n", name, v.features.Version)
+		fmt.Fprintf(&file, "func %sToLatest(value %s) (protocol.%s, error) {\n\treturn convert[protocol.%s](value)\n}\n\n", name, name, name, name)
+		fmt.Fprintf(&file, "// Converts a %s of the latest version to protocol %s, leaving out the\n", name, v.features.Version)
+		file.WriteString("// fields and union alternatives it doesn't have, as protocol.Downgrade does.\n")
+		fmt.Fprintf(&file, "func %sFromLatest(value protocol.%s) (%s, error) {\n\treturn convert[%s](protocol.Downgrade(Features, value))\n}\n\n", name, name, name, name)
+	}
+
+	return file.String()
+}
+
+func (v *version) versionFile() string {
+	var file strings.Builder
+	target := v.features.Version
+
+	fmt.Fprintf(&file, "// Package %s has the types of version %s of the protocol, without proposed\n", packageName(target), target)
+	file.WriteString("// features. It is generated from the protocol package by `mage generate`.\n")
+	file.WriteString("//\n")
+	file.WriteString("// Types that are the same in the latest version are aliases of the protocol\n")
+	file.WriteString("// package's types. Types that changed are declared without the fields and\n")
+	file.WriteString("// union alternatives added later, are decoded as strictly, and have\n")
+	file.WriteString("// conversions from and to the latest version, e.g. CompletionItemToLatest\n")
+	file.WriteString("// and CompletionItemFromLatest. The values of enumerations are not dated by\n")
+	file.WriteString("// the specification, so they include the values added later.\n")
+	fmt.Fprintf(&file, "package %s\n\n", packageName(target))
+	file.WriteString("import (\n\t\"bytes\"\n\t\"encoding/json\"\n\t\"fmt\"\n\n\t\"github.com/myleshyson/lsprotocol-go/protocol\"\n)\n\n")
+
+	fmt.Fprintf(&file, "// The part of the protocol this package has.\n")
+	fmt.Fprintf(&file, "var Features = protocol.Features{Version: protocol.Version%d_%d}\n\n", target.Major, target.Minor)
+
+	methods := map[string]string{}
+	for _, constant := range v.model.constants {
+		if constant.typeName == "MethodKind" {
+			methods[unquote(constant.value)] = constant.name
+		}
+	}
+
+	file.WriteString("// Decodes the params of a request or notification of this version, rejecting\n")
+	file.WriteString("// the fields and union alternatives added after it. Methods added after it\n")
+	file.WriteString("// are an error.\n")
+	file.WriteString("func DecodeParams(method protocol.MethodKind, params []byte) (any, error) {\n\tswitch method {\n")
+
+	for _, name := range v.order {
+		decl := v.types[name]
+
+		if !v.has[name] || !decl.message || strings.HasSuffix(name, "Response") {
+			continue
+		}
+
+		for _, field := range decl.expr.(*ast.StructType).Fields.List {
+			if len(field.Names) != 1 || field.Names[0].Name != "Params" {
+				continue
+			}
+
+			typeName, ok := v.resolve(field.Type, nil)
+			method := methods[v.methods[name]]
+
+			if !ok || method == "" {
+				continue
+			}
+
+			fmt.Fprintf(&file, "\tcase %s:\n\t\treturn decodeParams[%s](params)\n", method, typeName)
+		}
+	}
+
+	fmt.Fprintf(&file, "\t}\n\n\treturn nil, fmt.Errorf(\"method not found in protocol %s: %%s\", method)\n}\n\n", target)
+
+	file.WriteString(`func decodeParams[T any](params []byte) (any, error) {
+	value, err := decode[T](params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+// Converts a value between versions with a json round trip.
+func convert[T any](value any) (T, error) {
+	content, err := json.Marshal(value)
+
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	return decode[T](content)
+}
+
+// Decodes content strictly, rejecting unknown fields like the types of the
+// protocol package do.
+func decode[T any](content []byte) (T, error) {
+	var value T
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.DisallowUnknownFields()
+	err := decoder.Decode(&value)
+
+	return value, err
+}
+`)
+
+	return file.String()
+}
+
+func writeDoc(file *strings.Builder, doc []string, indent string) {
+	for _, line := range doc {
+		file.WriteString(indent + line + "\n")
+	}
+}
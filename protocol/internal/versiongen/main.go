@@ -0,0 +1,266 @@
+// Command versiongen generates a package per released version of the
+// protocol, e.g. protocol/v3_16, from the generated protocol package.
+//
+// Usage:
+//
+//	go run ./protocol/internal/versiongen [-protocol dir]
+//
+// The generator of the protocol package records the version the metaModel
+// gives every field, method and type in since and proposed struct tags,
+// MethodVersions and TypeVersions. A versioned package has the types of its
+// version, without proposed features: types that are the same as the latest
+// ones are aliases of them, and types that changed are declared again without
+// the fields and union alternatives added later, with conversions from and to
+// the latest types. `mage generate` runs it after the protocol package is
+// generated.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+)
+
+// The versions packages are generated for.
+var versions = []protocol.Version{protocol.Version3_16, protocol.Version3_17}
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, "versiongen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	flags := flag.NewFlagSet("versiongen", flag.ContinueOnError)
+	dir := flags.String("protocol", "protocol", "the directory of the protocol package")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	model, err := load(*dir)
+
+	if err != nil {
+		return err
+	}
+
+	for _, version := range versions {
+		files, err := generate(model, version)
+
+		if err != nil {
+			return err
+		}
+
+		output := filepath.Join(*dir, packageName(version))
+
+		if err := os.MkdirAll(output, 0o755); err != nil {
+			return err
+		}
+
+		for name, content := range files {
+			if err := os.WriteFile(filepath.Join(output, name), content, 0o644); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Returns the name of the package of a version, e.g. v3_16.
+func packageName(version protocol.Version) string {
+	return fmt.Sprintf("v%d_%d", version.Major, version.Minor)
+}
+
+// The declarations of the protocol package that versioned packages are made
+// of, in the order of types.go.
+type model struct {
+	types map[string]*declaration
+	order []string
+	// The values of enumerations.
+	constants []*constant
+	// The methods of messages, by the name of their type.
+	methods map[string]string
+}
+
+type declaration struct {
+	name string
+	doc  []string
+	expr ast.Expr
+	// Whether the type is the message of a request, notification or response,
+	// which versioned packages reuse from the protocol package.
+	message bool
+	// Whether the type has an UnmarshalJSON method.
+	unmarshal bool
+}
+
+type constant struct {
+	name     string
+	typeName string
+	value    string
+	doc      []string
+}
+
+// An entry of MessageRegistry or ResponseRegistry, which decodes a message of
+// a method.
+var messageEntry = regexp.MustCompile(`"([^"]+)": func\(data \[\]byte\) \(Message, error\) \{\s+var message (\w+)`)
+
+// Loads the declarations of types.go and the methods of registry.go.
+func load(dir string) (*model, error) {
+	fileSet := token.NewFileSet()
+	file, err := parser.ParseFile(fileSet, filepath.Join(dir, "types.go"), nil, parser.ParseComments)
+
+	if err != nil {
+		return nil, err
+	}
+
+	m := &model{types: map[string]*declaration{}, methods: map[string]string{}}
+
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.GenDecl:
+			m.addDeclaration(decl)
+		case *ast.FuncDecl:
+			if decl.Recv == nil || decl.Name.Name != "UnmarshalJSON" {
+				continue
+			}
+			if receiver, ok := decl.Recv.List[0].Type.(*ast.StarExpr); ok {
+				if name, ok := receiver.X.(*ast.Ident); ok && m.types[name.Name] != nil {
+					m.types[name.Name].unmarshal = true
+				}
+			}
+		}
+	}
+
+	registry, err := os.ReadFile(filepath.Join(dir, "registry.go"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, match := range messageEntry.FindAllStringSubmatch(string(registry), -1) {
+		m.methods[match[2]] = match[1]
+	}
+
+	if len(m.methods) == 0 {
+		return nil, errors.New("no messages found in registry.go")
+	}
+
+	return m, nil
+}
+
+func (m *model) addDeclaration(decl *ast.GenDecl) {
+	for _, spec := range decl.Specs {
+		switch spec := spec.(type) {
+		case *ast.TypeSpec:
+			if spec.TypeParams != nil || !spec.Name.IsExported() || !keep(spec.Type) {
+				continue
+			}
+
+			doc := spec.Doc
+			if doc == nil && len(decl.Specs) == 1 {
+				doc = decl.Doc
+			}
+
+			m.types[spec.Name.Name] = &declaration{
+				name:    spec.Name.Name,
+				doc:     comments(doc),
+				expr:    spec.Type,
+				message: isMessage(spec.Type),
+			}
+			m.order = append(m.order, spec.Name.Name)
+		case *ast.ValueSpec:
+			name, ok := spec.Type.(*ast.Ident)
+
+			if decl.Tok != token.CONST || !ok || len(spec.Names) != 1 || len(spec.Values) != 1 {
+				continue
+			}
+
+			value, ok := spec.Values[0].(*ast.BasicLit)
+
+			if !ok {
+				continue
+			}
+
+			m.constants = append(m.constants, &constant{
+				name:     spec.Names[0].Name,
+				typeName: name.Name,
+				value:    value.Value,
+				doc:      comments(spec.Doc),
+			})
+		}
+	}
+}
+
+// Reports whether a type is part of the protocol, rather than a helper of
+// the package such as Message or UnmarshalError.
+func keep(expr ast.Expr) bool {
+	switch expr := expr.(type) {
+	case *ast.InterfaceType:
+		return false
+	case *ast.StructType:
+		for _, field := range expr.Fields.List {
+			for _, name := range field.Names {
+				if !name.IsExported() {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+// Reports whether a type is the message of a request, notification or
+// response, which have a jsonrpc field.
+func isMessage(expr ast.Expr) bool {
+	structType, ok := expr.(*ast.StructType)
+
+	if !ok {
+		return false
+	}
+
+	for _, field := range structType.Fields.List {
+		for _, name := range field.Names {
+			if name.Name == "JsonRPC" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func comments(group *ast.CommentGroup) []string {
+	if group == nil {
+		return nil
+	}
+
+	lines := make([]string, 0, len(group.List))
+
+	for _, comment := range group.List {
+		lines = append(lines, strings.TrimRight(comment.Text, " "))
+	}
+
+	return lines
+}
+
+// Returns the value of a string constant, or the literal of other constants.
+func unquote(literal string) string {
+	if value, err := strconv.Unquote(literal); err == nil {
+		return value
+	}
+
+	return literal
+}
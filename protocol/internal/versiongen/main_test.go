@@ -0,0 +1,34 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGeneratedPackagesAreUpToDate(t *testing.T) {
+	model, err := load("../..")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, version := range versions {
+		files, err := generate(model, version)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for name, content := range files {
+			path := filepath.Join("../..", packageName(version), name)
+			existing, err := os.ReadFile(path)
+
+			if err != nil {
+				t.Fatalf("Expected %s to be generated, run mage generate: %s", path, err)
+			}
+			if !bytes.Equal(existing, content) {
+				t.Fatalf("Expected %s to be up to date, run mage generate", path)
+			}
+		}
+	}
+}
@@ -0,0 +1,306 @@
+package lsptest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+	"github.com/myleshyson/lsprotocol-go/protocol/recording"
+)
+
+// Update rewrites golden files with the transcripts of the test run instead of
+// comparing them. It is set from the LSPTEST_UPDATE environment variable, e.g.
+// LSPTEST_UPDATE=1 go test ./..., and packages with an -update flag of their
+// own can set it from theirs.
+var Update, _ = strconv.ParseBool(os.Getenv("LSPTEST_UPDATE"))
+
+// Fields whose values differ from run to run, which golden transcripts
+// replace with placeholders. The same value is replaced with the same
+// placeholder throughout a transcript, so a response still matches its request.
+// Request ids are numbered apart for each side, so a request of the client
+// and one of the server with the same id get different placeholders.
+//
+// Fields are json keys, which are replaced at any depth, or "Type.Field" of the
+// protocol's types, which are only replaced in messages that decode into their
+// types. The data servers attach to items is opaque, while other data, such as
+// SemanticTokens.Data, is kept.
+var DefaultNormalizedFields = []string{
+	"id",
+	"processId",
+	"resultId",
+	"previousResultId",
+	"token",
+	"workDoneToken",
+	"partialResultToken",
+	"CompletionItem.Data",
+	"CompletionItemDefaults.Data",
+	"CodeAction.Data",
+	"CodeLens.Data",
+	"DocumentLink.Data",
+	"InlayHint.Data",
+	"Diagnostic.Data",
+	"WorkspaceSymbol.Data",
+	"CallHierarchyItem.Data",
+	"TypeHierarchyItem.Data",
+}
+
+// Helper function that encodes a transcript as canonical json, with the values
+// of fields replaced with numbered placeholders such as "<resultId 1>".
+func NormalizeTranscript(entries []recording.Entry, fields []string) ([]byte, error) {
+	type transcriptEntry struct {
+		Direction recording.Direction `json:"direction"`
+		Message   any                 `json:"message"`
+	}
+
+	normalizer := &normalizer{fields: fields, placeholders: map[string]string{}, counts: map[string]int{}, methods: map[string]protocol.MethodKind{}}
+	transcript := make([]transcriptEntry, 0, len(entries))
+
+	for _, entry := range entries {
+		decoder := json.NewDecoder(bytes.NewReader(entry.Message))
+		decoder.UseNumber()
+
+		var message any
+
+		if err := decoder.Decode(&message); err != nil {
+			return nil, fmt.Errorf("decoding %s message: %w", entry.Direction, err)
+		}
+
+		transcript = append(transcript, transcriptEntry{Direction: entry.Direction, Message: normalizer.message(entry.Direction, entry.Message, message)})
+	}
+
+	content, err := protocol.MarshalCanonical(transcript)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return append(content, '\n'), nil
+}
+
+type normalizer struct {
+	fields []string
+	// Placeholders by field, scope and canonical value.
+	placeholders map[string]string
+	counts       map[string]int
+	// The methods of requests, by the direction they were sent in and id.
+	methods map[string]protocol.MethodKind
+}
+
+// Normalizes a message sent in direction. Its id is scoped to the direction of
+// the request, which a response is sent in the reverse of.
+func (n *normalizer) message(direction recording.Direction, content json.RawMessage, message any) any {
+	object, ok := message.(map[string]any)
+
+	if !ok {
+		return n.normalize(message)
+	}
+
+	if decoded := n.decode(direction, content, object); decoded != nil {
+		n.normalizeTyped(reflect.ValueOf(decoded), object)
+	}
+
+	if object["id"] == nil || !slices.Contains(n.fields, "id") {
+		return n.normalize(object)
+	}
+
+	if _, isRequest := object["method"]; !isRequest {
+		direction = direction.Reverse()
+	}
+
+	id := object["id"]
+	delete(object, "id")
+	n.normalize(object)
+	object["id"] = n.placeholder("id", string(direction), id)
+
+	return object
+}
+
+// Decodes a message into its protocol type. Responses are decoded with the
+// response of the request they answer. Returns nil for messages that can't be
+// decoded, e.g. of methods that are not part of the protocol.
+func (n *normalizer) decode(direction recording.Direction, content json.RawMessage, object map[string]any) protocol.Message {
+	id, _ := protocol.MarshalCanonical(object["id"])
+
+	if method, isRequest := object["method"].(string); isRequest {
+		if object["id"] != nil {
+			n.methods[string(direction)+"\x00"+string(id)] = protocol.MethodKind(method)
+		}
+
+		framed, err := protocol.EncodeMessage(content)
+		if err != nil {
+			return nil
+		}
+
+		decoded, err := protocol.DecodeMessage(framed)
+		if err != nil {
+			return nil
+		}
+
+		return decoded
+	}
+
+	key := string(direction.Reverse()) + "\x00" + string(id)
+	method, exists := n.methods[key]
+	delete(n.methods, key)
+
+	if !exists {
+		return nil
+	}
+
+	decoded, err := protocol.DecodeResponse(method, content)
+	if err != nil {
+		return nil
+	}
+
+	return decoded
+}
+
+// Normalizes the fields given as "Type.Field" in content, the json of value.
+func (n *normalizer) normalizeTyped(value reflect.Value, content any) any {
+	switch value.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if value.IsNil() {
+			return content
+		}
+		return n.normalizeTyped(value.Elem(), content)
+	case reflect.Slice, reflect.Array:
+		items, ok := content.([]any)
+		if !ok {
+			return content
+		}
+		for i := range min(value.Len(), len(items)) {
+			items[i] = n.normalizeTyped(value.Index(i), items[i])
+		}
+	case reflect.Map:
+		object, ok := content.(map[string]any)
+		if !ok || value.Type().Key().Kind() != reflect.String {
+			return content
+		}
+		for _, key := range value.MapKeys() {
+			if field, exists := object[key.String()]; exists {
+				object[key.String()] = n.normalizeTyped(value.MapIndex(key), field)
+			}
+		}
+	case reflect.Struct:
+		// Unions are encoded as the value they hold.
+		if value.NumField() == 1 && value.Type().Field(0).Name == "Value" {
+			return n.normalizeTyped(value.Field(0), content)
+		}
+
+		object, ok := content.(map[string]any)
+		if !ok {
+			return content
+		}
+
+		for i := range value.NumField() {
+			field := value.Type().Field(i)
+			key, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+			raw, exists := object[key]
+
+			if !field.IsExported() || key == "" || !exists {
+				continue
+			}
+
+			if raw != nil && slices.Contains(n.fields, value.Type().Name()+"."+field.Name) {
+				object[key] = n.placeholder(key, "", raw)
+				continue
+			}
+
+			object[key] = n.normalizeTyped(value.Field(i), raw)
+		}
+	}
+
+	return content
+}
+
+func (n *normalizer) normalize(value any) any {
+	switch value := value.(type) {
+	case map[string]any:
+		for key, field := range value {
+			if field != nil && slices.Contains(n.fields, key) {
+				value[key] = n.placeholder(key, "", field)
+			} else {
+				value[key] = n.normalize(field)
+			}
+		}
+	case []any:
+		for i, item := range value {
+			value[i] = n.normalize(item)
+		}
+	}
+
+	return value
+}
+
+func (n *normalizer) placeholder(field string, scope string, value any) string {
+	content, _ := protocol.MarshalCanonical(value)
+	key := field + "\x00" + scope + "\x00" + string(content)
+
+	if placeholder, exists := n.placeholders[key]; exists {
+		return placeholder
+	}
+
+	n.counts[field]++
+	placeholder := fmt.Sprintf("<%s %d>", field, n.counts[field])
+	n.placeholders[key] = placeholder
+
+	return placeholder
+}
+
+// Test helper that compares the normalized transcript of entries with the
+// golden file at path, or rewrites the file when Update is set.
+func AssertGolden(t testing.TB, path string, entries []recording.Entry) {
+	t.Helper()
+
+	content, err := NormalizeTranscript(entries, DefaultNormalizedFields)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if Update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, content, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	golden, err := os.ReadFile(path)
+
+	if err != nil {
+		t.Fatalf("Expected a golden file, run the tests with LSPTEST_UPDATE=1 to create it: %s", err)
+	}
+
+	if bytes.Equal(golden, content) {
+		return
+	}
+
+	expected := strings.Split(string(golden), "\n")
+	actual := strings.Split(string(content), "\n")
+
+	for i := range max(len(expected), len(actual)) {
+		var want, got string
+
+		if i < len(expected) {
+			want = expected[i]
+		}
+		if i < len(actual) {
+			got = actual[i]
+		}
+
+		if want != got {
+			t.Fatalf("Transcript differs from %s at line %d, run the tests with LSPTEST_UPDATE=1 to accept it:\nwant: %s\ngot:  %s", path, i+1, want, got)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package lsptest
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+	"github.com/myleshyson/lsprotocol-go/protocol/recording"
+)
+
+func TestGoldenTranscript(t *testing.T) {
+	h := New(t, wordServer(), Options{})
+
+	positions := h.OpenDocument("file:///a.go", protocol.LanguageKindGo, "package main\n\n// TODO\nfunc ma|in() {}\n")
+	h.ExpectDiagnostics("file:///a.go")
+	h.Hover("file:///a.go", positions[0])
+
+	h.AssertGolden("testdata/session.golden")
+}
+
+func TestNormalizeTranscript(t *testing.T) {
+	entries := []recording.Entry{
+		{Direction: recording.ClientToServer, Message: json.RawMessage(`{"jsonrpc":"2.0","id":7,"method":"textDocument/diagnostic","params":{"textDocument":{"uri":"file:///a.go"},"previousResultId":null}}`)},
+		{Direction: recording.ServerToClient, Message: json.RawMessage(`{"jsonrpc":"2.0","id":7,"result":{"kind":"full","resultId":"1729","items":[{"range":{"start":{"line":0,"character":0},"end":{"line":0,"character":1}},"message":"m","data":{"z":1,"a":2}}]}}`)},
+		{Direction: recording.ClientToServer, Message: json.RawMessage(`{"jsonrpc":"2.0","id":"x","method":"textDocument/diagnostic","params":{"textDocument":{"uri":"file:///a.go"},"previousResultId":"1729"}}`)},
+	}
+
+	content, err := NormalizeTranscript(entries, DefaultNormalizedFields)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	transcript := string(content)
+
+	if strings.Count(transcript, `"<id 1>"`) != 2 || !strings.Contains(transcript, `"<id 2>"`) {
+		t.Fatalf("Expected the same ids to get the same placeholder, got %s", transcript)
+	}
+	if !strings.Contains(transcript, `"resultId": "<resultId 1>"`) || !strings.Contains(transcript, `"previousResultId": null`) || strings.Contains(transcript, "1729") {
+		t.Fatalf("Expected result ids to be normalized, got %s", transcript)
+	}
+	if !strings.Contains(transcript, `"data": "<data 1>"`) {
+		t.Fatalf("Expected data to be normalized, got %s", transcript)
+	}
+}
+
+func TestNormalizeTranscriptKeepsSemanticTokensData(t *testing.T) {
+	entries := []recording.Entry{
+		{Direction: recording.ClientToServer, Message: json.RawMessage(`{"jsonrpc":"2.0","id":1,"method":"textDocument/semanticTokens/full","params":{"textDocument":{"uri":"file:///a.go"}}}`)},
+		{Direction: recording.ServerToClient, Message: json.RawMessage(`{"jsonrpc":"2.0","id":1,"result":{"resultId":"1","data":[0,5,4,1,0]}}`)},
+		{Direction: recording.ClientToServer, Message: json.RawMessage(`{"jsonrpc":"2.0","id":2,"method":"textDocument/semanticTokens/full/delta","params":{"textDocument":{"uri":"file:///a.go"},"previousResultId":"1"}}`)},
+		{Direction: recording.ServerToClient, Message: json.RawMessage(`{"jsonrpc":"2.0","id":2,"result":{"resultId":"2","edits":[{"start":0,"deleteCount":1,"data":[3]}]}}`)},
+		{Direction: recording.ServerToClient, Message: json.RawMessage(`{"jsonrpc":"2.0","method":"$/custom","params":{"data":{"z":1}}}`)},
+	}
+
+	content, err := NormalizeTranscript(entries, DefaultNormalizedFields)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var transcript []struct {
+		Message struct {
+			Result struct {
+				Data  []int `json:"data"`
+				Edits []struct {
+					Data []int `json:"data"`
+				} `json:"edits"`
+			} `json:"result"`
+			Params struct {
+				Data map[string]int `json:"data"`
+			} `json:"params"`
+		} `json:"message"`
+	}
+	if err := json.Unmarshal(content, &transcript); err != nil {
+		t.Fatalf("Expected the data of semantic tokens to be kept, got %s", content)
+	}
+
+	if len(transcript[1].Message.Result.Data) != 5 || transcript[3].Message.Result.Edits[0].Data[0] != 3 {
+		t.Fatalf("Expected the data of semantic tokens to be kept, got %s", content)
+	}
+	if transcript[4].Message.Params.Data["z"] != 1 {
+		t.Fatalf("Expected data of unknown types to be kept, got %s", content)
+	}
+}
+
+func TestNormalizeTranscriptIDsByDirection(t *testing.T) {
+	entries := []recording.Entry{
+		{Direction: recording.ClientToServer, Message: json.RawMessage(`{"jsonrpc":"2.0","id":1,"method":"textDocument/hover","params":{"textDocument":{"uri":"file:///a.go"},"position":{"line":0,"character":0}}}`)},
+		{Direction: recording.ServerToClient, Message: json.RawMessage(`{"jsonrpc":"2.0","id":1,"method":"workspace/configuration","params":{"items":[]}}`)},
+		{Direction: recording.ClientToServer, Message: json.RawMessage(`{"jsonrpc":"2.0","id":1,"result":[]}`)},
+		{Direction: recording.ServerToClient, Message: json.RawMessage(`{"jsonrpc":"2.0","id":1,"result":null}`)},
+	}
+
+	content, err := NormalizeTranscript(entries, DefaultNormalizedFields)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var transcript []struct {
+		Message struct {
+			ID string `json:"id"`
+		} `json:"message"`
+	}
+	if err := json.Unmarshal(content, &transcript); err != nil {
+		t.Fatal(err)
+	}
+
+	client, server := transcript[0].Message.ID, transcript[1].Message.ID
+	if client == server {
+		t.Fatalf("Expected the requests of each side to get different placeholders, got %s", content)
+	}
+	if transcript[2].Message.ID != server || transcript[3].Message.ID != client {
+		t.Fatalf("Expected responses to get the placeholder of their request, got %s", content)
+	}
+}
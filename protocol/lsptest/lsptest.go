@@ -0,0 +1,266 @@
+// Package lsptest tests a server built on this module in process. It connects
+// the server to a client over net.Pipe, performs the initialize handshake, and
+// provides helpers that fail the test instead of returning errors. For testing
+// clients, MockServer is a scriptable server to connect them to.
+package lsptest
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+	"github.com/myleshyson/lsprotocol-go/protocol/client"
+	"github.com/myleshyson/lsprotocol-go/protocol/recording"
+)
+
+// DefaultTimeout is how long requests and expectations wait by default.
+const DefaultTimeout = 5 * time.Second
+
+// Options configures the client side of a Harness.
+type Options struct {
+	Capabilities          protocol.ClientCapabilities
+	RootUri               *protocol.DocumentUri
+	WorkspaceFolders      []protocol.WorkspaceFolder
+	InitializationOptions any
+	// Handles requests and notifications sent by the server. Requests are
+	// answered with a null result when it is nil, or when it returns a nil
+	// result without an error.
+	Handler protocol.Handler
+	// Marks cursor positions in the text of OpenDocument. Defaults to "|".
+	Marker string
+	// How long requests and expectations wait. Defaults to DefaultTimeout.
+	Timeout time.Duration
+}
+
+// Harness is a client connected to a server in the same process.
+type Harness struct {
+	t       testing.TB
+	options Options
+
+	// The client side of the connection, after the initialize handshake.
+	Client *client.Client
+	// The server side of the connection.
+	Server *protocol.Conn
+
+	mu          sync.Mutex
+	diagnostics map[protocol.DocumentUri][]protocol.PublishDiagnosticsParams
+	published   chan struct{}
+	transcript  bytes.Buffer
+}
+
+// Starts a connection with server's options, connects a client to it and
+// performs the initialize handshake. The client shuts the server down and
+// both sides are closed when the test ends.
+func New(t testing.TB, server protocol.ConnOptions, options Options) *Harness {
+	t.Helper()
+
+	if options.Marker == "" {
+		options.Marker = "|"
+	}
+
+	if options.Timeout <= 0 {
+		options.Timeout = DefaultTimeout
+	}
+
+	h := &Harness{
+		t:           t,
+		options:     options,
+		diagnostics: map[protocol.DocumentUri][]protocol.PublishDiagnosticsParams{},
+		published:   make(chan struct{}),
+	}
+
+	clientSide, serverSide := net.Pipe()
+	h.Server = protocol.NewConn(recording.NewRecorder(serverSide, (*transcriptWriter)(h), recording.ServerToClient), server)
+
+	serverDone := make(chan struct{})
+	go func() {
+		defer close(serverDone)
+		h.Server.Run(context.Background())
+	}()
+
+	ctx, cancel := h.context()
+	defer cancel()
+
+	c, err := client.New(ctx, clientSide, client.Options{
+		Capabilities:          options.Capabilities,
+		ClientInfo:            &protocol.ClientInfo{Name: "lsptest"},
+		RootUri:               options.RootUri,
+		WorkspaceFolders:      options.WorkspaceFolders,
+		InitializationOptions: options.InitializationOptions,
+		Handler:               h.handle,
+	})
+
+	if err != nil {
+		h.Server.Close()
+		<-serverDone
+		t.Fatal(err)
+	}
+	h.Client = c
+
+	t.Cleanup(func() {
+		ctx, cancel := h.context()
+		defer cancel()
+
+		// Servers that don't handle shutdown are closed all the same.
+		h.Client.Close(ctx)
+		h.Server.Close()
+		<-serverDone
+	})
+
+	return h
+}
+
+func (h *Harness) context() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), h.options.Timeout)
+}
+
+// Records published diagnostics before passing messages to Options.Handler.
+func (h *Harness) handle(ctx context.Context, message protocol.IncomingMessage) (any, error) {
+	if notification, ok := message.(protocol.PublishDiagnosticsNotification); ok {
+		h.mu.Lock()
+		h.diagnostics[notification.Params.Uri] = append(h.diagnostics[notification.Params.Uri], notification.Params)
+		close(h.published)
+		h.published = make(chan struct{})
+		h.mu.Unlock()
+	}
+
+	if h.options.Handler == nil {
+		return nil, nil
+	}
+
+	return h.options.Handler(ctx, message)
+}
+
+// Returns the encoding positions are counted in, as the server chose it.
+func (h *Harness) Encoding() protocol.PositionEncodingKind {
+	if encoding := h.Client.ServerCapabilities().PositionEncoding; encoding != nil {
+		return *encoding
+	}
+
+	return protocol.PositionEncodingKindUTF16
+}
+
+// Opens a document whose text marks cursor positions with Options.Marker,
+// e.g. "fmt.Pri|ntln", and returns the marked positions in order. The markers
+// are removed from the text the server receives.
+func (h *Harness) OpenDocument(uri protocol.DocumentUri, languageId protocol.LanguageKind, text string) []protocol.Position {
+	h.t.Helper()
+
+	text, positions := ParseMarkers(text, h.options.Marker, h.Encoding())
+
+	ctx, cancel := h.context()
+	defer cancel()
+
+	if err := h.Client.Documents().Open(ctx, uri, languageId, text); err != nil {
+		h.t.Fatal(err)
+	}
+
+	return positions
+}
+
+// Sends a hover request for the position in the document at uri.
+func (h *Harness) Hover(uri protocol.DocumentUri, position protocol.Position) *protocol.Hover {
+	h.t.Helper()
+
+	ctx, cancel := h.context()
+	defer cancel()
+
+	hover, err := h.Client.Hover(ctx, protocol.HoverParams{
+		TextDocument: protocol.TextDocumentIdentifier{Uri: uri},
+		Position:     position,
+	})
+
+	if err != nil {
+		h.t.Fatal(err)
+	}
+
+	return hover
+}
+
+// Waits for the server to publish diagnostics for uri and returns them. Each
+// call returns the next publication, so a test can expect the diagnostics of
+// every change in turn.
+func (h *Harness) ExpectDiagnostics(uri protocol.DocumentUri) []protocol.Diagnostic {
+	h.t.Helper()
+
+	timeout := time.After(h.options.Timeout)
+
+	for {
+		h.mu.Lock()
+		published := h.published
+
+		if queued := h.diagnostics[uri]; len(queued) > 0 {
+			h.diagnostics[uri] = queued[1:]
+			h.mu.Unlock()
+			return queued[0].Diagnostics
+		}
+		h.mu.Unlock()
+
+		select {
+		case <-published:
+		case <-timeout:
+			h.t.Fatalf("Expected diagnostics for %s within %s", uri, h.options.Timeout)
+			return nil
+		}
+	}
+}
+
+// Returns every message sent between the client and the server so far,
+// starting with initialize.
+func (h *Harness) Transcript() []recording.Entry {
+	h.t.Helper()
+
+	h.mu.Lock()
+	entries, err := recording.ReadEntries(bytes.NewReader(h.transcript.Bytes()))
+	h.mu.Unlock()
+
+	if err != nil {
+		h.t.Fatal(err)
+	}
+
+	return entries
+}
+
+// Compares the transcript of the session so far with the golden file at path.
+// See AssertGolden.
+func (h *Harness) AssertGolden(path string) {
+	h.t.Helper()
+
+	AssertGolden(h.t, path, h.Transcript())
+}
+
+// Collects the recorded transcript of a Harness.
+type transcriptWriter Harness
+
+func (w *transcriptWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.transcript.Write(p)
+}
+
+// Helper function that removes the markers from text and returns the
+// positions they were at, counted in encoding.
+func ParseMarkers(text string, marker string, encoding protocol.PositionEncodingKind) (string, []protocol.Position) {
+	var builder strings.Builder
+	var positions []protocol.Position
+
+	for {
+		before, after, found := strings.Cut(text, marker)
+		builder.WriteString(before)
+
+		if !found {
+			break
+		}
+
+		positions = append(positions, protocol.PositionAt(builder.String(), builder.Len(), encoding))
+		text = after
+	}
+
+	return builder.String(), positions
+}
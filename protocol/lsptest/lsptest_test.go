@@ -0,0 +1,102 @@
+package lsptest
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+)
+
+// A server that hovers the word at the cursor and reports every "TODO".
+func wordServer() protocol.ConnOptions {
+	var mu sync.Mutex
+	documents := map[protocol.DocumentUri]string{}
+
+	publish := func(ctx context.Context, uri protocol.DocumentUri, text string) error {
+		diagnostics := []protocol.Diagnostic{}
+
+		for line, content := range strings.Split(text, "\n") {
+			if column := strings.Index(content, "TODO"); column >= 0 {
+				diagnostics = append(diagnostics, protocol.Diagnostic{
+					Range: protocol.Range{
+						Start: protocol.Position{Line: uint32(line), Character: uint32(column)},
+						End:   protocol.Position{Line: uint32(line), Character: uint32(column + 4)},
+					},
+					Message: "todo",
+				})
+			}
+		}
+
+		return protocol.ConnFromContext(ctx).PublishDiagnostics(ctx, protocol.PublishDiagnosticsParams{Uri: uri, Diagnostics: diagnostics})
+	}
+
+	return protocol.ConnOptions{
+		Handler: func(ctx context.Context, message protocol.IncomingMessage) (any, error) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			switch message := message.(type) {
+			case protocol.InitializeRequest:
+				return protocol.InitializeResult{
+					Capabilities: protocol.ServerCapabilities{
+						TextDocumentSync: &protocol.Or2[protocol.TextDocumentSyncOptions, protocol.TextDocumentSyncKind]{Value: protocol.TextDocumentSyncKindFull},
+						HoverProvider:    &protocol.Or2[bool, protocol.HoverOptions]{Value: true},
+					},
+				}, nil
+			case protocol.DidOpenTextDocumentNotification:
+				documents[message.Params.TextDocument.Uri] = message.Params.TextDocument.Text
+				return nil, publish(ctx, message.Params.TextDocument.Uri, message.Params.TextDocument.Text)
+			case protocol.HoverRequest:
+				text := documents[message.Params.TextDocument.Uri]
+				offset := protocol.OffsetAt(text, message.Params.Position, protocol.PositionEncodingKindUTF16)
+				start := strings.LastIndexAny(text[:offset], " \n(.") + 1
+				end := offset + strings.IndexAny(text[offset:]+" ", " \n(.")
+
+				return protocol.Hover{
+					Contents: protocol.Or3[protocol.MarkupContent, protocol.MarkedString, []protocol.MarkedString]{
+						Value: protocol.MarkupContent{Kind: protocol.MarkupKindPlainText, Value: text[start:end]},
+					},
+				}, nil
+			}
+			return nil, nil
+		},
+	}
+}
+
+func TestHarness(t *testing.T) {
+	h := New(t, wordServer(), Options{})
+
+	positions := h.OpenDocument("file:///a.go", protocol.LanguageKindGo, "package main\n\n// TODO\nfunc main() { fmt.Pri|ntln(\"😀\", x|) }\n")
+
+	if len(positions) != 2 || positions[0] != (protocol.Position{Line: 3, Character: 21}) {
+		t.Fatalf("Expected the marked positions, got %+v", positions)
+	}
+
+	hover := h.Hover("file:///a.go", positions[0])
+	if hover.Contents.Value.(protocol.MarkupContent).Value != "Println" {
+		t.Fatalf("Expected the word at the marker, got %+v", hover.Contents.Value)
+	}
+
+	diagnostics := h.ExpectDiagnostics("file:///a.go")
+	if len(diagnostics) != 1 || diagnostics[0].Range.Start.Line != 2 {
+		t.Fatalf("Expected the todo to be reported, got %+v", diagnostics)
+	}
+}
+
+func TestParseMarkers(t *testing.T) {
+	text, positions := ParseMarkers("a😀$b\n$c", "$", protocol.PositionEncodingKindUTF16)
+
+	if text != "a😀b\nc" {
+		t.Fatalf("Expected the markers to be removed, got %q", text)
+	}
+	if len(positions) != 2 || positions[0] != (protocol.Position{Line: 0, Character: 3}) || positions[1] != (protocol.Position{Line: 1, Character: 0}) {
+		t.Fatalf("Unexpected positions: %+v", positions)
+	}
+
+	_, positions = ParseMarkers("a😀$b", "$", protocol.PositionEncodingKindUTF8)
+	if positions[0].Character != 5 {
+		t.Fatalf("Expected the position in utf-8, got %+v", positions[0])
+	}
+}
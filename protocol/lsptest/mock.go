@@ -0,0 +1,194 @@
+package lsptest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+)
+
+// MockServer is a deterministic language server to test clients against. It
+// answers requests with canned results, errors or handler functions per
+// method, and records every message it receives for assertions.
+//
+// Requests without a canned response are answered with
+// ErrorCodesMethodNotFound, except initialize, which is answered with
+// Capabilities, and shutdown.
+type MockServer struct {
+	// The capabilities initialize is answered with.
+	Capabilities protocol.ServerCapabilities
+
+	mu        sync.Mutex
+	handlers  map[protocol.MethodKind]protocol.Handler
+	latencies map[protocol.MethodKind]time.Duration
+	received  []protocol.IncomingMessage
+	arrived   chan struct{}
+	conn      *protocol.Conn
+}
+
+// Creates a mock server without canned responses.
+func NewMockServer() *MockServer {
+	return &MockServer{
+		handlers:  map[protocol.MethodKind]protocol.Handler{},
+		latencies: map[protocol.MethodKind]time.Duration{},
+		arrived:   make(chan struct{}),
+	}
+}
+
+// Answers requests of method with result.
+func (m *MockServer) Respond(method protocol.MethodKind, result any) *MockServer {
+	return m.Handle(method, func(ctx context.Context, message protocol.IncomingMessage) (any, error) {
+		return result, nil
+	})
+}
+
+// Answers requests of method with err, e.g.
+// protocol.Error(int32(protocol.LSPErrorCodesContentModified), err).
+func (m *MockServer) Fail(method protocol.MethodKind, err error) *MockServer {
+	return m.Handle(method, func(ctx context.Context, message protocol.IncomingMessage) (any, error) {
+		return nil, err
+	})
+}
+
+// Handles requests and notifications of method with handler. Handlers can send
+// requests to the client, such as workspace/applyEdit, with
+// protocol.ConnFromContext(ctx).
+func (m *MockServer) Handle(method protocol.MethodKind, handler protocol.Handler) *MockServer {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.handlers[method] = handler
+
+	return m
+}
+
+// Delays the answers to requests of method, or of every method when method is
+// empty. A request that is cancelled while it is delayed is answered with
+// LSPErrorCodesRequestCancelled.
+func (m *MockServer) Delay(method protocol.MethodKind, latency time.Duration) *MockServer {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.latencies[method] = latency
+
+	return m
+}
+
+// Returns the connection options that serve the mock.
+func (m *MockServer) ConnOptions() protocol.ConnOptions {
+	return protocol.ConnOptions{Handler: m.handle}
+}
+
+// Serves the mock over rwc until the connection is closed or ctx is done.
+func (m *MockServer) Serve(ctx context.Context, rwc io.ReadWriteCloser) error {
+	conn := protocol.NewConn(rwc, m.ConnOptions())
+
+	m.mu.Lock()
+	m.conn = conn
+	m.mu.Unlock()
+
+	return conn.Run(ctx)
+}
+
+// Serves the mock over one end of a net.Pipe and returns the other end for
+// the client under test. The mock is closed when the test ends.
+func (m *MockServer) Connect(t testing.TB) net.Conn {
+	clientSide, serverSide := net.Pipe()
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		m.Serve(context.Background(), serverSide)
+	}()
+
+	t.Cleanup(func() {
+		serverSide.Close()
+		<-done
+	})
+
+	return clientSide
+}
+
+// Returns the connection of the last Serve, to send requests and
+// notifications to the client, or nil if the mock isn't served yet.
+func (m *MockServer) Conn() *protocol.Conn {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.conn
+}
+
+// Returns the requests and notifications received so far, in order.
+func (m *MockServer) Received() []protocol.IncomingMessage {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]protocol.IncomingMessage(nil), m.received...)
+}
+
+// Waits until a message of method is received and returns the first one.
+func (m *MockServer) Expect(ctx context.Context, method protocol.MethodKind) (protocol.IncomingMessage, error) {
+	for {
+		m.mu.Lock()
+		arrived := m.arrived
+
+		for _, message := range m.received {
+			if message.GetMethod() == method {
+				m.mu.Unlock()
+				return message, nil
+			}
+		}
+		m.mu.Unlock()
+
+		select {
+		case <-arrived:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+func (m *MockServer) handle(ctx context.Context, message protocol.IncomingMessage) (any, error) {
+	method := message.GetMethod()
+
+	m.mu.Lock()
+	m.received = append(m.received, message)
+	close(m.arrived)
+	m.arrived = make(chan struct{})
+
+	handler, handled := m.handlers[method]
+	latency, delayed := m.latencies[method]
+
+	if !delayed {
+		latency = m.latencies[""]
+	}
+	m.mu.Unlock()
+
+	if _, isRequest := message.(protocol.Request); isRequest && latency > 0 {
+		select {
+		case <-time.After(latency):
+		case <-ctx.Done():
+			return nil, protocol.Error(int32(protocol.LSPErrorCodesRequestCancelled), ctx.Err())
+		}
+	}
+
+	switch {
+	case handled:
+		return handler(ctx, message)
+	case method == protocol.InitializeMethod:
+		return protocol.InitializeResult{Capabilities: m.Capabilities}, nil
+	case method == protocol.ShutdownMethod:
+		return nil, nil
+	}
+
+	if _, isRequest := message.(protocol.Request); isRequest {
+		return nil, protocol.Error(int32(protocol.ErrorCodesMethodNotFound), errors.New("method not found: "+string(method)))
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,99 @@
+package lsptest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+	"github.com/myleshyson/lsprotocol-go/protocol/client"
+)
+
+func TestMockServer(t *testing.T) {
+	mock := NewMockServer()
+	mock.Capabilities.HoverProvider = &protocol.Or2[bool, protocol.HoverOptions]{Value: true}
+	mock.Respond(protocol.TextDocumentHoverMethod, protocol.Hover{
+		Contents: protocol.Or3[protocol.MarkupContent, protocol.MarkedString, []protocol.MarkedString]{
+			Value: protocol.MarkupContent{Kind: protocol.MarkupKindPlainText, Value: "canned"},
+		},
+	})
+	mock.Fail(protocol.TextDocumentDefinitionMethod, protocol.Error(int32(protocol.LSPErrorCodesContentModified), errors.New("content modified")))
+	mock.Handle(protocol.WorkspaceExecuteCommandMethod, func(ctx context.Context, message protocol.IncomingMessage) (any, error) {
+		return protocol.ConnFromContext(ctx).ApplyWorkspaceEdit(ctx, protocol.ApplyWorkspaceEditParams{})
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	edits := make(chan protocol.ApplyWorkspaceEditRequest, 1)
+	c, err := client.New(ctx, mock.Connect(t), client.Options{
+		Handler: func(ctx context.Context, message protocol.IncomingMessage) (any, error) {
+			if request, ok := message.(protocol.ApplyWorkspaceEditRequest); ok {
+				edits <- request
+				return protocol.ApplyWorkspaceEditResult{Applied: true}, nil
+			}
+			return nil, nil
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close(ctx)
+
+	hover, err := c.Hover(ctx, protocol.HoverParams{TextDocument: protocol.TextDocumentIdentifier{Uri: "file:///a.go"}})
+	if err != nil || hover.Contents.Value.(protocol.MarkupContent).Value != "canned" {
+		t.Fatalf("Expected the canned hover, got %+v, %v", hover, err)
+	}
+
+	var responseErr *protocol.ResponseError
+	if _, err := c.Definition(ctx, protocol.DefinitionParams{}); !errors.As(err, &responseErr) || responseErr.Code != int32(protocol.LSPErrorCodesContentModified) {
+		t.Fatalf("Expected the canned error, got %v", err)
+	}
+
+	if _, err := c.References(ctx, protocol.ReferenceParams{}); !errors.As(err, &responseErr) || responseErr.Code != int32(protocol.ErrorCodesMethodNotFound) {
+		t.Fatalf("Expected unhandled requests to fail, got %v", err)
+	}
+
+	if _, err := c.ExecuteCommand(ctx, protocol.ExecuteCommandParams{Command: "fix"}); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-edits:
+	default:
+		t.Fatal("Expected the server to request an edit before answering")
+	}
+
+	if _, err := mock.Expect(ctx, protocol.InitializedMethod); err != nil {
+		t.Fatal(err)
+	}
+	if received := mock.Received(); len(received) != 6 || received[0].GetMethod() != protocol.InitializeMethod {
+		t.Fatalf("Expected the received messages to be recorded, got %d", len(received))
+	}
+}
+
+func TestMockServerLatency(t *testing.T) {
+	mock := NewMockServer().Delay("", time.Hour)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn := protocol.NewConn(mock.Connect(t), protocol.ConnOptions{})
+	go conn.Run(ctx)
+	defer conn.Close()
+
+	requestCtx, cancelRequest := context.WithCancel(ctx)
+	go func() {
+		mock.Expect(ctx, protocol.TextDocumentHoverMethod)
+		cancelRequest()
+	}()
+
+	if _, err := conn.Hover(requestCtx, protocol.HoverParams{}); err == nil {
+		t.Fatal("Expected the delayed request to be cancelled")
+	}
+
+	mock.Delay("", 0)
+	if _, err := conn.Initialize(ctx, protocol.InitializeParams{}); err != nil {
+		t.Fatal(err)
+	}
+}
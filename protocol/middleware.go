@@ -0,0 +1,158 @@
+package protocol
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// Middleware wraps a Handler to add behavior around every request and
+// notification it handles, e.g. logging, metrics or authorization.
+type Middleware func(Handler) Handler
+
+// Helper function that wraps handler in middleware. The first middleware is the
+// outermost one, so it sees each message first and each result last.
+func Chain(handler Handler, middleware ...Middleware) Handler {
+	for i := len(middleware) - 1; i >= 0; i-- {
+		handler = middleware[i](handler)
+	}
+
+	return handler
+}
+
+// Outgoing is a request or notification a connection sends.
+type Outgoing struct {
+	Method MethodKind
+	Params any
+	// Whether the message is a request, which is answered with a response.
+	Request bool
+	// What the result of a request is decoded into, if anything.
+	Result any
+}
+
+// Sender sends a request or notification. For requests, it returns once the
+// response was received and its result decoded.
+type Sender func(ctx context.Context, message Outgoing) error
+
+// OutgoingMiddleware wraps a Sender to add behavior around every request and
+// notification a connection sends, e.g. logging, metrics or adding params.
+type OutgoingMiddleware func(Sender) Sender
+
+// Helper function that wraps sender in middleware. The first middleware is the
+// outermost one, so it sees each message first and each response last.
+func ChainOutgoing(sender Sender, middleware ...OutgoingMiddleware) Sender {
+	for i := len(middleware) - 1; i >= 0; i-- {
+		sender = middleware[i](sender)
+	}
+
+	return sender
+}
+
+// Returns middleware that recovers from panics in the handler. A request whose
+// handler panics is answered with ErrorCodesInternalError.
+func Recover() Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, message IncomingMessage) (result any, err error) {
+			defer func() {
+				if recovered := recover(); recovered != nil {
+					result = nil
+					err = Error(int32(ErrorCodesInternalError), fmt.Errorf("panic handling %s: %v", message.GetMethod(), recovered))
+				}
+			}()
+
+			return next(ctx, message)
+		}
+	}
+}
+
+// Returns middleware that logs every message handled, with its method and how
+// long it took. Failed requests are logged at slog.LevelError, everything else
+// at slog.LevelDebug.
+func Logging(logger *slog.Logger) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, message IncomingMessage) (any, error) {
+			start := time.Now()
+			result, err := next(ctx, message)
+
+			attributes := []slog.Attr{
+				slog.String("method", string(message.GetMethod())),
+				slog.Duration("duration", time.Since(start)),
+			}
+
+			if _, isRequest := message.(Request); !isRequest {
+				logger.LogAttrs(ctx, slog.LevelDebug, "handled notification", attributes...)
+				return result, err
+			}
+
+			if err != nil {
+				logger.LogAttrs(ctx, slog.LevelError, "request failed", append(attributes, slog.String("error", err.Error()))...)
+			} else {
+				logger.LogAttrs(ctx, slog.LevelDebug, "handled request", attributes...)
+			}
+
+			return result, err
+		}
+	}
+}
+
+// Returns middleware that limits how long a request may take. Requests for a
+// method in timeouts get that timeout, and all other requests get fallback,
+// where 0 means no timeout. Notifications are never timed out.
+//
+// The context passed to the handler is cancelled once the timeout passes, and
+// the request is answered with LSPErrorCodesRequestFailed, even if the handler
+// has not returned yet.
+func Timeout(fallback time.Duration, timeouts map[MethodKind]time.Duration) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, message IncomingMessage) (any, error) {
+			timeout, exists := timeouts[message.GetMethod()]
+
+			if !exists {
+				timeout = fallback
+			}
+
+			if _, isRequest := message.(Request); !isRequest || timeout <= 0 {
+				return next(ctx, message)
+			}
+
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			type outcome struct {
+				result    any
+				err       error
+				recovered any
+			}
+
+			done := make(chan outcome, 1)
+
+			go func() {
+				var handled outcome
+
+				defer func() {
+					if recovered := recover(); recovered != nil {
+						handled.recovered = recovered
+					}
+					done <- handled
+				}()
+
+				handled.result, handled.err = next(ctx, message)
+			}()
+
+			select {
+			case handled := <-done:
+				if handled.recovered != nil {
+					// Panic in the caller's goroutine, so Recover can handle it.
+					panic(handled.recovered)
+				}
+				return handled.result, handled.err
+			case <-ctx.Done():
+				if ctx.Err() == context.DeadlineExceeded {
+					return nil, Error(int32(LSPErrorCodesRequestFailed), fmt.Errorf("%s timed out after %s", message.GetMethod(), timeout))
+				}
+				return nil, ctx.Err()
+			}
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package protocol
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestChainOrder(t *testing.T) {
+	var calls []string
+
+	record := func(name string) Middleware {
+		return func(next Handler) Handler {
+			return func(ctx context.Context, message IncomingMessage) (any, error) {
+				calls = append(calls, name)
+				return next(ctx, message)
+			}
+		}
+	}
+
+	handler := Chain(func(ctx context.Context, message IncomingMessage) (any, error) {
+		calls = append(calls, "handler")
+		return nil, nil
+	}, record("first"), record("second"))
+
+	handler(context.Background(), InitializedNotification{})
+
+	if strings.Join(calls, ",") != "first,second,handler" {
+		t.Fatalf("Unexpected order: %v", calls)
+	}
+}
+
+func TestOutgoingMiddleware(t *testing.T) {
+	var sent []string
+
+	record := func(name string) OutgoingMiddleware {
+		return func(next Sender) Sender {
+			return func(ctx context.Context, message Outgoing) error {
+				sent = append(sent, name+" "+string(message.Method))
+				return next(ctx, message)
+			}
+		}
+	}
+
+	client, _ := connPair(t, ConnOptions{
+		OutgoingMiddleware: []OutgoingMiddleware{record("first"), record("second")},
+	}, ConnOptions{
+		Handler: func(ctx context.Context, message IncomingMessage) (any, error) {
+			return nil, nil
+		},
+	})
+
+	if err := client.Shutdown(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Exit(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "first shutdown,second shutdown,first exit,second exit"
+	if strings.Join(sent, ",") != expected {
+		t.Fatalf("Expected %s, got %v", expected, sent)
+	}
+}
+
+func TestOutgoingMiddlewareSeesRequests(t *testing.T) {
+	fail := errors.New("blocked")
+
+	client, _ := connPair(t, ConnOptions{
+		OutgoingMiddleware: []OutgoingMiddleware{func(next Sender) Sender {
+			return func(ctx context.Context, message Outgoing) error {
+				if message.Request && message.Method == ShutdownMethod {
+					return fail
+				}
+				return next(ctx, message)
+			}
+		}},
+	}, ConnOptions{})
+
+	if err := client.Shutdown(context.Background()); !errors.Is(err, fail) {
+		t.Fatalf("Expected the middleware's error, got %v", err)
+	}
+}
+
+func TestRecoverMiddleware(t *testing.T) {
+	client, _ := connPair(t, ConnOptions{}, ConnOptions{
+		Handler: func(ctx context.Context, message IncomingMessage) (any, error) {
+			panic("boom")
+		},
+		Middleware: []Middleware{Recover()},
+	})
+
+	err := client.Shutdown(context.Background())
+
+	var responseError *ResponseError
+	if !errors.As(err, &responseError) || responseError.Code != int32(ErrorCodesInternalError) {
+		t.Fatalf("Expected InternalError response error, got %v", err)
+	}
+	if !strings.Contains(responseError.Message, "boom") {
+		t.Fatalf("Expected the panic in the message, got %s", responseError.Message)
+	}
+}
+
+func TestTimeoutMiddleware(t *testing.T) {
+	client, _ := connPair(t, ConnOptions{}, ConnOptions{
+		Handler: func(ctx context.Context, message IncomingMessage) (any, error) {
+			if _, ok := message.(ShutdownRequest); ok {
+				time.Sleep(time.Second)
+			}
+			return nil, nil
+		},
+		Middleware: []Middleware{Recover(), Timeout(0, map[MethodKind]time.Duration{ShutdownMethod: 10 * time.Millisecond})},
+	})
+
+	err := client.Shutdown(context.Background())
+
+	var responseError *ResponseError
+	if !errors.As(err, &responseError) || responseError.Code != int32(LSPErrorCodesRequestFailed) {
+		t.Fatalf("Expected RequestFailed response error, got %v", err)
+	}
+
+	// Requests without a timeout are not affected.
+	if err := client.SemanticTokensRefresh(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTimeoutMiddlewarePanics(t *testing.T) {
+	handler := Chain(func(ctx context.Context, message IncomingMessage) (any, error) {
+		panic("boom")
+	}, Recover(), Timeout(time.Second, nil))
+
+	_, err := handler(context.Background(), ShutdownRequest{})
+
+	if err == nil || !strings.Contains(err.Error(), "boom") {
+		t.Fatalf("Expected the panic to be recovered, got %v", err)
+	}
+}
+
+func TestLoggingMiddleware(t *testing.T) {
+	var buffer bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buffer, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	handler := Chain(func(ctx context.Context, message IncomingMessage) (any, error) {
+		return nil, errors.New("failed")
+	}, Logging(logger))
+
+	handler(context.Background(), ShutdownRequest{Method: ShutdownMethod})
+
+	output := buffer.String()
+	if !strings.Contains(output, "level=ERROR") || !strings.Contains(output, "method=shutdown") || !strings.Contains(output, "error=failed") {
+		t.Fatalf("Unexpected log output: %s", output)
+	}
+}
@@ -0,0 +1,167 @@
+// Package recording records the messages a connection exchanges to a JSONL
+// file, and replays recordings against a server to compare its responses with
+// the recorded ones.
+package recording
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+)
+
+// The direction a recorded message was sent in.
+type Direction string
+
+const (
+	ClientToServer Direction = "client-to-server"
+	ServerToClient Direction = "server-to-client"
+)
+
+// Returns the other direction.
+func (d Direction) Reverse() Direction {
+	if d == ClientToServer {
+		return ServerToClient
+	}
+
+	return ClientToServer
+}
+
+// Entry is a recorded message, one per line of a recording.
+type Entry struct {
+	Time      time.Time       `json:"time"`
+	Direction Direction       `json:"direction"`
+	Message   json.RawMessage `json:"message"`
+}
+
+// Recorder wraps a transport and records every message read from or written to
+// it. It can wrap either side of a connection: a server wrapping its stdio
+// writes ServerToClient messages, and a client wrapping the server's writes
+// ClientToServer messages.
+type Recorder struct {
+	rwc     io.ReadWriteCloser
+	written Direction
+
+	mu      sync.Mutex
+	encoder *json.Encoder
+	err     error
+
+	// Bytes of a message that were only partly read or written so far.
+	reading []byte
+	writing []byte
+}
+
+// Creates a recorder around rwc that writes entries to output. written is the
+// direction of the messages written to rwc. Messages read from it are recorded
+// in the other direction.
+func NewRecorder(rwc io.ReadWriteCloser, output io.Writer, written Direction) *Recorder {
+	return &Recorder{
+		rwc:     rwc,
+		written: written,
+		encoder: json.NewEncoder(output),
+	}
+}
+
+func (r *Recorder) Read(p []byte) (int, error) {
+	n, err := r.rwc.Read(p)
+
+	if n > 0 {
+		r.mu.Lock()
+		r.reading = r.record(append(r.reading, p[:n]...), r.written.Reverse())
+		r.mu.Unlock()
+	}
+
+	return n, err
+}
+
+// Records the messages in p before writing them, so they are recorded before
+// the messages the other side sends in response.
+func (r *Recorder) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	r.writing = r.record(append(r.writing, p...), r.written)
+	r.mu.Unlock()
+
+	return r.rwc.Write(p)
+}
+
+// Closes the wrapped transport.
+func (r *Recorder) Close() error {
+	return r.rwc.Close()
+}
+
+// Returns the first error writing an entry, if any. Messages keep flowing
+// through the recorder when recording fails.
+func (r *Recorder) Err() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.err
+}
+
+// Records the complete messages at the start of data, and returns what is left.
+func (r *Recorder) record(data []byte, direction Direction) []byte {
+	for {
+		advance, token, err := protocol.Split(data, false)
+
+		if err != nil {
+			// Not a framed message, so there is nothing to record.
+			r.fail(err)
+			return nil
+		}
+
+		if token == nil {
+			return data
+		}
+
+		_, contentLength, content, err := protocol.SplitMessage(token)
+
+		if err != nil {
+			r.fail(err)
+		} else {
+			r.write(Entry{Time: time.Now(), Direction: direction, Message: content[:contentLength]})
+		}
+
+		data = data[advance:]
+	}
+}
+
+func (r *Recorder) write(entry Entry) {
+	if r.err != nil {
+		return
+	}
+
+	if !json.Valid(entry.Message) {
+		r.fail(errors.New("recorded message is not json"))
+		return
+	}
+
+	r.fail(r.encoder.Encode(entry))
+}
+
+func (r *Recorder) fail(err error) {
+	if r.err == nil {
+		r.err = err
+	}
+}
+
+// Helper function that reads the entries of a recording.
+func ReadEntries(r io.Reader) ([]Entry, error) {
+	decoder := json.NewDecoder(r)
+	var entries []Entry
+
+	for {
+		var entry Entry
+
+		if err := decoder.Decode(&entry); err != nil {
+			if errors.Is(err, io.EOF) {
+				return entries, nil
+			}
+			return entries, err
+		}
+
+		entries = append(entries, entry)
+	}
+}
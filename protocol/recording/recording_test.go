@@ -0,0 +1,161 @@
+package recording
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+)
+
+// Returns a server handler that answers hovers with the text hover returns.
+func hoverServer(hover func(uri protocol.DocumentUri) string) protocol.Handler {
+	return func(ctx context.Context, message protocol.IncomingMessage) (any, error) {
+		if request, ok := message.(protocol.HoverRequest); ok {
+			return protocol.Hover{
+				Contents: protocol.Or3[protocol.MarkupContent, protocol.MarkedString, []protocol.MarkedString]{
+					Value: protocol.MarkupContent{Kind: protocol.MarkupKindPlainText, Value: hover(request.Params.TextDocument.Uri)},
+				},
+			}, nil
+		}
+		return nil, nil
+	}
+}
+
+func hoverParams(uri protocol.DocumentUri) protocol.HoverParams {
+	return protocol.HoverParams{TextDocument: protocol.TextDocumentIdentifier{Uri: uri}}
+}
+
+// Records a client sending two hovers and a notification.
+func record(t *testing.T) []Entry {
+	var output bytes.Buffer
+	clientSide, serverSide := net.Pipe()
+	recorder := NewRecorder(clientSide, &output, ClientToServer)
+
+	client := protocol.NewConn(recorder, protocol.ConnOptions{})
+	server := protocol.NewConn(serverSide, protocol.ConnOptions{
+		Handler: hoverServer(func(uri protocol.DocumentUri) string { return string(uri) }),
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go client.Run(ctx)
+	go server.Run(ctx)
+
+	for _, uri := range []protocol.DocumentUri{"file:///a.go", "file:///b.go"} {
+		if _, err := client.Hover(ctx, hoverParams(uri)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := client.Initialized(ctx, protocol.InitializedParams{}); err != nil {
+		t.Fatal(err)
+	}
+
+	client.Close()
+
+	if err := recorder.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := ReadEntries(&output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return entries
+}
+
+func TestRecorder(t *testing.T) {
+	entries := record(t)
+
+	if len(entries) != 5 {
+		t.Fatalf("Expected 5 entries, got %d", len(entries))
+	}
+
+	directions := []Direction{ClientToServer, ServerToClient, ClientToServer, ServerToClient, ClientToServer}
+	for i, entry := range entries {
+		if entry.Direction != directions[i] || entry.Time.IsZero() {
+			t.Fatalf("Unexpected entry %d: %+v", i, entry)
+		}
+	}
+}
+
+func TestReplayDiffsResponses(t *testing.T) {
+	entries := record(t)
+
+	replaySide, serverSide := net.Pipe()
+	handled := make(chan protocol.IncomingMessage, 10)
+	server := protocol.NewConn(serverSide, protocol.ConnOptions{
+		Handler: protocol.Chain(
+			hoverServer(func(uri protocol.DocumentUri) string {
+				if uri == "file:///b.go" {
+					return "changed"
+				}
+				return string(uri)
+			}),
+			func(next protocol.Handler) protocol.Handler {
+				return func(ctx context.Context, message protocol.IncomingMessage) (any, error) {
+					handled <- message
+					return next(ctx, message)
+				}
+			},
+		),
+	})
+	go server.Run(context.Background())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	differences, err := Replay(ctx, entries, replaySide, ReplayOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(differences) != 1 {
+		t.Fatalf("Expected 1 difference, got %+v", differences)
+	}
+	if differences[0].ID != "2" || differences[0].Method != protocol.TextDocumentHoverMethod || !bytes.Contains(differences[0].Replayed, []byte("changed")) {
+		t.Fatalf("Unexpected difference: %+v", differences[0])
+	}
+	for i := range 3 {
+		select {
+		case <-handled:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Expected the server to receive 3 messages, got %d", i)
+		}
+	}
+}
+
+func TestReplayServerExitsEarly(t *testing.T) {
+	entries := record(t)
+
+	replaySide, serverSide := net.Pipe()
+	server := protocol.NewConn(serverSide, protocol.ConnOptions{
+		Handler: func(ctx context.Context, message protocol.IncomingMessage) (any, error) {
+			if request, ok := message.(protocol.HoverRequest); ok && request.Params.TextDocument.Uri == "file:///b.go" {
+				// Exits without answering the second hover.
+				protocol.ConnFromContext(ctx).Close()
+				return nil, nil
+			}
+			return hoverServer(func(uri protocol.DocumentUri) string { return string(uri) })(ctx, message)
+		},
+	})
+	go server.Run(context.Background())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	differences, err := Replay(ctx, entries, replaySide, ReplayOptions{})
+	if err != nil {
+		t.Fatalf("Expected the replay to end with the server, got %v", err)
+	}
+
+	if len(differences) != 1 {
+		t.Fatalf("Expected 1 difference, got %+v", differences)
+	}
+	if differences[0].ID != "2" || differences[0].Recorded == nil || differences[0].Replayed != nil {
+		t.Fatalf("Expected the unanswered hover as a difference, got %+v", differences[0])
+	}
+}
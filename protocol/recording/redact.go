@@ -0,0 +1,372 @@
+package recording
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"reflect"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+)
+
+// Fields whose strings are source code or derived from it, such as the names
+// of symbols, as "Type.Field" of the protocol's Go types.
+var DefaultTextFields = []string{
+	"TextDocumentItem.Text",
+	"TextDocumentContentChangePartial.Text",
+	"TextDocumentContentChangeWholeDocument.Text",
+	"DidSaveTextDocumentParams.Text",
+	"TextDocumentContentResult.Text",
+	"Hover.Contents",
+	"TextEdit.NewText",
+	"AnnotatedTextEdit.NewText",
+	"SnippetTextEdit.Snippet",
+	"CompletionItem.Label",
+	"CompletionItem.Detail",
+	"CompletionItem.Documentation",
+	"CompletionItem.InsertText",
+	"CompletionItem.TextEdit",
+	"CompletionItem.FilterText",
+	"CompletionItem.SortText",
+	"CompletionItem.TextEditText",
+	"CompletionItemLabelDetails.Detail",
+	"CompletionItemLabelDetails.Description",
+	"InlineCompletionItem.InsertText",
+	"InlayHint.Label",
+	"InlayHint.Tooltip",
+	"InlayHintLabelPart.Value",
+	"InlayHintLabelPart.Tooltip",
+	"SignatureInformation.Label",
+	"SignatureInformation.Documentation",
+	"ParameterInformation.Label",
+	"ParameterInformation.Documentation",
+	"DocumentSymbol.Name",
+	"DocumentSymbol.Detail",
+	"SymbolInformation.Name",
+	"SymbolInformation.ContainerName",
+	"WorkspaceSymbol.Name",
+	"WorkspaceSymbol.ContainerName",
+	"CallHierarchyItem.Name",
+	"CallHierarchyItem.Detail",
+	"TypeHierarchyItem.Name",
+	"TypeHierarchyItem.Detail",
+	"RenameParams.NewName",
+	"CodeAction.Title",
+	"CodeActionDisabled.Reason",
+	"Command.Title",
+	"Command.Arguments",
+	"Diagnostic.Message",
+	"DiagnosticRelatedInformation.Message",
+	"LogMessageParams.Message",
+	"ShowMessageParams.Message",
+	"ShowMessageRequestParams.Message",
+	"MessageActionItem.Title",
+	"LogTraceParams.Message",
+	"LogTraceParams.Verbose",
+}
+
+// Fields whose strings are file paths.
+var DefaultPathFields = []string{
+	"InitializeParams.RootPath",
+	"WorkspaceFolder.Name",
+}
+
+// Fields that are removed, because they hold arbitrary settings or data.
+var DefaultScrubbedFields = []string{
+	"InitializeParams.InitializationOptions",
+	"DidChangeConfigurationParams.Settings",
+	"ExecuteCommandParams.Arguments",
+	"NotebookDocument.Metadata",
+	"NotebookCell.Metadata",
+	"CompletionItem.Data",
+	"CompletionItemDefaults.Data",
+	"CodeAction.Data",
+	"CodeLens.Data",
+	"DocumentLink.Data",
+	"InlayHint.Data",
+	"Diagnostic.Data",
+	"WorkspaceSymbol.Data",
+	"CallHierarchyItem.Data",
+	"TypeHierarchyItem.Data",
+}
+
+// Redactor removes proprietary code and paths from messages, so recordings and
+// traces can be shared.
+//
+// Text fields are replaced with placeholders of the same length in Encoding,
+// keeping whitespace, so positions and ranges stay valid. Every DocumentUri and
+// URI, and the path fields, have each path segment replaced with a hash, which
+// is the same for the same segment throughout a session, so messages about
+// the same file still refer to the same file. Strings of arbitrary json, such
+// as LSPAny values, are hashed the same way when they are file uris. Scrubbed
+// fields are removed.
+type Redactor struct {
+	// Makes the hashes of path segments impossible to look up. Use the same
+	// salt for a whole session.
+	Salt string
+	// The encoding positions are counted in. Defaults to UTF-16.
+	Encoding protocol.PositionEncodingKind
+	// Fields, as "Type.Field", whose strings are replaced with placeholders.
+	TextFields []string
+	// Fields whose strings are file paths.
+	PathFields []string
+	// Fields that are removed.
+	ScrubbedFields []string
+}
+
+// Creates a redactor with the default fields.
+func NewRedactor(salt string) *Redactor {
+	return &Redactor{
+		Salt:           salt,
+		Encoding:       protocol.PositionEncodingKindUTF16,
+		TextFields:     slices.Clone(DefaultTextFields),
+		PathFields:     slices.Clone(DefaultPathFields),
+		ScrubbedFields: slices.Clone(DefaultScrubbedFields),
+	}
+}
+
+// How the strings of a value are redacted.
+type redaction int
+
+const (
+	redactNone redaction = iota
+	redactText
+	redactPath
+)
+
+var (
+	documentUriType = reflect.TypeFor[protocol.DocumentUri]()
+	uriType         = reflect.TypeFor[protocol.URI]()
+	stringType      = reflect.TypeFor[string]()
+)
+
+// Returns a redacted copy of message.
+func (r *Redactor) Redact(message protocol.Message) protocol.Message {
+	if message == nil {
+		return nil
+	}
+
+	return r.redact(reflect.ValueOf(message), redactNone).Interface().(protocol.Message)
+}
+
+func (r *Redactor) redact(value reflect.Value, mode redaction) reflect.Value {
+	switch value.Type() {
+	case documentUriType, uriType:
+		return reflect.ValueOf(r.RedactURI(value.String())).Convert(value.Type())
+	case stringType:
+		switch mode {
+		case redactText:
+			return reflect.ValueOf(r.placeholder(value.String()))
+		case redactPath:
+			return reflect.ValueOf(r.RedactPath(value.String()))
+		}
+		return value
+	}
+
+	switch value.Kind() {
+	case reflect.Pointer:
+		if value.IsNil() {
+			return value
+		}
+		copied := reflect.New(value.Type().Elem())
+		copied.Elem().Set(r.redact(value.Elem(), mode))
+		return copied
+	case reflect.Interface:
+		if value.IsNil() {
+			return value
+		}
+		copied := reflect.New(value.Type()).Elem()
+		if element := value.Elem(); element.Type() == stringType && strings.HasPrefix(element.String(), "file:") {
+			copied.Set(reflect.ValueOf(r.RedactURI(element.String())))
+			return copied
+		}
+		copied.Set(r.redact(value.Elem(), mode))
+		return copied
+	case reflect.Slice:
+		if value.IsNil() {
+			return value
+		}
+		copied := reflect.MakeSlice(value.Type(), value.Len(), value.Len())
+		for i := range value.Len() {
+			copied.Index(i).Set(r.redact(value.Index(i), mode))
+		}
+		return copied
+	case reflect.Map:
+		if value.IsNil() {
+			return value
+		}
+		copied := reflect.MakeMapWithSize(value.Type(), value.Len())
+		for _, key := range value.MapKeys() {
+			// Keys are only redacted when they are uris, e.g. WorkspaceEdit.changes.
+			copied.SetMapIndex(r.redact(key, redactNone), r.redact(value.MapIndex(key), mode))
+		}
+		return copied
+	case reflect.Struct:
+		copied := reflect.New(value.Type()).Elem()
+		copied.Set(value)
+		for i := range value.NumField() {
+			field := value.Type().Field(i)
+
+			if !field.IsExported() {
+				continue
+			}
+
+			name := value.Type().Name() + "." + field.Name
+			fieldMode := mode
+
+			switch {
+			case slices.Contains(r.ScrubbedFields, name):
+				copied.Field(i).SetZero()
+				continue
+			case slices.Contains(r.TextFields, name):
+				fieldMode = redactText
+			case slices.Contains(r.PathFields, name):
+				fieldMode = redactPath
+			}
+
+			copied.Field(i).Set(r.redact(value.Field(i), fieldMode))
+		}
+		return copied
+	}
+
+	return value
+}
+
+// Returns a placeholder for text with the same length in r.Encoding, keeping
+// whitespace so lines and indentation stay the same.
+func (r *Redactor) placeholder(text string) string {
+	var builder strings.Builder
+
+	for _, character := range text {
+		if unicode.IsSpace(character) {
+			builder.WriteRune(character)
+			continue
+		}
+
+		length := 1
+
+		switch r.Encoding {
+		case protocol.PositionEncodingKindUTF8:
+			length = utf8.RuneLen(character)
+		case protocol.PositionEncodingKindUTF32:
+		default:
+			length = utf16.RuneLen(character)
+		}
+
+		builder.WriteString(strings.Repeat("x", max(length, 1)))
+	}
+
+	return builder.String()
+}
+
+// Returns uri with its authority, path segments and query hashed. The scheme
+// and file extensions are kept.
+func (r *Redactor) RedactURI(uri string) string {
+	parsed, err := url.Parse(uri)
+
+	if err != nil || parsed.Scheme == "" {
+		return r.hash(uri)
+	}
+
+	if parsed.Opaque != "" {
+		return parsed.Scheme + ":" + r.RedactPath(parsed.Opaque)
+	}
+
+	redacted := parsed.Scheme + "://"
+
+	if parsed.Host != "" {
+		redacted += r.hash(parsed.Host)
+	}
+
+	redacted += r.RedactPath(parsed.Path)
+
+	if parsed.RawQuery != "" {
+		redacted += "?" + r.hash(parsed.RawQuery)
+	}
+
+	return redacted
+}
+
+// Returns path with each segment hashed, keeping separators and file
+// extensions.
+func (r *Redactor) RedactPath(value string) string {
+	segments := strings.FieldsFunc(value, func(character rune) bool {
+		return character == '/' || character == '\\'
+	})
+
+	var builder strings.Builder
+	rest := value
+
+	for _, segment := range segments {
+		index := strings.Index(rest, segment)
+		builder.WriteString(rest[:index])
+		builder.WriteString(r.hash(strings.TrimSuffix(segment, path.Ext(segment))) + path.Ext(segment))
+		rest = rest[index+len(segment):]
+	}
+	builder.WriteString(rest)
+
+	return builder.String()
+}
+
+func (r *Redactor) hash(value string) string {
+	sum := sha256.Sum256([]byte(r.Salt + value))
+
+	return hex.EncodeToString(sum[:4])
+}
+
+// Redacts the messages of a recording. Responses are decoded with the typed
+// response of the request they answer. Messages that can't be decoded are
+// reported as an error rather than kept as they are.
+func (r *Redactor) RedactEntries(entries []Entry, extensions ...protocol.Extension) ([]Entry, error) {
+	// The methods of requests, by the direction they were sent in and id.
+	methods := map[string]protocol.MethodKind{}
+	redacted := make([]Entry, 0, len(entries))
+
+	for _, entry := range entries {
+		var message envelope
+
+		if err := json.Unmarshal(entry.Message, &message); err != nil {
+			return nil, err
+		}
+
+		var decoded protocol.Message
+		var err error
+
+		if message.isResponse() {
+			method := methods[string(entry.Direction.Reverse())+idKey(message.ID)]
+			decoded, err = protocol.DecodeResponse(method, entry.Message, extensions...)
+		} else {
+			framed, encodeErr := protocol.EncodeMessage(entry.Message)
+			if encodeErr != nil {
+				return nil, encodeErr
+			}
+			decoded, err = protocol.DecodeMessage(framed, extensions...)
+
+			if message.isRequest() {
+				methods[string(entry.Direction)+idKey(message.ID)] = protocol.MethodKind(message.Method)
+			}
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("decoding %s message: %w", entry.Direction, err)
+		}
+
+		content, err := json.Marshal(r.Redact(decoded))
+
+		if err != nil {
+			return nil, err
+		}
+
+		redacted = append(redacted, Entry{Time: entry.Time, Direction: entry.Direction, Message: content})
+	}
+
+	return redacted, nil
+}
@@ -0,0 +1,158 @@
+package recording
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+)
+
+func TestRedactText(t *testing.T) {
+	redactor := NewRedactor("salt")
+	text := "func main() {\n\tfmt.Println(\"h😀llo\")\n}\n"
+
+	redacted := redactor.Redact(protocol.DidOpenTextDocumentNotification{
+		Method: protocol.TextDocumentDidOpenMethod,
+		Params: protocol.DidOpenTextDocumentParams{
+			TextDocument: protocol.TextDocumentItem{
+				Uri:        "file:///home/me/acme/main.go",
+				LanguageId: protocol.LanguageKindGo,
+				Text:       text,
+			},
+		},
+	}).(protocol.DidOpenTextDocumentNotification)
+
+	document := redacted.Params.TextDocument
+
+	if document.Text == text || strings.ContainsAny(document.Text, "fmain") {
+		t.Fatalf("Expected the text to be redacted, got %q", document.Text)
+	}
+	if len(utf16.Encode([]rune(document.Text))) != len(utf16.Encode([]rune(text))) || strings.Count(document.Text, "\n") != 3 {
+		t.Fatalf("Expected the same UTF-16 length and lines, got %q", document.Text)
+	}
+	if document.LanguageId != protocol.LanguageKindGo || redacted.Method != protocol.TextDocumentDidOpenMethod {
+		t.Fatalf("Expected other fields to be kept, got %+v", redacted)
+	}
+
+	if strings.Contains(string(document.Uri), "acme") || !strings.HasPrefix(string(document.Uri), "file:///") || !strings.HasSuffix(string(document.Uri), ".go") {
+		t.Fatalf("Expected the uri's segments to be hashed, got %s", document.Uri)
+	}
+	if redactor.RedactURI("file:///home/me/acme/util.go")[:len("file:///")+18] != string(document.Uri)[:len("file:///")+18] {
+		t.Fatal("Expected the same directories to hash the same")
+	}
+}
+
+func TestRedactNestedFields(t *testing.T) {
+	redactor := NewRedactor("salt")
+	redactor.ScrubbedFields = append(redactor.ScrubbedFields, "Hover.Range")
+
+	response := redactor.Redact(protocol.HoverResponse{
+		Result: &protocol.Hover{
+			Contents: protocol.Or3[protocol.MarkupContent, protocol.MarkedString, []protocol.MarkedString]{
+				Value: protocol.MarkupContent{Kind: protocol.MarkupKindMarkdown, Value: "func secret()"},
+			},
+			Range: &protocol.Range{},
+		},
+	}).(protocol.HoverResponse)
+
+	contents := response.Result.Contents.Value.(protocol.MarkupContent)
+	if contents.Value != "xxxx xxxxxxxx" || contents.Kind != protocol.MarkupKindMarkdown {
+		t.Fatalf("Expected the hover to be redacted, got %+v", contents)
+	}
+	if response.Result.Range != nil {
+		t.Fatal("Expected the scrubbed field to be removed")
+	}
+
+	edit := redactor.Redact(protocol.ApplyWorkspaceEditRequest{
+		Params: protocol.ApplyWorkspaceEditParams{
+			Edit: protocol.WorkspaceEdit{
+				Changes: map[protocol.DocumentUri][]protocol.TextEdit{"file:///acme/a.go": {{NewText: "secret"}}},
+			},
+		},
+	}).(protocol.ApplyWorkspaceEditRequest)
+
+	for uri, edits := range edit.Params.Edit.Changes {
+		if strings.Contains(string(uri), "acme") || edits[0].NewText != "xxxxxx" {
+			t.Fatalf("Expected the changes to be redacted, got %s: %+v", uri, edits)
+		}
+	}
+}
+
+func TestRedactEntries(t *testing.T) {
+	entries := record(t)
+
+	redacted, err := NewRedactor("salt").RedactEntries(entries)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(redacted) != len(entries) {
+		t.Fatalf("Expected %d entries, got %d", len(entries), len(redacted))
+	}
+	for _, entry := range redacted {
+		if bytes.Contains(entry.Message, []byte("file:///a.go")) || bytes.Contains(entry.Message, []byte("file:///b.go")) {
+			t.Fatalf("Expected the paths to be redacted, got %s", entry.Message)
+		}
+	}
+}
+
+func TestRedactSession(t *testing.T) {
+	// Every identifier and path of the session, which must not survive.
+	identifiers := []string{"Frobnicate", "frobnicate", "Widget", "widget", "acme", "/home/me"}
+
+	session := []struct {
+		direction Direction
+		message   string
+	}{
+		{ClientToServer, `{"jsonrpc":"2.0","method":"textDocument/didOpen","params":{"textDocument":{"uri":"file:///home/me/acme/widget.go","languageId":"go","version":1,"text":"func Frobnicate(w Widget) {}"}}}`},
+		{ClientToServer, `{"jsonrpc":"2.0","id":1,"method":"textDocument/documentSymbol","params":{"textDocument":{"uri":"file:///home/me/acme/widget.go"}}}`},
+		{ServerToClient, `{"jsonrpc":"2.0","id":1,"result":[{"name":"Frobnicate","detail":"func(w Widget)","kind":12,"range":{"start":{"line":0,"character":0},"end":{"line":0,"character":28}},"selectionRange":{"start":{"line":0,"character":5},"end":{"line":0,"character":15}},"children":[{"name":"w","detail":"Widget","kind":13,"range":{"start":{"line":0,"character":16},"end":{"line":0,"character":24}},"selectionRange":{"start":{"line":0,"character":16},"end":{"line":0,"character":17}}}]}]}`},
+		{ClientToServer, `{"jsonrpc":"2.0","id":2,"method":"workspace/symbol","params":{"query":"Frob"}}`},
+		{ServerToClient, `{"jsonrpc":"2.0","id":2,"result":[{"name":"Frobnicate","containerName":"acme","kind":12,"location":{"uri":"file:///home/me/acme/widget.go"},"data":{"symbol":"acme.Frobnicate"}}]}`},
+		{ServerToClient, `{"jsonrpc":"2.0","method":"textDocument/publishDiagnostics","params":{"uri":"file:///home/me/acme/widget.go","diagnostics":[{"range":{"start":{"line":0,"character":16},"end":{"line":0,"character":24}},"message":"undefined: Widget","relatedInformation":[{"location":{"uri":"file:///home/me/acme/types.go","range":{"start":{"line":3,"character":0},"end":{"line":3,"character":6}}},"message":"did you mean widget?"}],"data":{"fix":"file:///home/me/acme/types.go"}}]}}`},
+		{ClientToServer, `{"jsonrpc":"2.0","id":3,"method":"textDocument/codeAction","params":{"textDocument":{"uri":"file:///home/me/acme/widget.go"},"range":{"start":{"line":0,"character":16},"end":{"line":0,"character":24}},"context":{"diagnostics":[]}}}`},
+		{ServerToClient, `{"jsonrpc":"2.0","id":3,"result":[{"title":"Rename Widget to widget","command":{"title":"Frobnicate widget","command":"server.rename","arguments":["file:///home/me/acme/widget.go",{"from":"Widget","uri":"file:///home/me/acme/widget.go"}]},"data":{"name":"Widget"}},{"title":"Move Frobnicate","disabled":{"reason":"Frobnicate is exported"}}]}`},
+		{ClientToServer, `{"jsonrpc":"2.0","id":4,"method":"textDocument/rename","params":{"textDocument":{"uri":"file:///home/me/acme/widget.go"},"position":{"line":0,"character":5},"newName":"Frobnicate2"}}`},
+		{ClientToServer, `{"jsonrpc":"2.0","id":5,"method":"textDocument/signatureHelp","params":{"textDocument":{"uri":"file:///home/me/acme/widget.go"},"position":{"line":0,"character":5}}}`},
+		{ServerToClient, `{"jsonrpc":"2.0","id":5,"result":{"signatures":[{"label":"Frobnicate(w Widget)","parameters":[{"label":"w Widget"},{"label":[11,19]}]}]}}`},
+		{ClientToServer, `{"jsonrpc":"2.0","id":6,"method":"textDocument/prepareCallHierarchy","params":{"textDocument":{"uri":"file:///home/me/acme/widget.go"},"position":{"line":0,"character":5}}}`},
+		{ServerToClient, `{"jsonrpc":"2.0","id":6,"result":[{"name":"Frobnicate","detail":"acme.Frobnicate","kind":12,"uri":"file:///home/me/acme/widget.go","range":{"start":{"line":0,"character":0},"end":{"line":0,"character":28}},"selectionRange":{"start":{"line":0,"character":5},"end":{"line":0,"character":15}},"data":"acme.Frobnicate"}]}`},
+		{ClientToServer, `{"jsonrpc":"2.0","id":7,"method":"textDocument/prepareTypeHierarchy","params":{"textDocument":{"uri":"file:///home/me/acme/widget.go"},"position":{"line":0,"character":18}}}`},
+		{ServerToClient, `{"jsonrpc":"2.0","id":7,"result":[{"name":"Widget","detail":"acme.Widget","kind":23,"uri":"file:///home/me/acme/types.go","range":{"start":{"line":3,"character":0},"end":{"line":3,"character":6}},"selectionRange":{"start":{"line":3,"character":0},"end":{"line":3,"character":6}},"data":{"type":"Widget"}}]}`},
+		{ClientToServer, `{"jsonrpc":"2.0","id":8,"method":"textDocument/codeLens","params":{"textDocument":{"uri":"file:///home/me/acme/widget.go"}}}`},
+		{ServerToClient, `{"jsonrpc":"2.0","id":8,"result":[{"range":{"start":{"line":0,"character":5},"end":{"line":0,"character":15}},"command":{"title":"Run Frobnicate","command":"server.run","arguments":["Frobnicate"]},"data":"file:///home/me/acme/widget.go"}]}`},
+		{ClientToServer, `{"jsonrpc":"2.0","id":9,"method":"textDocument/completion","params":{"textDocument":{"uri":"file:///home/me/acme/widget.go"},"position":{"line":0,"character":5}}}`},
+		{ServerToClient, `{"jsonrpc":"2.0","id":9,"result":[{"label":"Frobnicate","labelDetails":{"detail":"(w Widget)","description":"acme"},"filterText":"Frobnicate","sortText":"frobnicate","data":{"uri":"file:///home/me/acme/widget.go"}}]}`},
+		{ServerToClient, `{"jsonrpc":"2.0","id":1,"method":"window/showMessageRequest","params":{"type":3,"message":"Frobnicate every Widget?","actions":[{"title":"Frobnicate"}]}}`},
+		{ClientToServer, `{"jsonrpc":"2.0","id":1,"result":{"title":"Frobnicate"}}`},
+		{ServerToClient, `{"jsonrpc":"2.0","id":2,"method":"workspace/configuration","params":{"items":[{"scopeUri":"file:///home/me/acme","section":"go"}]}}`},
+		{ClientToServer, `{"jsonrpc":"2.0","id":2,"result":[{"gopath":"file:///home/me/acme/go"}]}`},
+	}
+
+	var entries []Entry
+	for _, message := range session {
+		entries = append(entries, Entry{Direction: message.direction, Message: json.RawMessage(message.message)})
+	}
+
+	redacted, err := NewRedactor("salt").RedactEntries(entries)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, entry := range redacted {
+		for _, identifier := range identifiers {
+			if bytes.Contains(entry.Message, []byte(identifier)) {
+				t.Fatalf("Expected %q to be redacted from %s, got %s", identifier, session[i].message, entry.Message)
+			}
+		}
+	}
+
+	// Uris in arbitrary json are hashed like every other uri.
+	configuration := string(redacted[len(redacted)-1].Message)
+	if !strings.Contains(configuration, NewRedactor("salt").RedactURI("file:///home/me/acme/go")) {
+		t.Fatalf("Expected the uri in the configuration to be hashed, got %s", configuration)
+	}
+}
@@ -0,0 +1,286 @@
+package recording
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"reflect"
+	"sync"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+)
+
+// ReplayOptions configures Replay.
+type ReplayOptions struct {
+	// Methods that are not part of the protocol, which requests and responses
+	// are decoded for.
+	Extensions []protocol.Extension
+}
+
+// Difference is a recorded request whose response from the replayed server
+// differs from the recorded response.
+type Difference struct {
+	// The id of the request, as json.
+	ID     string
+	Method protocol.MethodKind
+	// The recorded response, or nil if none was recorded.
+	Recorded json.RawMessage
+	// The response of the replayed server, or nil if it didn't respond.
+	Replayed json.RawMessage
+}
+
+// The id, method and kind of a recorded or replayed message.
+type envelope struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+}
+
+func (e envelope) isResponse() bool {
+	return e.Method == "" && len(e.ID) > 0 && string(e.ID) != "null"
+}
+
+func (e envelope) isRequest() bool {
+	return e.Method != "" && len(e.ID) > 0
+}
+
+// Feeds the ClientToServer messages of a recording to server, and returns the
+// requests whose responses differ from the recorded ones. Responses are matched
+// to requests by id, and compared after decoding them with the typed response
+// of the request's method.
+//
+// Messages are sent in the recorded order, and wait for the responses that
+// were recorded before them, so e.g. exit is only sent once shutdown was
+// answered. Responses to requests from the server are sent once the replayed
+// server sent the request. server is closed once the replay is done. When ctx
+// is done first, the differences so far are returned along with ctx's error.
+//
+// When the server stops reading or writing before the replay is done, nothing
+// more is sent, and the requests it didn't answer are returned as differences
+// without a replayed response.
+func Replay(ctx context.Context, entries []Entry, server io.ReadWriteCloser, options ReplayOptions) ([]Difference, error) {
+	defer server.Close()
+
+	replay := &replayer{
+		options:   options,
+		methods:   map[string]protocol.MethodKind{},
+		recorded:  map[string]json.RawMessage{},
+		replayed:  map[string]json.RawMessage{},
+		signals:   map[string]chan struct{}{},
+		signalled: map[string]bool{},
+		done:      make(chan struct{}),
+	}
+
+	// The client's requests, in recorded order.
+	var requests []string
+
+	for _, entry := range entries {
+		var message envelope
+
+		if err := json.Unmarshal(entry.Message, &message); err != nil {
+			return nil, err
+		}
+
+		id := idKey(message.ID)
+
+		switch {
+		case entry.Direction == ClientToServer && message.isRequest():
+			replay.methods[id] = replay.method(entry.Message, message)
+			requests = append(requests, id)
+		case entry.Direction == ServerToClient && message.isResponse():
+			replay.recorded[id] = entry.Message
+		}
+	}
+
+	go replay.read(server)
+
+	for _, entry := range entries {
+		var message envelope
+		json.Unmarshal(entry.Message, &message)
+
+		id := idKey(message.ID)
+
+		switch {
+		case entry.Direction == ServerToClient && message.isResponse():
+			if _, requested := replay.methods[id]; requested {
+				replay.wait(ctx, "response "+id)
+			}
+			continue
+		case entry.Direction == ServerToClient:
+			continue
+		case message.isResponse():
+			replay.wait(ctx, "request "+id)
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+
+		content, err := protocol.EncodeMessage(entry.Message)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := server.Write(content); err != nil {
+			// The server is gone, so stop reading from it too.
+			server.Close()
+			break
+		}
+	}
+
+	for _, id := range requests {
+		replay.wait(ctx, "response "+id)
+	}
+
+	var differences []Difference
+
+	replay.mu.Lock()
+	defer replay.mu.Unlock()
+
+	for _, id := range requests {
+		recorded, replayed := replay.recorded[id], replay.replayed[id]
+
+		if !replay.equal(replay.methods[id], recorded, replayed) {
+			differences = append(differences, Difference{ID: id, Method: replay.methods[id], Recorded: recorded, Replayed: replayed})
+		}
+	}
+
+	return differences, ctx.Err()
+}
+
+type replayer struct {
+	options ReplayOptions
+	// The methods of the client's requests, by id.
+	methods map[string]protocol.MethodKind
+	// The recorded responses to the client's requests, by id.
+	recorded map[string]json.RawMessage
+
+	mu sync.Mutex
+	// The replayed server's responses, by id.
+	replayed map[string]json.RawMessage
+	// Closed once the replayed server sent a response or request, by
+	// "response id" and "request id".
+	signals   map[string]chan struct{}
+	signalled map[string]bool
+	// Closed once the replayed server's output ended, after which nothing
+	// more is signalled.
+	done chan struct{}
+}
+
+// Returns the method of a recorded request, using the typed decoders.
+func (r *replayer) method(content json.RawMessage, message envelope) protocol.MethodKind {
+	framed, err := protocol.EncodeMessage(content)
+
+	if err == nil {
+		if decoded, err := protocol.DecodeMessage(framed, r.options.Extensions...); err == nil {
+			if incoming, ok := decoded.(protocol.IncomingMessage); ok {
+				return incoming.GetMethod()
+			}
+		}
+	}
+
+	return protocol.MethodKind(message.Method)
+}
+
+// Reads the replayed server's messages until it is closed.
+func (r *replayer) read(server io.Reader) {
+	defer close(r.done)
+
+	scanner := protocol.NewScanner(server)
+
+	for scanner.Scan() {
+		_, contentLength, content, err := protocol.SplitMessage(scanner.Bytes())
+
+		if err != nil {
+			continue
+		}
+		content = bytes.Clone(content[:contentLength])
+
+		var message envelope
+
+		if json.Unmarshal(content, &message) != nil {
+			continue
+		}
+
+		id := idKey(message.ID)
+
+		switch {
+		case message.isResponse():
+			r.mu.Lock()
+			r.replayed[id] = content
+			r.mu.Unlock()
+			r.signal("response " + id)
+		case message.isRequest():
+			r.signal("request " + id)
+		}
+	}
+}
+
+func (r *replayer) channel(key string) chan struct{} {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	signal, exists := r.signals[key]
+
+	if !exists {
+		signal = make(chan struct{})
+		r.signals[key] = signal
+	}
+
+	return signal
+}
+
+func (r *replayer) signal(key string) {
+	signal := r.channel(key)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.signalled[key] {
+		r.signalled[key] = true
+		close(signal)
+	}
+}
+
+func (r *replayer) wait(ctx context.Context, key string) {
+	select {
+	case <-r.channel(key):
+	case <-r.done:
+	case <-ctx.Done():
+	}
+}
+
+// Reports whether two responses to a request for method are the same, once
+// decoded with its typed response.
+func (r *replayer) equal(method protocol.MethodKind, recorded, replayed json.RawMessage) bool {
+	if recorded == nil || replayed == nil {
+		return recorded == nil && replayed == nil
+	}
+
+	recordedMessage, recordedErr := protocol.DecodeResponse(method, recorded, r.options.Extensions...)
+	replayedMessage, replayedErr := protocol.DecodeResponse(method, replayed, r.options.Extensions...)
+
+	if recordedErr == nil && replayedErr == nil {
+		return reflect.DeepEqual(recordedMessage, replayedMessage)
+	}
+
+	// Responses that don't decode are compared as json.
+	var recordedValue, replayedValue any
+
+	json.Unmarshal(recorded, &recordedValue)
+	json.Unmarshal(replayed, &replayedValue)
+
+	return reflect.DeepEqual(recordedValue, replayedValue)
+}
+
+// Normalizes a raw jsonrpc id, so ids can be compared regardless of whitespace.
+func idKey(id json.RawMessage) string {
+	var buffer bytes.Buffer
+
+	if err := json.Compact(&buffer, id); err != nil {
+		return string(id)
+	}
+
+	return buffer.String()
+}
@@ -0,0 +1,394 @@
+package recording
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+)
+
+// TraceOptions configures ParseTrace.
+type TraceOptions struct {
+	// The direction of the messages traced as sent. Editors trace the client
+	// side, so this defaults to ClientToServer.
+	Sent Direction
+	// Methods that are not part of the protocol, which messages are decoded
+	// for.
+	Extensions []protocol.Extension
+}
+
+// TraceMessage is a message parsed from a trace log.
+type TraceMessage struct {
+	// The time of day the message was traced at. Text traces only have the
+	// time of day, not the date.
+	Time      time.Time
+	Direction Direction
+	Method    protocol.MethodKind
+	// The jsonrpc message rebuilt from the trace. Params and results are only
+	// part of verbose text traces.
+	Content json.RawMessage
+	// The typed message, or nil if it could not be decoded, e.g. because the
+	// trace didn't include its params.
+	Message protocol.Message
+	// Why the message could not be decoded.
+	Err error
+}
+
+// Returns the message as a recording entry, e.g. to replay a trace.
+func (m TraceMessage) Entry() Entry {
+	return Entry{Time: m.Time, Direction: m.Direction, Message: m.Content}
+}
+
+var (
+	traceHeader          = regexp.MustCompile(`^\[(Trace|LSP)\s*- ([^\]]+)\] (.*)$`)
+	traceRequest         = regexp.MustCompile(`^(Sending|Received) request '(.+) - \((.*)\)'\.$`)
+	traceNotification    = regexp.MustCompile(`^(Sending|Received) notification '(.+)'\.$`)
+	traceResponse        = regexp.MustCompile(`^(Sending|Received) response '(.+) - \((.*)\)'(.*)$`)
+	traceResponseFailure = regexp.MustCompile(`Request failed: (.*) \((-?\d+)\)\.$`)
+)
+
+// The prefixes of the params, result and error data of verbose text traces.
+var traceBodies = []string{"Params: ", "Result: ", "Error data: "}
+
+// A message of the json trace format, e.g.
+// [LSP   - 10:01:02 AM] {"isLSPMessage":true,"type":"send-request","message":{...},"timestamp":1700000000000}
+type jsonTrace struct {
+	IsLSPMessage bool            `json:"isLSPMessage"`
+	Type         string          `json:"type"`
+	Message      json.RawMessage `json:"message"`
+	Timestamp    int64           `json:"timestamp"`
+}
+
+// A traced message, before it is decoded.
+type tracedMessage struct {
+	time      time.Time
+	sent      bool
+	kind      string
+	method    string
+	id        string
+	params    json.RawMessage
+	result    json.RawMessage
+	errorData json.RawMessage
+	failure   *protocol.ResponseError
+	content   json.RawMessage
+}
+
+// Helper function that parses the "Language Server trace" output of VS Code,
+// in either its text or json format, into typed messages. Lines that are not
+// part of a traced message, e.g. the server's log messages or stderr output
+// between messages, are skipped.
+//
+// Responses are decoded with the typed response of the method they answer.
+func ParseTrace(r io.Reader, options TraceOptions) ([]TraceMessage, error) {
+	if options.Sent == "" {
+		options.Sent = ClientToServer
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), protocol.MaxMessageSize)
+
+	var traced []*tracedMessage
+	var current *tracedMessage
+	var body strings.Builder
+	// Whether the body of the current message is complete, after which lines
+	// until the next header are skipped.
+	complete := false
+
+	finish := func() error {
+		if current == nil {
+			return nil
+		}
+
+		err := current.parseBody(body.String())
+		traced = append(traced, current)
+		current = nil
+		body.Reset()
+		complete = false
+
+		return err
+	}
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		header := traceHeader.FindStringSubmatch(line)
+
+		if header == nil {
+			if current == nil || complete {
+				continue
+			}
+
+			if body.Len() == 0 {
+				if _, _, found := cutTraceBody(line); !found {
+					continue
+				}
+			} else if !isTraceBodyLine(line) {
+				continue
+			}
+
+			body.WriteString(line)
+			body.WriteString("\n")
+
+			// Bodies are indented json, which ends on a line that isn't
+			// indented.
+			if !strings.HasPrefix(line, " ") && !strings.HasPrefix(line, "\t") {
+				_, content, _ := cutTraceBody(body.String())
+				complete = json.Valid([]byte(content))
+			}
+			continue
+		}
+
+		if err := finish(); err != nil {
+			return nil, err
+		}
+
+		at, _ := time.Parse("3:04:05 PM", header[2])
+
+		if strings.HasPrefix(header[3], "{") {
+			message, err := parseJSONTrace(header[3], at)
+			if err != nil {
+				return nil, err
+			}
+			if message != nil {
+				traced = append(traced, message)
+			}
+			continue
+		}
+
+		current = parseTextTrace(header[3], at)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := finish(); err != nil {
+		return nil, err
+	}
+
+	return decodeTrace(traced, options), nil
+}
+
+// Parses the header of a text trace, or returns nil if it doesn't trace a
+// message.
+func parseTextTrace(header string, at time.Time) *tracedMessage {
+	if match := traceRequest.FindStringSubmatch(header); match != nil {
+		return &tracedMessage{time: at, sent: match[1] == "Sending", kind: "request", method: match[2], id: match[3]}
+	}
+
+	if match := traceNotification.FindStringSubmatch(header); match != nil {
+		return &tracedMessage{time: at, sent: match[1] == "Sending", kind: "notification", method: match[2]}
+	}
+
+	if match := traceResponse.FindStringSubmatch(header); match != nil {
+		message := &tracedMessage{time: at, sent: match[1] == "Sending", kind: "response", method: match[2], id: match[3]}
+
+		if failure := traceResponseFailure.FindStringSubmatch(match[4]); failure != nil {
+			code, _ := strconv.Atoi(failure[2])
+			message.failure = &protocol.ResponseError{Code: int32(code), Message: failure[1]}
+		}
+
+		return message
+	}
+
+	return nil
+}
+
+// Parses the params, result or error data of a verbose text trace.
+func (m *tracedMessage) parseBody(body string) error {
+	if m == nil {
+		return nil
+	}
+
+	prefix, content, found := cutTraceBody(strings.TrimSpace(body))
+
+	if !found {
+		return nil
+	}
+
+	if !json.Valid([]byte(content)) {
+		return fmt.Errorf("invalid json in trace of %s: %s", m.method, content)
+	}
+
+	switch prefix {
+	case "Params: ":
+		m.params = json.RawMessage(content)
+	case "Result: ":
+		m.result = json.RawMessage(content)
+	default:
+		m.errorData = json.RawMessage(content)
+	}
+
+	return nil
+}
+
+// Helper function that cuts the prefix of a params, result or error data body
+// from text.
+func cutTraceBody(text string) (string, string, bool) {
+	for _, prefix := range traceBodies {
+		if content, found := strings.CutPrefix(text, prefix); found {
+			return prefix, content, true
+		}
+	}
+
+	return "", "", false
+}
+
+// Whether a line continues a body that has started. Bodies are indented json,
+// so only indented lines and the closing brackets of the body belong to it.
+func isTraceBodyLine(line string) bool {
+	if strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t") {
+		return true
+	}
+
+	closing := strings.TrimRight(line, " \t")
+
+	return closing != "" && strings.Trim(closing, "}],") == ""
+}
+
+// Parses a message of the json trace format, or returns nil for other objects.
+func parseJSONTrace(line string, at time.Time) (*tracedMessage, error) {
+	var trace jsonTrace
+
+	if err := json.Unmarshal([]byte(line), &trace); err != nil {
+		return nil, fmt.Errorf("invalid json trace: %w", err)
+	}
+
+	if !trace.IsLSPMessage {
+		return nil, nil
+	}
+
+	direction, kind, found := strings.Cut(trace.Type, "-")
+
+	if !found {
+		return nil, fmt.Errorf("invalid json trace type: %s", trace.Type)
+	}
+
+	var message struct {
+		ID     json.RawMessage `json:"id"`
+		Method string          `json:"method"`
+	}
+
+	if err := json.Unmarshal(trace.Message, &message); err != nil {
+		return nil, fmt.Errorf("invalid json trace message: %w", err)
+	}
+
+	if trace.Timestamp != 0 {
+		at = time.UnixMilli(trace.Timestamp)
+	}
+
+	id := string(message.ID)
+	if unquoted, err := strconv.Unquote(id); err == nil {
+		id = unquoted
+	}
+
+	return &tracedMessage{
+		time:    at,
+		sent:    direction == "send",
+		kind:    kind,
+		method:  message.Method,
+		id:      id,
+		content: trace.Message,
+	}, nil
+}
+
+// Rebuilds and decodes the traced messages. Responses of the json format
+// don't include their method, which is looked up from the request they answer.
+func decodeTrace(traced []*tracedMessage, options TraceOptions) []TraceMessage {
+	// The methods of the requests sent and received, by id.
+	methods := map[bool]map[string]string{true: {}, false: {}}
+	var messages []TraceMessage
+
+	for _, message := range traced {
+		if message == nil {
+			continue
+		}
+
+		direction := options.Sent
+		if !message.sent {
+			direction = direction.Reverse()
+		}
+
+		switch message.kind {
+		case "request":
+			methods[message.sent][message.id] = message.method
+		case "response":
+			// A response that is sent answers a request that was received.
+			if message.method == "" {
+				message.method = methods[!message.sent][message.id]
+			}
+			delete(methods[!message.sent], message.id)
+		}
+
+		content := message.content
+		if content == nil {
+			content = message.rebuild()
+		}
+
+		traceMessage := TraceMessage{
+			Time:      message.time,
+			Direction: direction,
+			Method:    protocol.MethodKind(message.method),
+			Content:   content,
+		}
+
+		if message.kind == "response" {
+			traceMessage.Message, traceMessage.Err = protocol.DecodeResponse(traceMessage.Method, content, options.Extensions...)
+		} else if framed, err := protocol.EncodeMessage(content); err != nil {
+			traceMessage.Err = err
+		} else {
+			traceMessage.Message, traceMessage.Err = protocol.DecodeMessage(framed, options.Extensions...)
+		}
+
+		messages = append(messages, traceMessage)
+	}
+
+	return messages
+}
+
+// Rebuilds the jsonrpc message of a text trace.
+func (m *tracedMessage) rebuild() json.RawMessage {
+	message := map[string]any{"jsonrpc": "2.0"}
+
+	if m.kind != "response" {
+		message["method"] = m.method
+	}
+
+	if m.kind != "notification" {
+		// Ids are traced without quotes, so only numbers can be told apart.
+		if id, err := strconv.ParseInt(m.id, 10, 32); err == nil {
+			message["id"] = id
+		} else {
+			message["id"] = m.id
+		}
+	}
+
+	if m.params != nil {
+		message["params"] = m.params
+	}
+
+	switch {
+	case m.failure != nil || m.errorData != nil:
+		failure := map[string]any{"code": int32(protocol.ErrorCodesInternalError), "message": ""}
+		if m.failure != nil {
+			failure["code"], failure["message"] = m.failure.Code, m.failure.Message
+		}
+		if m.errorData != nil {
+			failure["data"] = m.errorData
+		}
+		message["error"] = failure
+	case m.result != nil:
+		message["result"] = m.result
+	case m.kind == "response":
+		message["result"] = nil
+	}
+
+	content, _ := json.Marshal(message)
+
+	return content
+}
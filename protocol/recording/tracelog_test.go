@@ -0,0 +1,186 @@
+package recording
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+)
+
+func TestParseTextTrace(t *testing.T) {
+	var trace bytes.Buffer
+	tracer := &protocol.Tracer{Writer: &trace}
+	tracer.SetValue(protocol.TraceValueVerbose)
+
+	clientSide, serverSide := net.Pipe()
+	client := protocol.NewConn(clientSide, protocol.ConnOptions{Tracer: tracer})
+	server := protocol.NewConn(serverSide, protocol.ConnOptions{
+		Handler: hoverServer(func(uri protocol.DocumentUri) string { return "hover for " + string(uri) }),
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go client.Run(ctx)
+	go server.Run(ctx)
+
+	if _, err := client.Hover(ctx, hoverParams("file:///a.go")); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Initialized(ctx, protocol.InitializedParams{}); err != nil {
+		t.Fatal(err)
+	}
+	client.Close()
+
+	messages, err := ParseTrace(strings.NewReader("[Info  - 10:01:02 AM] server started\n"+trace.String()), TraceOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(messages) != 3 {
+		t.Fatalf("Expected 3 messages, got %d", len(messages))
+	}
+
+	request, ok := messages[0].Message.(protocol.HoverRequest)
+	if !ok || request.Params.TextDocument.Uri != "file:///a.go" || messages[0].Direction != ClientToServer {
+		t.Fatalf("Unexpected request: %+v", messages[0])
+	}
+
+	response, ok := messages[1].Message.(protocol.HoverResponse)
+	if !ok || response.Result.Contents.Value.(protocol.MarkupContent).Value != "hover for file:///a.go" || messages[1].Direction != ServerToClient {
+		t.Fatalf("Unexpected response: %+v", messages[1])
+	}
+
+	if _, ok := messages[2].Message.(protocol.InitializedNotification); !ok {
+		t.Fatalf("Unexpected notification: %+v", messages[2])
+	}
+}
+
+func TestParseTextTraceWithoutParams(t *testing.T) {
+	trace := strings.Join([]string{
+		"[Trace - 10:01:02 AM] Sending request 'shutdown - (3)'.",
+		"[Trace - 10:01:02 AM] Received response 'textDocument/hover - (2)' in 12ms. Request failed: no package (-32803).",
+	}, "\n")
+
+	messages, err := ParseTrace(strings.NewReader(trace), TraceOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := messages[0].Message.(protocol.ShutdownRequest); !ok {
+		t.Fatalf("Expected a shutdown request, got %+v", messages[0])
+	}
+	if messages[0].Time.Hour() != 10 || messages[0].Time.Second() != 2 {
+		t.Fatalf("Expected the time of the trace, got %s", messages[0].Time)
+	}
+
+	response, ok := messages[1].Message.(protocol.HoverResponse)
+	if !ok || response.Error == nil || response.Error.Code != -32803 || response.Error.Message != "no package" {
+		t.Fatalf("Expected a failed hover response, got %+v", messages[1])
+	}
+}
+
+func TestParseJSONTrace(t *testing.T) {
+	trace := strings.Join([]string{
+		`[LSP   - 10:01:02 AM] {"isLSPMessage":true,"type":"send-request","message":{"jsonrpc":"2.0","id":12,"method":"textDocument/hover","params":{"textDocument":{"uri":"file:///a.go"},"position":{"line":1,"character":2}}},"timestamp":1700000000000}`,
+		`[LSP   - 10:01:02 AM] {"isLSPMessage":true,"type":"receive-response","message":{"jsonrpc":"2.0","id":12,"result":null},"timestamp":1700000000050}`,
+		`[LSP   - 10:01:03 AM] {"isLSPMessage":true,"type":"receive-notification","message":{"jsonrpc":"2.0","method":"window/logMessage","params":{"type":3,"message":"ready"}},"timestamp":1700000001000}`,
+	}, "\n")
+
+	messages, err := ParseTrace(strings.NewReader(trace), TraceOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(messages) != 3 {
+		t.Fatalf("Expected 3 messages, got %d", len(messages))
+	}
+	if request, ok := messages[0].Message.(protocol.HoverRequest); !ok || request.Params.Position.Character != 2 {
+		t.Fatalf("Unexpected request: %+v", messages[0])
+	}
+	if _, ok := messages[1].Message.(protocol.HoverResponse); !ok || messages[1].Method != protocol.TextDocumentHoverMethod {
+		t.Fatalf("Expected the response to be decoded for its request's method, got %+v", messages[1])
+	}
+	if _, ok := messages[2].Message.(protocol.LogMessageNotification); !ok || messages[2].Direction != ServerToClient {
+		t.Fatalf("Unexpected notification: %+v", messages[2])
+	}
+	if messages[0].Time.UnixMilli() != 1700000000000 {
+		t.Fatalf("Expected the timestamp of the trace, got %s", messages[0].Time)
+	}
+}
+
+func TestParseTextTraceWithStderr(t *testing.T) {
+	trace := strings.Join([]string{
+		"[Trace - 10:01:02 AM] Sending request 'textDocument/hover - (2)'.",
+		"2024/01/02 10:01:02 loading packages",
+		"Params: {",
+		`    "textDocument": {`,
+		`        "uri": "file:///a.go"`,
+		"    },",
+		`    "position": {`,
+		`        "line": 1,`,
+		`        "character": 2`,
+		"    }",
+		"}",
+		"",
+		"2024/01/02 10:01:02 loaded 12 packages",
+		"panic: not a json value",
+		"",
+		"[Trace - 10:01:02 AM] Received response 'textDocument/hover - (2)' in 12ms.",
+		"Result: null",
+		"warning: cache is stale",
+		"",
+	}, "\n")
+
+	messages, err := ParseTrace(strings.NewReader(trace), TraceOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(messages) != 2 {
+		t.Fatalf("Expected 2 messages, got %d", len(messages))
+	}
+
+	request, ok := messages[0].Message.(protocol.HoverRequest)
+	if !ok || request.Params.TextDocument.Uri != "file:///a.go" || request.Params.Position.Character != 2 {
+		t.Fatalf("Unexpected request: %+v", messages[0])
+	}
+
+	if response, ok := messages[1].Message.(protocol.HoverResponse); !ok || response.Result != nil {
+		t.Fatalf("Unexpected response: %+v", messages[1])
+	}
+}
+
+func TestParseTextTraceWithStderrInBody(t *testing.T) {
+	trace := strings.Join([]string{
+		"[Trace - 10:01:02 AM] Sending request 'textDocument/hover - (2)'.",
+		"Params: {",
+		`    "textDocument": {`,
+		`        "uri": "file:///a.go"`,
+		"    },",
+		"2024/01/02 10:01:02 loading packages",
+		`    "position": {`,
+		`        "line": 1,`,
+		"panic: not a json value",
+		`        "character": 2`,
+		"    }",
+		"}",
+		"",
+	}, "\n")
+
+	messages, err := ParseTrace(strings.NewReader(trace), TraceOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(messages) != 1 {
+		t.Fatalf("Expected 1 message, got %d", len(messages))
+	}
+
+	request, ok := messages[0].Message.(protocol.HoverRequest)
+	if !ok || request.Params.TextDocument.Uri != "file:///a.go" || request.Params.Position.Character != 2 {
+		t.Fatalf("Unexpected request: %+v", messages[0])
+	}
+}
@@ -0,0 +1,385 @@
+package protocol
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Registration methods that are not request or notification methods
+// themselves, but are used as the method of a dynamic registration.
+const (
+	TextDocumentSemanticTokensMethod MethodKind = "textDocument/semanticTokens"
+	NotebookDocumentSyncMethod       MethodKind = "notebookDocument/sync"
+)
+
+// Registrations keeps track of the capabilities a server has dynamically
+// registered with a client.
+//
+// Calls to Register and Unregister are queued until Flush is called, which
+// batches them into the params of a single client/registerCapability and
+// client/unregisterCapability request.
+type Registrations struct {
+	mu                sync.Mutex
+	capabilities      ClientCapabilities
+	prefix            string
+	next              uint64
+	active            map[string]Registration
+	pendingRegister   []Registration
+	pendingUnregister []Unregistration
+}
+
+// Creates a registration manager for a client that advertised the given capabilities.
+func NewRegistrations(capabilities ClientCapabilities) *Registrations {
+	return &Registrations{
+		capabilities: capabilities,
+		prefix:       "registration-",
+		active:       map[string]Registration{},
+	}
+}
+
+// Queues a registration for method with the given options, and returns the id
+// generated for it. It fails if the client does not support dynamic registration
+// for the method.
+func (r *Registrations) Register(method MethodKind, options any) (string, error) {
+	if !SupportsDynamicRegistration(r.capabilities, method) {
+		return "", fmt.Errorf("client does not support dynamic registration for %s", method)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.next++
+	id := r.prefix + strconv.FormatUint(r.next, 10)
+	registration := Registration{
+		Id:              id,
+		Method:          string(method),
+		RegisterOptions: options,
+	}
+	r.active[id] = registration
+	r.pendingRegister = append(r.pendingRegister, registration)
+
+	return id, nil
+}
+
+// Queues the removal of a registration previously returned by Register.
+// Unregistering a registration that was never flushed drops it from the queue
+// without sending anything to the client.
+func (r *Registrations) Unregister(id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	registration, exists := r.active[id]
+
+	if !exists {
+		return fmt.Errorf("unknown registration: %s", id)
+	}
+	delete(r.active, id)
+
+	for i, pending := range r.pendingRegister {
+		if pending.Id == id {
+			r.pendingRegister = append(r.pendingRegister[:i], r.pendingRegister[i+1:]...)
+			return nil
+		}
+	}
+
+	r.pendingUnregister = append(r.pendingUnregister, Unregistration{
+		Id:     id,
+		Method: registration.Method,
+	})
+
+	return nil
+}
+
+// Drains the queued changes. Either return value is nil when there is nothing
+// to send for it.
+func (r *Registrations) Flush() (*RegistrationParams, *UnregistrationParams) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var register *RegistrationParams
+	var unregister *UnregistrationParams
+
+	if len(r.pendingRegister) > 0 {
+		register = &RegistrationParams{Registrations: r.pendingRegister}
+		r.pendingRegister = nil
+	}
+
+	if len(r.pendingUnregister) > 0 {
+		unregister = &UnregistrationParams{Unregisterations: r.pendingUnregister}
+		r.pendingUnregister = nil
+	}
+
+	return register, unregister
+}
+
+// Returns the registration with the given id, if it is active.
+func (r *Registrations) Get(id string) (Registration, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	registration, exists := r.active[id]
+
+	return registration, exists
+}
+
+// Returns all active registrations for method.
+func (r *Registrations) ForMethod(method MethodKind) []Registration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return registrationsForMethod(r.active, method)
+}
+
+// Helper function that returns the options of an active registration as T.
+func RegisteredOptions[T any](r *Registrations, id string) (T, bool) {
+	var zero T
+	registration, exists := r.Get(id)
+
+	if !exists {
+		return zero, false
+	}
+
+	options, ok := registration.RegisterOptions.(T)
+
+	return options, ok
+}
+
+// ActiveRegistrations records the registrations a client has received through
+// client/registerCapability, so features can check whether a server has
+// registered for them.
+type ActiveRegistrations struct {
+	mu     sync.RWMutex
+	active map[string]Registration
+}
+
+func NewActiveRegistrations() *ActiveRegistrations {
+	return &ActiveRegistrations{
+		active: map[string]Registration{},
+	}
+}
+
+// Records the registrations of a client/registerCapability request, with their
+// options decoded by DecodeRegistrationOptions. Nothing is recorded if any of the
+// ids is already registered or any of the options fail to decode.
+func (a *ActiveRegistrations) Apply(params RegistrationParams) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	decoded := make([]Registration, 0, len(params.Registrations))
+
+	for _, registration := range params.Registrations {
+		if _, exists := a.active[registration.Id]; exists {
+			return fmt.Errorf("registration already exists: %s", registration.Id)
+		}
+
+		options, err := DecodeRegistrationOptions(registration)
+
+		if err != nil {
+			return err
+		}
+		registration.RegisterOptions = options
+		decoded = append(decoded, registration)
+	}
+
+	for _, registration := range decoded {
+		a.active[registration.Id] = registration
+	}
+
+	return nil
+}
+
+// Removes the registrations of a client/unregisterCapability request. Nothing
+// is removed if any of the ids is unknown.
+func (a *ActiveRegistrations) Remove(params UnregistrationParams) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, unregistration := range params.Unregisterations {
+		if _, exists := a.active[unregistration.Id]; !exists {
+			return fmt.Errorf("unknown registration: %s", unregistration.Id)
+		}
+	}
+
+	for _, unregistration := range params.Unregisterations {
+		delete(a.active, unregistration.Id)
+	}
+
+	return nil
+}
+
+// Returns all active registrations for method.
+func (a *ActiveRegistrations) ForMethod(method MethodKind) []Registration {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	return registrationsForMethod(a.active, method)
+}
+
+// Reports whether the server has at least one active registration for method.
+func (a *ActiveRegistrations) Has(method MethodKind) bool {
+	return len(a.ForMethod(method)) > 0
+}
+
+// Helper function that returns the options of an active registration as T.
+func ActiveOptions[T any](a *ActiveRegistrations, id string) (T, bool) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	var zero T
+	registration, exists := a.active[id]
+
+	if !exists {
+		return zero, false
+	}
+
+	options, ok := registration.RegisterOptions.(T)
+
+	return options, ok
+}
+
+// Helper function that decodes the registerOptions of a registration into the
+// options struct of its method, using RegistrationOptionsRegistry. Options of
+// methods that are not in the registry are returned as is.
+func DecodeRegistrationOptions(registration Registration) (any, error) {
+	decode, exists := RegistrationOptionsRegistry[registration.Method]
+
+	if !exists || registration.RegisterOptions == nil {
+		return registration.RegisterOptions, nil
+	}
+
+	data, err := json.Marshal(registration.RegisterOptions)
+
+	if err != nil {
+		return nil, err
+	}
+
+	options, err := decode(data)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid registerOptions for %s (id %s): %w", registration.Method, registration.Id, err)
+	}
+
+	return options, nil
+}
+
+func registrationsForMethod(active map[string]Registration, method MethodKind) []Registration {
+	var result []Registration
+
+	for _, registration := range active {
+		if registration.Method == string(method) {
+			result = append(result, registration)
+		}
+	}
+
+	slices.SortFunc(result, func(a, b Registration) int {
+		return strings.Compare(a.Id, b.Id)
+	})
+
+	return result
+}
+
+// Reports whether the client advertised dynamicRegistration support for the
+// capability that method is registered under.
+func SupportsDynamicRegistration(capabilities ClientCapabilities, method MethodKind) bool {
+	if textDocument := capabilities.TextDocument; textDocument != nil {
+		switch method {
+		case TextDocumentDidOpenMethod,
+			TextDocumentDidChangeMethod,
+			TextDocumentDidCloseMethod,
+			TextDocumentDidSaveMethod,
+			TextDocumentWillSaveMethod,
+			TextDocumentWillSaveWaitUntilMethod:
+			return textDocument.Synchronization != nil && textDocument.Synchronization.DynamicRegistration
+		case TextDocumentCompletionMethod:
+			return textDocument.Completion != nil && textDocument.Completion.DynamicRegistration
+		case TextDocumentHoverMethod:
+			return textDocument.Hover != nil && textDocument.Hover.DynamicRegistration
+		case TextDocumentSignatureHelpMethod:
+			return textDocument.SignatureHelp != nil && textDocument.SignatureHelp.DynamicRegistration
+		case TextDocumentDeclarationMethod:
+			return textDocument.Declaration != nil && textDocument.Declaration.DynamicRegistration
+		case TextDocumentDefinitionMethod:
+			return textDocument.Definition != nil && textDocument.Definition.DynamicRegistration
+		case TextDocumentTypeDefinitionMethod:
+			return textDocument.TypeDefinition != nil && textDocument.TypeDefinition.DynamicRegistration
+		case TextDocumentImplementationMethod:
+			return textDocument.Implementation != nil && textDocument.Implementation.DynamicRegistration
+		case TextDocumentReferencesMethod:
+			return textDocument.References != nil && textDocument.References.DynamicRegistration
+		case TextDocumentDocumentHighlightMethod:
+			return textDocument.DocumentHighlight != nil && textDocument.DocumentHighlight.DynamicRegistration
+		case TextDocumentDocumentSymbolMethod:
+			return textDocument.DocumentSymbol != nil && textDocument.DocumentSymbol.DynamicRegistration
+		case TextDocumentCodeActionMethod:
+			return textDocument.CodeAction != nil && textDocument.CodeAction.DynamicRegistration
+		case TextDocumentCodeLensMethod:
+			return textDocument.CodeLens != nil && textDocument.CodeLens.DynamicRegistration
+		case TextDocumentDocumentLinkMethod:
+			return textDocument.DocumentLink != nil && textDocument.DocumentLink.DynamicRegistration
+		case TextDocumentDocumentColorMethod:
+			return textDocument.ColorProvider != nil && textDocument.ColorProvider.DynamicRegistration
+		case TextDocumentFormattingMethod:
+			return textDocument.Formatting != nil && textDocument.Formatting.DynamicRegistration
+		case TextDocumentRangeFormattingMethod, TextDocumentRangesFormattingMethod:
+			return textDocument.RangeFormatting != nil && textDocument.RangeFormatting.DynamicRegistration
+		case TextDocumentOnTypeFormattingMethod:
+			return textDocument.OnTypeFormatting != nil && textDocument.OnTypeFormatting.DynamicRegistration
+		case TextDocumentRenameMethod:
+			return textDocument.Rename != nil && textDocument.Rename.DynamicRegistration
+		case TextDocumentFoldingRangeMethod:
+			return textDocument.FoldingRange != nil && textDocument.FoldingRange.DynamicRegistration
+		case TextDocumentSelectionRangeMethod:
+			return textDocument.SelectionRange != nil && textDocument.SelectionRange.DynamicRegistration
+		case TextDocumentPrepareCallHierarchyMethod:
+			return textDocument.CallHierarchy != nil && textDocument.CallHierarchy.DynamicRegistration
+		case TextDocumentSemanticTokensMethod:
+			return textDocument.SemanticTokens != nil && textDocument.SemanticTokens.DynamicRegistration
+		case TextDocumentLinkedEditingRangeMethod:
+			return textDocument.LinkedEditingRange != nil && textDocument.LinkedEditingRange.DynamicRegistration
+		case TextDocumentMonikerMethod:
+			return textDocument.Moniker != nil && textDocument.Moniker.DynamicRegistration
+		case TextDocumentPrepareTypeHierarchyMethod:
+			return textDocument.TypeHierarchy != nil && textDocument.TypeHierarchy.DynamicRegistration
+		case TextDocumentInlineValueMethod:
+			return textDocument.InlineValue != nil && textDocument.InlineValue.DynamicRegistration
+		case TextDocumentInlayHintMethod:
+			return textDocument.InlayHint != nil && textDocument.InlayHint.DynamicRegistration
+		case TextDocumentDiagnosticMethod:
+			return textDocument.Diagnostic != nil && textDocument.Diagnostic.DynamicRegistration
+		case TextDocumentInlineCompletionMethod:
+			return textDocument.InlineCompletion != nil && textDocument.InlineCompletion.DynamicRegistration
+		}
+	}
+
+	if workspace := capabilities.Workspace; workspace != nil {
+		switch method {
+		case WorkspaceDidChangeConfigurationMethod:
+			return workspace.DidChangeConfiguration != nil && workspace.DidChangeConfiguration.DynamicRegistration
+		case WorkspaceDidChangeWatchedFilesMethod:
+			return workspace.DidChangeWatchedFiles != nil && workspace.DidChangeWatchedFiles.DynamicRegistration
+		case WorkspaceSymbolMethod:
+			return workspace.Symbol != nil && workspace.Symbol.DynamicRegistration
+		case WorkspaceExecuteCommandMethod:
+			return workspace.ExecuteCommand != nil && workspace.ExecuteCommand.DynamicRegistration
+		case WorkspaceTextDocumentContentMethod:
+			return workspace.TextDocumentContent != nil && workspace.TextDocumentContent.DynamicRegistration
+		case WorkspaceWillCreateFilesMethod,
+			WorkspaceDidCreateFilesMethod,
+			WorkspaceWillRenameFilesMethod,
+			WorkspaceDidRenameFilesMethod,
+			WorkspaceWillDeleteFilesMethod,
+			WorkspaceDidDeleteFilesMethod:
+			return workspace.FileOperations != nil && workspace.FileOperations.DynamicRegistration
+		}
+	}
+
+	if method == NotebookDocumentSyncMethod && capabilities.NotebookDocument != nil {
+		return capabilities.NotebookDocument.Synchronization.DynamicRegistration
+	}
+
+	return false
+}
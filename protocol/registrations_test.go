@@ -0,0 +1,187 @@
+package protocol
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func dynamicWatchedFilesCapabilities() ClientCapabilities {
+	return ClientCapabilities{
+		Workspace: &WorkspaceClientCapabilities{
+			DidChangeWatchedFiles: &DidChangeWatchedFilesClientCapabilities{
+				DynamicRegistration: true,
+			},
+		},
+	}
+}
+
+func TestRegistrationsRequireDynamicRegistration(t *testing.T) {
+	registrations := NewRegistrations(ClientCapabilities{})
+
+	if _, err := registrations.Register(WorkspaceDidChangeWatchedFilesMethod, nil); err == nil {
+		t.Fatal("Expected error when client does not support dynamic registration")
+	}
+}
+
+func TestRegistrationsBatchRegisterAndUnregister(t *testing.T) {
+	registrations := NewRegistrations(dynamicWatchedFilesCapabilities())
+	options := DidChangeWatchedFilesRegistrationOptions{
+		Watchers: []FileSystemWatcher{{GlobPattern: GlobPattern{Value: Pattern("**/*.go")}}},
+	}
+
+	first, err := registrations.Register(WorkspaceDidChangeWatchedFilesMethod, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := registrations.Register(WorkspaceDidChangeWatchedFilesMethod, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if first == second {
+		t.Fatalf("Expected unique registration ids, got %s twice", first)
+	}
+
+	register, unregister := registrations.Flush()
+
+	if register == nil || len(register.Registrations) != 2 {
+		t.Fatalf("Expected 2 batched registrations, got %v", register)
+	}
+	if unregister != nil {
+		t.Fatalf("Expected no unregistrations, got %v", unregister)
+	}
+
+	stored, ok := RegisteredOptions[DidChangeWatchedFilesRegistrationOptions](registrations, first)
+	if !ok || len(stored.Watchers) != 1 {
+		t.Fatalf("Expected typed options for %s, got %v", first, stored)
+	}
+
+	if err := registrations.Unregister(first); err != nil {
+		t.Fatal(err)
+	}
+
+	register, unregister = registrations.Flush()
+
+	if register != nil {
+		t.Fatalf("Expected no registrations, got %v", register)
+	}
+	if unregister == nil || len(unregister.Unregisterations) != 1 || unregister.Unregisterations[0].Id != first {
+		t.Fatalf("Expected unregistration of %s, got %v", first, unregister)
+	}
+
+	if got := registrations.ForMethod(WorkspaceDidChangeWatchedFilesMethod); len(got) != 1 || got[0].Id != second {
+		t.Fatalf("Expected only %s to be active, got %v", second, got)
+	}
+}
+
+func TestRegistrationsUnregisterBeforeFlush(t *testing.T) {
+	registrations := NewRegistrations(dynamicWatchedFilesCapabilities())
+
+	id, err := registrations.Register(WorkspaceDidChangeWatchedFilesMethod, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := registrations.Unregister(id); err != nil {
+		t.Fatal(err)
+	}
+
+	if register, unregister := registrations.Flush(); register != nil || unregister != nil {
+		t.Fatalf("Expected nothing to flush, got %v and %v", register, unregister)
+	}
+
+	if err := registrations.Unregister(id); err == nil {
+		t.Fatal("Expected error when unregistering an unknown id")
+	}
+}
+
+func TestActiveRegistrations(t *testing.T) {
+	active := NewActiveRegistrations()
+
+	err := active.Apply(RegistrationParams{Registrations: []Registration{
+		{Id: "1", Method: string(TextDocumentHoverMethod)},
+		{Id: "2", Method: string(TextDocumentCompletionMethod)},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !active.Has(TextDocumentHoverMethod) {
+		t.Fatal("Expected hover to be registered")
+	}
+
+	if err := active.Apply(RegistrationParams{Registrations: []Registration{{Id: "1", Method: "textDocument/hover"}}}); err == nil {
+		t.Fatal("Expected error for duplicate registration id")
+	}
+
+	if err := active.Remove(UnregistrationParams{Unregisterations: []Unregistration{{Id: "1", Method: "textDocument/hover"}}}); err != nil {
+		t.Fatal(err)
+	}
+
+	if active.Has(TextDocumentHoverMethod) {
+		t.Fatal("Expected hover to be unregistered")
+	}
+
+	if err := active.Remove(UnregistrationParams{Unregisterations: []Unregistration{{Id: "1", Method: "textDocument/hover"}}}); err == nil {
+		t.Fatal("Expected error for unknown registration id")
+	}
+}
+
+func TestDecodeRegistrationOptions(t *testing.T) {
+	content := []byte(`{"registrations": [
+		{"id": "1", "method": "textDocument/didChange", "registerOptions": {"documentSelector": [{"language": "go"}], "syncKind": 2}},
+		{"id": "2", "method": "textDocument/semanticTokens", "registerOptions": {"documentSelector": null, "legend": {"tokenTypes": [], "tokenModifiers": []}, "full": true}},
+		{"id": "3", "method": "workspace/didChangeWatchedFiles", "registerOptions": {"watchers": [{"globPattern": "**/*.go"}]}}
+	]}`)
+
+	var params RegistrationParams
+	if err := json.Unmarshal(content, &params); err != nil {
+		t.Fatal(err)
+	}
+
+	active := NewActiveRegistrations()
+	if err := active.Apply(params); err != nil {
+		t.Fatal(err)
+	}
+
+	change, ok := ActiveOptions[TextDocumentChangeRegistrationOptions](active, "1")
+	if !ok || change.SyncKind != TextDocumentSyncKindIncremental {
+		t.Fatalf("Expected TextDocumentChangeRegistrationOptions, got %v", change)
+	}
+
+	if _, ok := ActiveOptions[SemanticTokensRegistrationOptions](active, "2"); !ok {
+		t.Fatal("Expected SemanticTokensRegistrationOptions")
+	}
+
+	if _, ok := ActiveOptions[DidChangeWatchedFilesRegistrationOptions](active, "3"); !ok {
+		t.Fatal("Expected DidChangeWatchedFilesRegistrationOptions")
+	}
+}
+
+func TestDecodeRegistrationOptionsMismatch(t *testing.T) {
+	registration := Registration{
+		Id:              "1",
+		Method:          string(WorkspaceDidChangeWatchedFilesMethod),
+		RegisterOptions: map[string]any{"syncKind": 2},
+	}
+
+	_, err := DecodeRegistrationOptions(registration)
+
+	if err == nil {
+		t.Fatal("Expected error for options that do not match the method")
+	}
+
+	if !strings.Contains(err.Error(), "workspace/didChangeWatchedFiles") {
+		t.Fatalf("Expected error to mention the method, got: %s", err)
+	}
+
+	active := NewActiveRegistrations()
+	if err := active.Apply(RegistrationParams{Registrations: []Registration{registration}}); err == nil {
+		t.Fatal("Expected Apply to reject mismatched options")
+	}
+
+	if active.Has(WorkspaceDidChangeWatchedFilesMethod) {
+		t.Fatal("Expected nothing to be recorded")
+	}
+}
@@ -668,4 +668,1017 @@ var MessageRegistry = map[string]func([]byte) (Message, error) {
 		}
 		return message, nil
 	},
+}
+var ResponseRegistry = map[string]func([]byte) (Message, error) {
+	"textDocument/implementation": func(data []byte) (Message, error) {
+		var message ImplementationResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"textDocument/typeDefinition": func(data []byte) (Message, error) {
+		var message TypeDefinitionResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"workspace/workspaceFolders": func(data []byte) (Message, error) {
+		var message WorkspaceFoldersResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"workspace/configuration": func(data []byte) (Message, error) {
+		var message ConfigurationResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"textDocument/documentColor": func(data []byte) (Message, error) {
+		var message DocumentColorResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"textDocument/colorPresentation": func(data []byte) (Message, error) {
+		var message ColorPresentationResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"textDocument/foldingRange": func(data []byte) (Message, error) {
+		var message FoldingRangeResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"workspace/foldingRange/refresh": func(data []byte) (Message, error) {
+		var message FoldingRangeRefreshResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"textDocument/declaration": func(data []byte) (Message, error) {
+		var message DeclarationResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"textDocument/selectionRange": func(data []byte) (Message, error) {
+		var message SelectionRangeResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"window/workDoneProgress/create": func(data []byte) (Message, error) {
+		var message WorkDoneProgressCreateResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"textDocument/prepareCallHierarchy": func(data []byte) (Message, error) {
+		var message CallHierarchyPrepareResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"callHierarchy/incomingCalls": func(data []byte) (Message, error) {
+		var message CallHierarchyIncomingCallsResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"callHierarchy/outgoingCalls": func(data []byte) (Message, error) {
+		var message CallHierarchyOutgoingCallsResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"textDocument/semanticTokens/full": func(data []byte) (Message, error) {
+		var message SemanticTokensResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"textDocument/semanticTokens/full/delta": func(data []byte) (Message, error) {
+		var message SemanticTokensDeltaResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"textDocument/semanticTokens/range": func(data []byte) (Message, error) {
+		var message SemanticTokensRangeResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"workspace/semanticTokens/refresh": func(data []byte) (Message, error) {
+		var message SemanticTokensRefreshResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"window/showDocument": func(data []byte) (Message, error) {
+		var message ShowDocumentResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"textDocument/linkedEditingRange": func(data []byte) (Message, error) {
+		var message LinkedEditingRangeResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"workspace/willCreateFiles": func(data []byte) (Message, error) {
+		var message WillCreateFilesResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"workspace/willRenameFiles": func(data []byte) (Message, error) {
+		var message WillRenameFilesResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"workspace/willDeleteFiles": func(data []byte) (Message, error) {
+		var message WillDeleteFilesResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"textDocument/moniker": func(data []byte) (Message, error) {
+		var message MonikerResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"textDocument/prepareTypeHierarchy": func(data []byte) (Message, error) {
+		var message TypeHierarchyPrepareResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"typeHierarchy/supertypes": func(data []byte) (Message, error) {
+		var message TypeHierarchySupertypesResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"typeHierarchy/subtypes": func(data []byte) (Message, error) {
+		var message TypeHierarchySubtypesResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"textDocument/inlineValue": func(data []byte) (Message, error) {
+		var message InlineValueResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"workspace/inlineValue/refresh": func(data []byte) (Message, error) {
+		var message InlineValueRefreshResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"textDocument/inlayHint": func(data []byte) (Message, error) {
+		var message InlayHintResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"inlayHint/resolve": func(data []byte) (Message, error) {
+		var message InlayHintResolveResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"workspace/inlayHint/refresh": func(data []byte) (Message, error) {
+		var message InlayHintRefreshResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"textDocument/diagnostic": func(data []byte) (Message, error) {
+		var message DocumentDiagnosticResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"workspace/diagnostic": func(data []byte) (Message, error) {
+		var message WorkspaceDiagnosticResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"workspace/diagnostic/refresh": func(data []byte) (Message, error) {
+		var message DiagnosticRefreshResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"textDocument/inlineCompletion": func(data []byte) (Message, error) {
+		var message InlineCompletionResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"workspace/textDocumentContent": func(data []byte) (Message, error) {
+		var message TextDocumentContentResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"workspace/textDocumentContent/refresh": func(data []byte) (Message, error) {
+		var message TextDocumentContentRefreshResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"client/registerCapability": func(data []byte) (Message, error) {
+		var message RegistrationResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"client/unregisterCapability": func(data []byte) (Message, error) {
+		var message UnregistrationResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"initialize": func(data []byte) (Message, error) {
+		var message InitializeResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"shutdown": func(data []byte) (Message, error) {
+		var message ShutdownResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"window/showMessageRequest": func(data []byte) (Message, error) {
+		var message ShowMessageResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"textDocument/willSaveWaitUntil": func(data []byte) (Message, error) {
+		var message WillSaveTextDocumentWaitUntilResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"textDocument/completion": func(data []byte) (Message, error) {
+		var message CompletionResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"completionItem/resolve": func(data []byte) (Message, error) {
+		var message CompletionResolveResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"textDocument/hover": func(data []byte) (Message, error) {
+		var message HoverResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"textDocument/signatureHelp": func(data []byte) (Message, error) {
+		var message SignatureHelpResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"textDocument/definition": func(data []byte) (Message, error) {
+		var message DefinitionResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"textDocument/references": func(data []byte) (Message, error) {
+		var message ReferencesResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"textDocument/documentHighlight": func(data []byte) (Message, error) {
+		var message DocumentHighlightResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"textDocument/documentSymbol": func(data []byte) (Message, error) {
+		var message DocumentSymbolResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"textDocument/codeAction": func(data []byte) (Message, error) {
+		var message CodeActionResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"codeAction/resolve": func(data []byte) (Message, error) {
+		var message CodeActionResolveResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"workspace/symbol": func(data []byte) (Message, error) {
+		var message WorkspaceSymbolResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"workspaceSymbol/resolve": func(data []byte) (Message, error) {
+		var message WorkspaceSymbolResolveResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"textDocument/codeLens": func(data []byte) (Message, error) {
+		var message CodeLensResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"codeLens/resolve": func(data []byte) (Message, error) {
+		var message CodeLensResolveResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"workspace/codeLens/refresh": func(data []byte) (Message, error) {
+		var message CodeLensRefreshResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"textDocument/documentLink": func(data []byte) (Message, error) {
+		var message DocumentLinkResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"documentLink/resolve": func(data []byte) (Message, error) {
+		var message DocumentLinkResolveResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"textDocument/formatting": func(data []byte) (Message, error) {
+		var message DocumentFormattingResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"textDocument/rangeFormatting": func(data []byte) (Message, error) {
+		var message DocumentRangeFormattingResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"textDocument/rangesFormatting": func(data []byte) (Message, error) {
+		var message DocumentRangesFormattingResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"textDocument/onTypeFormatting": func(data []byte) (Message, error) {
+		var message DocumentOnTypeFormattingResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"textDocument/rename": func(data []byte) (Message, error) {
+		var message RenameResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"textDocument/prepareRename": func(data []byte) (Message, error) {
+		var message PrepareRenameResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"workspace/executeCommand": func(data []byte) (Message, error) {
+		var message ExecuteCommandResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+	"workspace/applyEdit": func(data []byte) (Message, error) {
+		var message ApplyWorkspaceEditResponse
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	},
+}
+var MethodVersions = map[string]MethodVersion{
+	"workspace/foldingRange/refresh": {Since: "3.18.0", Proposed: true},
+	"textDocument/prepareCallHierarchy": {Since: "3.16.0"},
+	"callHierarchy/incomingCalls": {Since: "3.16.0"},
+	"callHierarchy/outgoingCalls": {Since: "3.16.0"},
+	"textDocument/semanticTokens/full": {Since: "3.16.0"},
+	"textDocument/semanticTokens/full/delta": {Since: "3.16.0"},
+	"textDocument/semanticTokens/range": {Since: "3.16.0"},
+	"workspace/semanticTokens/refresh": {Since: "3.16.0"},
+	"window/showDocument": {Since: "3.16.0"},
+	"textDocument/linkedEditingRange": {Since: "3.16.0"},
+	"workspace/willCreateFiles": {Since: "3.16.0"},
+	"workspace/willRenameFiles": {Since: "3.16.0"},
+	"workspace/willDeleteFiles": {Since: "3.16.0"},
+	"textDocument/prepareTypeHierarchy": {Since: "3.17.0"},
+	"typeHierarchy/supertypes": {Since: "3.17.0"},
+	"typeHierarchy/subtypes": {Since: "3.17.0"},
+	"textDocument/inlineValue": {Since: "3.17.0"},
+	"workspace/inlineValue/refresh": {Since: "3.17.0"},
+	"textDocument/inlayHint": {Since: "3.17.0"},
+	"inlayHint/resolve": {Since: "3.17.0"},
+	"workspace/inlayHint/refresh": {Since: "3.17.0"},
+	"textDocument/diagnostic": {Since: "3.17.0"},
+	"workspace/diagnostic": {Since: "3.17.0"},
+	"workspace/diagnostic/refresh": {Since: "3.17.0"},
+	"textDocument/inlineCompletion": {Since: "3.18.0", Proposed: true},
+	"workspace/textDocumentContent": {Since: "3.18.0", Proposed: true},
+	"workspace/textDocumentContent/refresh": {Since: "3.18.0", Proposed: true},
+	"workspaceSymbol/resolve": {Since: "3.17.0"},
+	"workspace/codeLens/refresh": {Since: "3.16.0"},
+	"textDocument/rangesFormatting": {Since: "3.18.0", Proposed: true},
+	"workspace/didCreateFiles": {Since: "3.16.0"},
+	"workspace/didRenameFiles": {Since: "3.16.0"},
+	"workspace/didDeleteFiles": {Since: "3.16.0"},
+	"notebookDocument/didOpen": {Since: "3.17.0"},
+	"notebookDocument/didSave": {Since: "3.17.0"},
+	"notebookDocument/didClose": {Since: "3.17.0"},
+}
+var TypeVersions = map[string]MethodVersion{
+	"AnnotatedTextEdit": {Since: "3.16.0"},
+	"CallHierarchyClientCapabilities": {Since: "3.16.0"},
+	"CallHierarchyIncomingCall": {Since: "3.16.0"},
+	"CallHierarchyIncomingCallsParams": {Since: "3.16.0"},
+	"CallHierarchyItem": {Since: "3.16.0"},
+	"CallHierarchyOptions": {Since: "3.16.0"},
+	"CallHierarchyOutgoingCall": {Since: "3.16.0"},
+	"CallHierarchyOutgoingCallsParams": {Since: "3.16.0"},
+	"CallHierarchyPrepareParams": {Since: "3.16.0"},
+	"CallHierarchyRegistrationOptions": {Since: "3.16.0"},
+	"ChangeAnnotation": {Since: "3.16.0"},
+	"CodeActionKindDocumentation": {Since: "3.18.0", Proposed: true},
+	"CodeActionTag": {Since: "3.18.0", Proposed: true},
+	"CodeActionTagOptions": {Since: "3.18.0", Proposed: true},
+	"CodeActionTriggerKind": {Since: "3.17.0"},
+	"CodeDescription": {Since: "3.16.0"},
+	"CodeLensWorkspaceClientCapabilities": {Since: "3.16.0"},
+	"CompletionItemDefaults": {Since: "3.17.0"},
+	"CompletionItemLabelDetails": {Since: "3.17.0"},
+	"CompletionItemTag": {Since: "3.15.0"},
+	"CompletionListCapabilities": {Since: "3.17.0"},
+	"CreateFilesParams": {Since: "3.16.0"},
+	"DeclarationClientCapabilities": {Since: "3.14.0"},
+	"DeleteFilesParams": {Since: "3.16.0"},
+	"DiagnosticClientCapabilities": {Since: "3.17.0"},
+	"DiagnosticOptions": {Since: "3.17.0"},
+	"DiagnosticRegistrationOptions": {Since: "3.17.0"},
+	"DiagnosticServerCancellationData": {Since: "3.17.0"},
+	"DiagnosticTag": {Since: "3.15.0"},
+	"DiagnosticWorkspaceClientCapabilities": {Since: "3.17.0"},
+	"DidChangeNotebookDocumentParams": {Since: "3.17.0"},
+	"DidCloseNotebookDocumentParams": {Since: "3.17.0"},
+	"DidOpenNotebookDocumentParams": {Since: "3.17.0"},
+	"DidSaveNotebookDocumentParams": {Since: "3.17.0"},
+	"DocumentDiagnosticParams": {Since: "3.17.0"},
+	"DocumentDiagnosticReportKind": {Since: "3.17.0"},
+	"DocumentDiagnosticReportPartialResult": {Since: "3.17.0"},
+	"DocumentRangesFormattingParams": {Since: "3.18.0", Proposed: true},
+	"FileCreate": {Since: "3.16.0"},
+	"FileDelete": {Since: "3.16.0"},
+	"FileOperationClientCapabilities": {Since: "3.16.0"},
+	"FileOperationFilter": {Since: "3.16.0"},
+	"FileOperationOptions": {Since: "3.16.0"},
+	"FileOperationPattern": {Since: "3.16.0"},
+	"FileOperationPatternKind": {Since: "3.16.0"},
+	"FileOperationPatternOptions": {Since: "3.16.0"},
+	"FileOperationRegistrationOptions": {Since: "3.16.0"},
+	"FileRename": {Since: "3.16.0"},
+	"FoldingRangeWorkspaceClientCapabilities": {Since: "3.18.0", Proposed: true},
+	"FullDocumentDiagnosticReport": {Since: "3.17.0"},
+	"GeneralClientCapabilities": {Since: "3.16.0"},
+	"ImplementationClientCapabilities": {Since: "3.6.0"},
+	"InlayHint": {Since: "3.17.0"},
+	"InlayHintClientCapabilities": {Since: "3.17.0"},
+	"InlayHintKind": {Since: "3.17.0"},
+	"InlayHintLabelPart": {Since: "3.17.0"},
+	"InlayHintOptions": {Since: "3.17.0"},
+	"InlayHintParams": {Since: "3.17.0"},
+	"InlayHintRegistrationOptions": {Since: "3.17.0"},
+	"InlayHintWorkspaceClientCapabilities": {Since: "3.17.0"},
+	"InlineCompletionClientCapabilities": {Since: "3.18.0", Proposed: true},
+	"InlineCompletionContext": {Since: "3.18.0", Proposed: true},
+	"InlineCompletionItem": {Since: "3.18.0", Proposed: true},
+	"InlineCompletionList": {Since: "3.18.0", Proposed: true},
+	"InlineCompletionOptions": {Since: "3.18.0", Proposed: true},
+	"InlineCompletionParams": {Since: "3.18.0", Proposed: true},
+	"InlineCompletionRegistrationOptions": {Since: "3.18.0", Proposed: true},
+	"InlineCompletionTriggerKind": {Since: "3.18.0", Proposed: true},
+	"InlineValueClientCapabilities": {Since: "3.17.0"},
+	"InlineValueContext": {Since: "3.17.0"},
+	"InlineValueEvaluatableExpression": {Since: "3.17.0"},
+	"InlineValueOptions": {Since: "3.17.0"},
+	"InlineValueParams": {Since: "3.17.0"},
+	"InlineValueRegistrationOptions": {Since: "3.17.0"},
+	"InlineValueText": {Since: "3.17.0"},
+	"InlineValueVariableLookup": {Since: "3.17.0"},
+	"InlineValueWorkspaceClientCapabilities": {Since: "3.17.0"},
+	"InsertReplaceEdit": {Since: "3.16.0"},
+	"InsertTextMode": {Since: "3.16.0"},
+	"LinkedEditingRangeClientCapabilities": {Since: "3.16.0"},
+	"LinkedEditingRanges": {Since: "3.16.0"},
+	"MarkdownClientCapabilities": {Since: "3.16.0"},
+	"Moniker": {Since: "3.16.0"},
+	"MonikerClientCapabilities": {Since: "3.16.0"},
+	"MonikerKind": {Since: "3.16.0"},
+	"NotebookCell": {Since: "3.17.0"},
+	"NotebookCellArrayChange": {Since: "3.17.0"},
+	"NotebookCellKind": {Since: "3.17.0"},
+	"NotebookCellTextDocumentFilter": {Since: "3.17.0"},
+	"NotebookDocument": {Since: "3.17.0"},
+	"NotebookDocumentChangeEvent": {Since: "3.17.0"},
+	"NotebookDocumentClientCapabilities": {Since: "3.17.0"},
+	"NotebookDocumentIdentifier": {Since: "3.17.0"},
+	"NotebookDocumentSyncClientCapabilities": {Since: "3.17.0"},
+	"NotebookDocumentSyncOptions": {Since: "3.17.0"},
+	"NotebookDocumentSyncRegistrationOptions": {Since: "3.17.0"},
+	"PositionEncodingKind": {Since: "3.17.0"},
+	"PreviousResultId": {Since: "3.17.0"},
+	"RegularExpressionsClientCapabilities": {Since: "3.16.0"},
+	"RelatedFullDocumentDiagnosticReport": {Since: "3.17.0"},
+	"RelatedUnchangedDocumentDiagnosticReport": {Since: "3.17.0"},
+	"RelativePattern": {Since: "3.17.0"},
+	"RenameFilesParams": {Since: "3.16.0"},
+	"SelectedCompletionInfo": {Since: "3.18.0", Proposed: true},
+	"SemanticTokenModifiers": {Since: "3.16.0"},
+	"SemanticTokenTypes": {Since: "3.16.0"},
+	"SemanticTokens": {Since: "3.16.0"},
+	"SemanticTokensClientCapabilities": {Since: "3.16.0"},
+	"SemanticTokensDelta": {Since: "3.16.0"},
+	"SemanticTokensDeltaParams": {Since: "3.16.0"},
+	"SemanticTokensDeltaPartialResult": {Since: "3.16.0"},
+	"SemanticTokensEdit": {Since: "3.16.0"},
+	"SemanticTokensLegend": {Since: "3.16.0"},
+	"SemanticTokensOptions": {Since: "3.16.0"},
+	"SemanticTokensParams": {Since: "3.16.0"},
+	"SemanticTokensPartialResult": {Since: "3.16.0"},
+	"SemanticTokensRangeParams": {Since: "3.16.0"},
+	"SemanticTokensRegistrationOptions": {Since: "3.16.0"},
+	"SemanticTokensWorkspaceClientCapabilities": {Since: "3.16.0"},
+	"ShowDocumentClientCapabilities": {Since: "3.16.0"},
+	"ShowDocumentParams": {Since: "3.16.0"},
+	"ShowDocumentResult": {Since: "3.16.0"},
+	"SignatureHelpContext": {Since: "3.15.0"},
+	"SignatureHelpTriggerKind": {Since: "3.15.0"},
+	"SnippetTextEdit": {Since: "3.18.0", Proposed: true},
+	"StringValue": {Since: "3.18.0", Proposed: true},
+	"SymbolTag": {Since: "3.16"},
+	"TextDocumentContentClientCapabilities": {Since: "3.18.0", Proposed: true},
+	"TextDocumentContentOptions": {Since: "3.18.0", Proposed: true},
+	"TextDocumentContentParams": {Since: "3.18.0", Proposed: true},
+	"TextDocumentContentRefreshParams": {Since: "3.18.0", Proposed: true},
+	"TextDocumentContentRegistrationOptions": {Since: "3.18.0", Proposed: true},
+	"TextDocumentContentResult": {Since: "3.18.0", Proposed: true},
+	"TypeHierarchyClientCapabilities": {Since: "3.17.0"},
+	"TypeHierarchyItem": {Since: "3.17.0"},
+	"TypeHierarchyOptions": {Since: "3.17.0"},
+	"TypeHierarchyPrepareParams": {Since: "3.17.0"},
+	"TypeHierarchyRegistrationOptions": {Since: "3.17.0"},
+	"TypeHierarchySubtypesParams": {Since: "3.17.0"},
+	"TypeHierarchySupertypesParams": {Since: "3.17.0"},
+	"UnchangedDocumentDiagnosticReport": {Since: "3.17.0"},
+	"UniquenessLevel": {Since: "3.16.0"},
+	"VersionedNotebookDocumentIdentifier": {Since: "3.17.0"},
+	"WorkspaceDiagnosticParams": {Since: "3.17.0"},
+	"WorkspaceDiagnosticReport": {Since: "3.17.0"},
+	"WorkspaceDiagnosticReportPartialResult": {Since: "3.17.0"},
+	"WorkspaceEditMetadata": {Since: "3.18.0", Proposed: true},
+	"WorkspaceFullDocumentDiagnosticReport": {Since: "3.17.0"},
+	"WorkspaceSymbol": {Since: "3.17.0"},
+	"WorkspaceUnchangedDocumentDiagnosticReport": {Since: "3.17.0"},
+}
+var RegistrationOptionsRegistry = map[string]func([]byte) (any, error) {
+	"textDocument/implementation": func(data []byte) (any, error) {
+		var options ImplementationRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"textDocument/typeDefinition": func(data []byte) (any, error) {
+		var options TypeDefinitionRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"textDocument/documentColor": func(data []byte) (any, error) {
+		var options DocumentColorRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"textDocument/foldingRange": func(data []byte) (any, error) {
+		var options FoldingRangeRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"textDocument/declaration": func(data []byte) (any, error) {
+		var options DeclarationRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"textDocument/selectionRange": func(data []byte) (any, error) {
+		var options SelectionRangeRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"textDocument/prepareCallHierarchy": func(data []byte) (any, error) {
+		var options CallHierarchyRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"textDocument/semanticTokens": func(data []byte) (any, error) {
+		var options SemanticTokensRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"textDocument/linkedEditingRange": func(data []byte) (any, error) {
+		var options LinkedEditingRangeRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"workspace/willCreateFiles": func(data []byte) (any, error) {
+		var options FileOperationRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"workspace/willRenameFiles": func(data []byte) (any, error) {
+		var options FileOperationRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"workspace/willDeleteFiles": func(data []byte) (any, error) {
+		var options FileOperationRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"textDocument/moniker": func(data []byte) (any, error) {
+		var options MonikerRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"textDocument/prepareTypeHierarchy": func(data []byte) (any, error) {
+		var options TypeHierarchyRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"textDocument/inlineValue": func(data []byte) (any, error) {
+		var options InlineValueRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"textDocument/inlayHint": func(data []byte) (any, error) {
+		var options InlayHintRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"textDocument/diagnostic": func(data []byte) (any, error) {
+		var options DiagnosticRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"textDocument/inlineCompletion": func(data []byte) (any, error) {
+		var options InlineCompletionRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"workspace/textDocumentContent": func(data []byte) (any, error) {
+		var options TextDocumentContentRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"textDocument/willSaveWaitUntil": func(data []byte) (any, error) {
+		var options TextDocumentRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"textDocument/completion": func(data []byte) (any, error) {
+		var options CompletionRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"textDocument/hover": func(data []byte) (any, error) {
+		var options HoverRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"textDocument/signatureHelp": func(data []byte) (any, error) {
+		var options SignatureHelpRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"textDocument/definition": func(data []byte) (any, error) {
+		var options DefinitionRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"textDocument/references": func(data []byte) (any, error) {
+		var options ReferenceRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"textDocument/documentHighlight": func(data []byte) (any, error) {
+		var options DocumentHighlightRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"textDocument/documentSymbol": func(data []byte) (any, error) {
+		var options DocumentSymbolRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"textDocument/codeAction": func(data []byte) (any, error) {
+		var options CodeActionRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"workspace/symbol": func(data []byte) (any, error) {
+		var options WorkspaceSymbolRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"textDocument/codeLens": func(data []byte) (any, error) {
+		var options CodeLensRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"textDocument/documentLink": func(data []byte) (any, error) {
+		var options DocumentLinkRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"textDocument/formatting": func(data []byte) (any, error) {
+		var options DocumentFormattingRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"textDocument/rangeFormatting": func(data []byte) (any, error) {
+		var options DocumentRangeFormattingRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"textDocument/rangesFormatting": func(data []byte) (any, error) {
+		var options DocumentRangeFormattingRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"textDocument/onTypeFormatting": func(data []byte) (any, error) {
+		var options DocumentOnTypeFormattingRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"textDocument/rename": func(data []byte) (any, error) {
+		var options RenameRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"workspace/executeCommand": func(data []byte) (any, error) {
+		var options ExecuteCommandRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"workspace/didCreateFiles": func(data []byte) (any, error) {
+		var options FileOperationRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"workspace/didRenameFiles": func(data []byte) (any, error) {
+		var options FileOperationRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"workspace/didDeleteFiles": func(data []byte) (any, error) {
+		var options FileOperationRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"notebookDocument/sync": func(data []byte) (any, error) {
+		var options NotebookDocumentSyncRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"workspace/didChangeConfiguration": func(data []byte) (any, error) {
+		var options DidChangeConfigurationRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"textDocument/didOpen": func(data []byte) (any, error) {
+		var options TextDocumentRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"textDocument/didChange": func(data []byte) (any, error) {
+		var options TextDocumentChangeRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"textDocument/didClose": func(data []byte) (any, error) {
+		var options TextDocumentRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"textDocument/didSave": func(data []byte) (any, error) {
+		var options TextDocumentSaveRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"textDocument/willSave": func(data []byte) (any, error) {
+		var options TextDocumentRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
+	"workspace/didChangeWatchedFiles": func(data []byte) (any, error) {
+		var options DidChangeWatchedFilesRegistrationOptions
+		if err := json.Unmarshal(data, &options); err != nil {
+			return nil, err
+		}
+		return options, nil
+	},
 }
\ No newline at end of file
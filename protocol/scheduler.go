@@ -0,0 +1,139 @@
+package protocol
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+)
+
+// The cause of a request's context being cancelled because the document it
+// targets changed.
+var errContentModified = errors.New("content modified")
+
+// The requests Scheduler.CancelOnChange cancels by default: those that only
+// read the document they target, so their result is stale once it changes.
+// Requests that change state or return edits, such as textDocument/rename or
+// textDocument/willSaveWaitUntil, are left to finish.
+var DefaultCancelMethods = []MethodKind{
+	TextDocumentCodeActionMethod,
+	TextDocumentCodeLensMethod,
+	TextDocumentColorPresentationMethod,
+	TextDocumentCompletionMethod,
+	TextDocumentDeclarationMethod,
+	TextDocumentDefinitionMethod,
+	TextDocumentDiagnosticMethod,
+	TextDocumentDocumentColorMethod,
+	TextDocumentDocumentHighlightMethod,
+	TextDocumentDocumentLinkMethod,
+	TextDocumentDocumentSymbolMethod,
+	TextDocumentFoldingRangeMethod,
+	TextDocumentHoverMethod,
+	TextDocumentImplementationMethod,
+	TextDocumentInlayHintMethod,
+	TextDocumentInlineCompletionMethod,
+	TextDocumentInlineValueMethod,
+	TextDocumentLinkedEditingRangeMethod,
+	TextDocumentMonikerMethod,
+	TextDocumentPrepareCallHierarchyMethod,
+	TextDocumentPrepareRenameMethod,
+	TextDocumentPrepareTypeHierarchyMethod,
+	TextDocumentReferencesMethod,
+	TextDocumentSelectionRangeMethod,
+	TextDocumentSemanticTokensFullMethod,
+	TextDocumentSemanticTokensFullDeltaMethod,
+	TextDocumentSemanticTokensRangeMethod,
+	TextDocumentSignatureHelpMethod,
+	TextDocumentTypeDefinitionMethod,
+}
+
+// Scheduler configures how a Conn runs its Handler. Notifications, and
+// requests for the Serialized methods, are handled one at a time in the order
+// they were received. Other requests are handled concurrently by up to Workers
+// goroutines, and start in the order they were received, after any message
+// received before them that is handled in order.
+//
+// The zero value handles every message in order.
+type Scheduler struct {
+	// The number of requests that may be handled concurrently. With 0 or
+	// less, every request is handled in order.
+	Workers int
+	// Request methods that are handled in order, along with notifications,
+	// e.g. requests that change the server's state.
+	Serialized []MethodKind
+	// Cancels concurrently handled requests for a text document once a
+	// didChange or didClose notification for it is received. They are
+	// answered with LSPErrorCodesContentModified.
+	CancelOnChange bool
+	// The request methods CancelOnChange applies to. Defaults to
+	// DefaultCancelMethods.
+	CancelMethods []MethodKind
+}
+
+// Reports whether message is handled in order.
+func (s Scheduler) serial(message IncomingMessage) bool {
+	if s.Workers <= 0 {
+		return true
+	}
+
+	if _, isRequest := message.(Request); !isRequest {
+		return true
+	}
+
+	return slices.Contains(s.Serialized, message.GetMethod())
+}
+
+// Reports whether message is cancelled once the document it targets changes.
+func (s Scheduler) cancels(message IncomingMessage) bool {
+	if !s.CancelOnChange {
+		return false
+	}
+
+	if s.CancelMethods == nil {
+		return slices.Contains(DefaultCancelMethods, message.GetMethod())
+	}
+
+	return slices.Contains(s.CancelMethods, message.GetMethod())
+}
+
+// Returns the text document a didChange or didClose notification changes.
+func changedDocument(message IncomingMessage) (DocumentUri, bool) {
+	switch message := message.(type) {
+	case DidChangeTextDocumentNotification:
+		return message.Params.TextDocument.Uri, true
+	case DidCloseTextDocumentNotification:
+		return message.Params.TextDocument.Uri, true
+	}
+
+	return "", false
+}
+
+// Helper function that returns the text document a message targets, for
+// messages with a textDocument parameter.
+func TargetDocument(message IncomingMessage) (DocumentUri, bool) {
+	params := reflect.ValueOf(message.GetParams())
+
+	for params.Kind() == reflect.Pointer {
+		if params.IsNil() {
+			return "", false
+		}
+		params = params.Elem()
+	}
+
+	if params.Kind() != reflect.Struct {
+		return "", false
+	}
+
+	document := params.FieldByName("TextDocument")
+
+	if document.Kind() != reflect.Struct {
+		return "", false
+	}
+
+	uri := document.FieldByName("Uri")
+
+	if !uri.IsValid() || uri.Type() != reflect.TypeFor[DocumentUri]() {
+		return "", false
+	}
+
+	return uri.Interface().(DocumentUri), true
+}
@@ -0,0 +1,201 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func hoverParams(uri DocumentUri) HoverParams {
+	return HoverParams{TextDocument: TextDocumentIdentifier{Uri: uri}}
+}
+
+func TestSchedulerRunsRequestsConcurrently(t *testing.T) {
+	started := make(chan struct{})
+
+	client, _ := connPair(t, ConnOptions{}, ConnOptions{
+		Handler: func(ctx context.Context, message IncomingMessage) (any, error) {
+			request := message.(HoverRequest)
+			if request.Params.TextDocument.Uri == "file:///first.go" {
+				// Only returns once the second request started.
+				select {
+				case <-started:
+				case <-time.After(5 * time.Second):
+					return nil, errors.New("second request did not start")
+				}
+			} else {
+				close(started)
+			}
+			return nil, nil
+		},
+		Scheduler: Scheduler{Workers: 2},
+	})
+
+	errs := make(chan error, 2)
+
+	go func() {
+		_, err := client.Hover(context.Background(), hoverParams("file:///first.go"))
+		errs <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	go func() {
+		_, err := client.Hover(context.Background(), hoverParams("file:///second.go"))
+		errs <- err
+	}()
+
+	for range 2 {
+		if err := <-errs; err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestSchedulerOrdersNotifications(t *testing.T) {
+	received := make(chan int32, 10)
+
+	client, _ := connPair(t, ConnOptions{}, ConnOptions{
+		Handler: func(ctx context.Context, message IncomingMessage) (any, error) {
+			if change, ok := message.(DidChangeTextDocumentNotification); ok {
+				received <- change.Params.TextDocument.Version
+			}
+			return nil, nil
+		},
+		Scheduler: Scheduler{Workers: 4},
+	})
+
+	for version := range int32(10) {
+		client.DidChangeTextDocument(context.Background(), DidChangeTextDocumentParams{
+			TextDocument: VersionedTextDocumentIdentifier{Uri: "file:///a.go", Version: version},
+		})
+	}
+
+	for version := range int32(10) {
+		if got := <-received; got != version {
+			t.Fatalf("Expected version %d, got %d", version, got)
+		}
+	}
+}
+
+func TestSchedulerCancelsOnChange(t *testing.T) {
+	client, _ := connPair(t, ConnOptions{}, ConnOptions{
+		Handler: func(ctx context.Context, message IncomingMessage) (any, error) {
+			if _, ok := message.(HoverRequest); ok {
+				<-ctx.Done()
+				return &Hover{}, nil
+			}
+			return nil, nil
+		},
+		Scheduler: Scheduler{Workers: 2, CancelOnChange: true},
+	})
+
+	errs := make(chan error, 1)
+
+	go func() {
+		_, err := client.Hover(context.Background(), hoverParams("file:///a.go"))
+		errs <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	client.DidChangeTextDocument(context.Background(), DidChangeTextDocumentParams{
+		TextDocument: VersionedTextDocumentIdentifier{Uri: "file:///a.go", Version: 2},
+	})
+
+	var responseError *ResponseError
+	if err := <-errs; !errors.As(err, &responseError) || responseError.Code != int32(LSPErrorCodesContentModified) {
+		t.Fatalf("Expected ContentModified response error, got %v", err)
+	}
+}
+
+func TestSchedulerKeepsWritesOnChange(t *testing.T) {
+	release := make(chan struct{})
+	client, _ := connPair(t, ConnOptions{}, ConnOptions{
+		Handler: func(ctx context.Context, message IncomingMessage) (any, error) {
+			if _, ok := message.(RenameRequest); ok {
+				select {
+				case <-release:
+					return &WorkspaceEdit{}, nil
+				case <-ctx.Done():
+					return nil, ctx.Err()
+				}
+			}
+			return nil, nil
+		},
+		Scheduler: Scheduler{Workers: 2, CancelOnChange: true},
+	})
+
+	errs := make(chan error, 1)
+
+	go func() {
+		_, err := client.Rename(context.Background(), RenameParams{
+			TextDocument: TextDocumentIdentifier{Uri: "file:///a.go"},
+			NewName:      "renamed",
+		})
+		errs <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	client.DidChangeTextDocument(context.Background(), DidChangeTextDocumentParams{
+		TextDocument: VersionedTextDocumentIdentifier{Uri: "file:///a.go", Version: 2},
+	})
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+
+	if err := <-errs; err != nil {
+		t.Fatalf("Expected rename to finish despite the change, got %v", err)
+	}
+}
+
+func TestSchedulerCancels(t *testing.T) {
+	scheduler := Scheduler{CancelOnChange: true}
+
+	if !scheduler.cancels(HoverRequest{Method: TextDocumentHoverMethod}) {
+		t.Fatal("Expected hover to be cancelled by default")
+	}
+	for _, method := range []MethodKind{TextDocumentRenameMethod, TextDocumentWillSaveWaitUntilMethod, WorkspaceExecuteCommandMethod} {
+		if slices.Contains(DefaultCancelMethods, method) {
+			t.Fatalf("Expected %s not to be cancelled by default", method)
+		}
+	}
+
+	scheduler.CancelMethods = []MethodKind{TextDocumentFormattingMethod}
+
+	if scheduler.cancels(HoverRequest{Method: TextDocumentHoverMethod}) {
+		t.Fatal("Expected only the configured methods to be cancelled")
+	}
+	if !scheduler.cancels(DocumentFormattingRequest{Method: TextDocumentFormattingMethod}) {
+		t.Fatal("Expected the configured methods to be cancelled")
+	}
+	if (Scheduler{}).cancels(HoverRequest{Method: TextDocumentHoverMethod}) {
+		t.Fatal("Expected nothing to be cancelled without CancelOnChange")
+	}
+}
+
+func TestSchedulerSerial(t *testing.T) {
+	scheduler := Scheduler{Workers: 4, Serialized: []MethodKind{TextDocumentRenameMethod}}
+
+	if !scheduler.serial(DidOpenTextDocumentNotification{Method: TextDocumentDidOpenMethod}) {
+		t.Fatal("Expected notifications to be handled in order")
+	}
+	if !scheduler.serial(RenameRequest{Method: TextDocumentRenameMethod}) {
+		t.Fatal("Expected serialized methods to be handled in order")
+	}
+	if scheduler.serial(HoverRequest{Method: TextDocumentHoverMethod}) {
+		t.Fatal("Expected other requests to be handled concurrently")
+	}
+	if !(Scheduler{}).serial(HoverRequest{Method: TextDocumentHoverMethod}) {
+		t.Fatal("Expected the zero scheduler to handle every message in order")
+	}
+}
+
+func TestTargetDocument(t *testing.T) {
+	uri, ok := TargetDocument(HoverRequest{Params: hoverParams("file:///a.go")})
+	if !ok || uri != "file:///a.go" {
+		t.Fatalf("Expected file:///a.go, got %s", uri)
+	}
+
+	if _, ok := TargetDocument(ShutdownRequest{}); ok {
+		t.Fatal("Expected no document for a request without params")
+	}
+}
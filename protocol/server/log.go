@@ -0,0 +1,205 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+)
+
+// The number of records LogHandler holds before it drops new ones.
+const DefaultLogBufferSize = 256
+
+// LogHandlerOptions configures a LogHandler.
+type LogHandlerOptions struct {
+	// The minimum level of the records sent. Defaults to slog.LevelInfo.
+	Level slog.Leveler
+	// Reports whether a record is also shown to the user with
+	// window/showMessage, e.g. errors the user needs to act on.
+	Show func(record slog.Record) bool
+	// The number of records held while the connection isn't attached yet, or
+	// while it is slow to write. A value of 0 means DefaultLogBufferSize.
+	BufferSize int
+	// Whether the client supports MessageTypeDebug, which is proposed for 3.18
+	// and has no client capability. Without it, records below slog.LevelDebug
+	// are sent as MessageTypeLog, which every client supports.
+	DebugSupport bool
+}
+
+// LogHandler is a slog.Handler that sends records to the client as
+// window/logMessage notifications, so they show up in the editor's output
+// panel. Levels map to message types: slog.LevelError and above to Error,
+// slog.LevelWarn to Warning, slog.LevelInfo to Info, slog.LevelDebug to Log,
+// and anything below to Debug for clients with DebugSupport, or to Log.
+//
+// Records are held until Attach is called with a connection that finished
+// initializing, and are sent in order by a single goroutine. Logging never
+// blocks: records that don't fit in the buffer are dropped.
+type LogHandler struct {
+	core   *logCore
+	attrs  string
+	groups string
+}
+
+// State shared by a LogHandler and the handlers derived from it.
+type logCore struct {
+	options  LogHandlerOptions
+	records  chan logRecord
+	attached sync.Once
+	dropped  atomic.Int64
+}
+
+type logRecord struct {
+	messageType protocol.MessageType
+	message     string
+	show        bool
+}
+
+// Creates a LogHandler, which holds its records until Attach is called.
+func NewLogHandler(options LogHandlerOptions) *LogHandler {
+	if options.Level == nil {
+		options.Level = slog.LevelInfo
+	}
+
+	if options.BufferSize <= 0 {
+		options.BufferSize = DefaultLogBufferSize
+	}
+
+	return &LogHandler{
+		core: &logCore{
+			options: options,
+			records: make(chan logRecord, options.BufferSize),
+		},
+	}
+}
+
+// Starts sending records over conn, beginning with the ones held so far, until
+// the connection is closed. Only the first call has an effect.
+func (h *LogHandler) Attach(conn *protocol.Conn) {
+	h.core.attached.Do(func() {
+		go h.core.send(conn)
+	})
+}
+
+// Returns the number of records dropped because the buffer was full.
+func (h *LogHandler) Dropped() int64 {
+	return h.core.dropped.Load()
+}
+
+func (h *LogHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return level >= h.core.options.Level.Level()
+}
+
+func (h *LogHandler) Handle(ctx context.Context, record slog.Record) error {
+	var message strings.Builder
+
+	message.WriteString(record.Message)
+	message.WriteString(h.attrs)
+
+	record.Attrs(func(attr slog.Attr) bool {
+		appendAttr(&message, h.groups, attr)
+		return true
+	})
+
+	entry := logRecord{
+		messageType: messageType(record.Level, h.core.options.DebugSupport),
+		message:     message.String(),
+		show:        h.core.options.Show != nil && h.core.options.Show(record),
+	}
+
+	select {
+	case h.core.records <- entry:
+	default:
+		h.core.dropped.Add(1)
+	}
+
+	return nil
+}
+
+func (h *LogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	var formatted strings.Builder
+
+	formatted.WriteString(h.attrs)
+
+	for _, attr := range attrs {
+		appendAttr(&formatted, h.groups, attr)
+	}
+
+	return &LogHandler{core: h.core, attrs: formatted.String(), groups: h.groups}
+}
+
+func (h *LogHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+
+	return &LogHandler{core: h.core, attrs: h.attrs, groups: h.groups + name + "."}
+}
+
+func (c *logCore) send(conn *protocol.Conn) {
+	ctx := context.Background()
+
+	for {
+		select {
+		case <-conn.Done():
+			return
+		case record := <-c.records:
+			conn.LogMessage(ctx, protocol.LogMessageParams{Type: record.messageType, Message: record.message})
+
+			if record.show {
+				conn.ShowMessageNotification(ctx, protocol.ShowMessageParams{Type: record.messageType, Message: record.message})
+			}
+		}
+	}
+}
+
+// Maps a slog level to the message type it is sent with.
+func messageType(level slog.Level, debugSupport bool) protocol.MessageType {
+	switch {
+	case level >= slog.LevelError:
+		return protocol.MessageTypeError
+	case level >= slog.LevelWarn:
+		return protocol.MessageTypeWarning
+	case level >= slog.LevelInfo:
+		return protocol.MessageTypeInfo
+	case level >= slog.LevelDebug || !debugSupport:
+		return protocol.MessageTypeLog
+	}
+
+	return protocol.MessageTypeDebug
+}
+
+// Appends an attribute as " key=value", with groups as dotted prefixes.
+func appendAttr(builder *strings.Builder, prefix string, attr slog.Attr) {
+	attr.Value = attr.Value.Resolve()
+
+	if attr.Equal(slog.Attr{}) {
+		return
+	}
+
+	if attr.Value.Kind() == slog.KindGroup {
+		if attr.Key != "" {
+			prefix += attr.Key + "."
+		}
+		for _, member := range attr.Value.Group() {
+			appendAttr(builder, prefix, member)
+		}
+		return
+	}
+
+	value := attr.Value.String()
+
+	if value == "" || strings.ContainsAny(value, " =\"\n") {
+		value = strconv.Quote(value)
+	}
+
+	builder.WriteString(" ")
+	builder.WriteString(prefix)
+	builder.WriteString(attr.Key)
+	builder.WriteString("=")
+	builder.WriteString(value)
+}
@@ -0,0 +1,104 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+)
+
+func TestLogHandlerBuffersUntilAttached(t *testing.T) {
+	conn, received := serverConn(t)
+	handler := NewLogHandler(LogHandlerOptions{
+		Level: slog.LevelDebug,
+		Show: func(record slog.Record) bool {
+			return record.Level >= slog.LevelError
+		},
+	})
+	logger := slog.New(handler).With("component", "indexer").WithGroup("request")
+
+	logger.Debug("starting")
+	logger.Error("index failed", "path", "/tmp/a b.go", slog.Group("retry", "attempt", 2))
+
+	select {
+	case message := <-received:
+		t.Fatalf("Expected nothing to be sent before Attach, got %#v", message)
+	default:
+	}
+
+	handler.Attach(conn)
+
+	debug := (<-received).(protocol.LogMessageNotification)
+	if debug.Params.Type != protocol.MessageTypeLog || debug.Params.Message != "starting component=indexer" {
+		t.Fatalf("Unexpected log message: %+v", debug.Params)
+	}
+
+	expected := `index failed component=indexer request.path="/tmp/a b.go" request.retry.attempt=2`
+
+	failed := (<-received).(protocol.LogMessageNotification)
+	if failed.Params.Type != protocol.MessageTypeError || failed.Params.Message != expected {
+		t.Fatalf("Unexpected log message: %+v", failed.Params)
+	}
+
+	shown := (<-received).(protocol.ShowMessageNotification)
+	if shown.Params.Type != protocol.MessageTypeError || shown.Params.Message != expected {
+		t.Fatalf("Expected the error to be shown, got %+v", shown.Params)
+	}
+}
+
+func TestLogHandlerDropsWhenFull(t *testing.T) {
+	handler := NewLogHandler(LogHandlerOptions{BufferSize: 2})
+	logger := slog.New(handler)
+
+	for range 5 {
+		logger.Info("message")
+	}
+	logger.Debug("below the level")
+
+	if handler.Dropped() != 3 {
+		t.Fatalf("Expected 3 dropped records, got %d", handler.Dropped())
+	}
+	if handler.Enabled(context.Background(), slog.LevelDebug) {
+		t.Fatal("Expected debug records to be disabled by default")
+	}
+}
+
+func TestLogHandlerDebugSupport(t *testing.T) {
+	for _, debugSupport := range []bool{false, true} {
+		conn, received := serverConn(t)
+		handler := NewLogHandler(LogHandlerOptions{Level: slog.LevelDebug - 4, DebugSupport: debugSupport})
+		handler.Attach(conn)
+
+		slog.New(handler).Log(context.Background(), slog.LevelDebug-4, "trace")
+
+		expected := protocol.MessageTypeLog
+		if debugSupport {
+			expected = protocol.MessageTypeDebug
+		}
+
+		if message := (<-received).(protocol.LogMessageNotification); message.Params.Type != expected {
+			t.Fatalf("Expected type %d with debug support %v, got %d", expected, debugSupport, message.Params.Type)
+		}
+	}
+}
+
+func TestMessageType(t *testing.T) {
+	levels := map[slog.Level]protocol.MessageType{
+		slog.LevelError + 4: protocol.MessageTypeError,
+		slog.LevelWarn:      protocol.MessageTypeWarning,
+		slog.LevelInfo:      protocol.MessageTypeInfo,
+		slog.LevelDebug:     protocol.MessageTypeLog,
+		slog.LevelDebug - 4: protocol.MessageTypeDebug,
+	}
+
+	for level, expected := range levels {
+		if got := messageType(level, true); got != expected {
+			t.Fatalf("Expected %d for %s, got %d", expected, level, got)
+		}
+	}
+
+	if got := messageType(slog.LevelDebug-4, false); got != protocol.MessageTypeLog {
+		t.Fatalf("Expected Log for clients without debug support, got %d", got)
+	}
+}
@@ -0,0 +1,309 @@
+// Package server provides state a language server keeps on behalf of its
+// client, such as the notebooks and diagnostics it is tracking.
+package server
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+)
+
+// CellDocument is the text document of a notebook cell.
+type CellDocument struct {
+	Uri        protocol.DocumentUri
+	LanguageId protocol.LanguageKind
+	Version    int32
+	Text       string
+	// The notebook the cell belongs to.
+	Notebook protocol.URI
+}
+
+// NotebookStore keeps the notebooks a client synced through notebookDocument/*
+// notifications, along with the text documents of their cells. Notebooks that
+// don't match the notebook selector of its NotebookDocumentSyncOptions are
+// ignored.
+type NotebookStore struct {
+	options  protocol.NotebookDocumentSyncOptions
+	encoding protocol.PositionEncodingKind
+
+	mu        sync.Mutex
+	notebooks map[protocol.URI]*protocol.NotebookDocument
+	cells     map[protocol.DocumentUri]*CellDocument
+	ignored   map[protocol.URI]bool
+}
+
+// Creates a store for the notebooks selected by options. An empty notebook
+// selector selects every notebook. Cell text changes are applied in encoding.
+func NewNotebookStore(options protocol.NotebookDocumentSyncOptions, encoding protocol.PositionEncodingKind) *NotebookStore {
+	return &NotebookStore{
+		options:   options,
+		encoding:  encoding,
+		notebooks: map[protocol.URI]*protocol.NotebookDocument{},
+		cells:     map[protocol.DocumentUri]*CellDocument{},
+		ignored:   map[protocol.URI]bool{},
+	}
+}
+
+// Reports whether a notebook whose cells are in cellLanguages matches the
+// store's notebook selector.
+func (s *NotebookStore) Synced(notebookType string, uri protocol.URI, cellLanguages []protocol.LanguageKind) bool {
+	if len(s.options.NotebookSelector) == 0 {
+		return true
+	}
+
+	for _, selector := range s.options.NotebookSelector {
+		if protocol.MatchNotebookSelector(selector, notebookType, uri, cellLanguages) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Handles the notebookDocument/didOpen, didChange and didClose notifications,
+// and reports whether message was one of them.
+func (s *NotebookStore) Handle(message protocol.IncomingMessage) (bool, error) {
+	switch message := message.(type) {
+	case protocol.DidOpenNotebookDocumentNotification:
+		return true, s.DidOpen(message.Params)
+	case protocol.DidChangeNotebookDocumentNotification:
+		return true, s.DidChange(message.Params)
+	case protocol.DidCloseNotebookDocumentNotification:
+		return true, s.DidClose(message.Params)
+	}
+
+	return false, nil
+}
+
+// Opens a notebook and the text documents of its cells.
+func (s *NotebookStore) DidOpen(params protocol.DidOpenNotebookDocumentParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	notebook := params.NotebookDocument
+
+	if _, exists := s.notebooks[notebook.Uri]; exists {
+		return fmt.Errorf("notebook already open: %s", notebook.Uri)
+	}
+
+	cellLanguages := make([]protocol.LanguageKind, 0, len(params.CellTextDocuments))
+
+	for _, item := range params.CellTextDocuments {
+		cellLanguages = append(cellLanguages, item.LanguageId)
+	}
+
+	if !s.Synced(notebook.NotebookType, notebook.Uri, cellLanguages) {
+		s.ignored[notebook.Uri] = true
+		return nil
+	}
+
+	notebook.Cells = slices.Clone(notebook.Cells)
+	s.notebooks[notebook.Uri] = &notebook
+
+	for _, item := range params.CellTextDocuments {
+		s.cells[item.Uri] = &CellDocument{
+			Uri:        item.Uri,
+			LanguageId: item.LanguageId,
+			Version:    item.Version,
+			Text:       item.Text,
+			Notebook:   notebook.Uri,
+		}
+	}
+
+	return nil
+}
+
+// Applies a change to an open notebook. The change is applied as a whole, or
+// not at all if any part of it is invalid.
+func (s *NotebookStore) DidChange(params protocol.DidChangeNotebookDocumentParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	uri := params.NotebookDocument.Uri
+
+	if s.ignored[uri] {
+		return nil
+	}
+
+	current, exists := s.notebooks[uri]
+
+	if !exists {
+		return fmt.Errorf("notebook not open: %s", uri)
+	}
+
+	notebook := *current
+	notebook.Version = params.NotebookDocument.Version
+	notebook.Cells = slices.Clone(current.Cells)
+
+	if params.Change.Metadata != nil {
+		notebook.Metadata = params.Change.Metadata
+	}
+
+	// Cell documents that are opened, changed or closed by the change.
+	opened := map[protocol.DocumentUri]*CellDocument{}
+	closed := map[protocol.DocumentUri]bool{}
+
+	lookup := func(uri protocol.DocumentUri) (*CellDocument, bool) {
+		if cell, exists := opened[uri]; exists {
+			return cell, true
+		}
+		if closed[uri] {
+			return nil, false
+		}
+		cell, exists := s.cells[uri]
+		if !exists || cell.Notebook != notebook.Uri {
+			return nil, false
+		}
+		return cell, true
+	}
+
+	if cells := params.Change.Cells; cells != nil {
+		if structure := cells.Structure; structure != nil {
+			start := int(structure.Array.Start)
+			end := start + int(structure.Array.DeleteCount)
+
+			if end > len(notebook.Cells) {
+				return fmt.Errorf("invalid cell change for %s: cells %d to %d of %d", uri, start, end, len(notebook.Cells))
+			}
+			notebook.Cells = slices.Replace(notebook.Cells, start, end, structure.Array.Cells...)
+
+			for _, identifier := range structure.DidClose {
+				if _, exists := lookup(identifier.Uri); !exists {
+					return fmt.Errorf("cell not open: %s", identifier.Uri)
+				}
+				delete(opened, identifier.Uri)
+				closed[identifier.Uri] = true
+			}
+
+			for _, item := range structure.DidOpen {
+				opened[item.Uri] = &CellDocument{
+					Uri:        item.Uri,
+					LanguageId: item.LanguageId,
+					Version:    item.Version,
+					Text:       item.Text,
+					Notebook:   notebook.Uri,
+				}
+			}
+		}
+
+		for _, data := range cells.Data {
+			index := slices.IndexFunc(notebook.Cells, func(cell protocol.NotebookCell) bool {
+				return cell.Document == data.Document
+			})
+
+			if index < 0 {
+				return fmt.Errorf("cell not in notebook %s: %s", uri, data.Document)
+			}
+			notebook.Cells[index] = data
+		}
+
+		for _, content := range cells.TextContent {
+			cell, exists := lookup(content.Document.Uri)
+
+			if !exists {
+				return fmt.Errorf("cell not open: %s", content.Document.Uri)
+			}
+
+			text, err := protocol.ApplyContentChanges(cell.Text, content.Changes, s.encoding)
+
+			if err != nil {
+				return fmt.Errorf("invalid change for %s: %w", content.Document.Uri, err)
+			}
+
+			changed := *cell
+			changed.Text = text
+			changed.Version = content.Document.Version
+			opened[changed.Uri] = &changed
+		}
+	}
+
+	*current = notebook
+
+	for uri := range closed {
+		delete(s.cells, uri)
+	}
+
+	for uri, cell := range opened {
+		s.cells[uri] = cell
+	}
+
+	return nil
+}
+
+// Closes a notebook and the text documents of its cells.
+func (s *NotebookStore) DidClose(params protocol.DidCloseNotebookDocumentParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	uri := params.NotebookDocument.Uri
+
+	if s.ignored[uri] {
+		delete(s.ignored, uri)
+		return nil
+	}
+
+	if _, exists := s.notebooks[uri]; !exists {
+		return fmt.Errorf("notebook not open: %s", uri)
+	}
+	delete(s.notebooks, uri)
+
+	for cellUri, cell := range s.cells {
+		if cell.Notebook == uri {
+			delete(s.cells, cellUri)
+		}
+	}
+
+	return nil
+}
+
+// Returns a copy of an open notebook.
+func (s *NotebookStore) Notebook(uri protocol.URI) (protocol.NotebookDocument, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	notebook, exists := s.notebooks[uri]
+
+	if !exists {
+		return protocol.NotebookDocument{}, false
+	}
+
+	copied := *notebook
+	copied.Cells = slices.Clone(notebook.Cells)
+
+	return copied, true
+}
+
+// Returns a copy of the text document of an open cell.
+func (s *NotebookStore) Cell(uri protocol.DocumentUri) (CellDocument, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cell, exists := s.cells[uri]
+
+	if !exists {
+		return CellDocument{}, false
+	}
+
+	return *cell, true
+}
+
+// Returns the notebook a cell document belongs to, and the index of the cell
+// in it.
+func (s *NotebookStore) Locate(uri protocol.DocumentUri) (protocol.URI, int, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for notebookUri, notebook := range s.notebooks {
+		index := slices.IndexFunc(notebook.Cells, func(cell protocol.NotebookCell) bool {
+			return cell.Document == uri
+		})
+
+		if index >= 0 {
+			return notebookUri, index, true
+		}
+	}
+
+	return "", 0, false
+}
@@ -0,0 +1,188 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+)
+
+const notebookUri = protocol.URI("file:///workspace/a.ipynb")
+
+func cell(uri protocol.DocumentUri) protocol.NotebookCell {
+	return protocol.NotebookCell{Document: uri, Kind: protocol.NotebookCellKindCode}
+}
+
+func cellItem(uri protocol.DocumentUri, text string) protocol.TextDocumentItem {
+	return protocol.TextDocumentItem{Uri: uri, LanguageId: protocol.LanguageKindPython, Version: 1, Text: text}
+}
+
+func openNotebook(t *testing.T, store *NotebookStore) {
+	err := store.DidOpen(protocol.DidOpenNotebookDocumentParams{
+		NotebookDocument: protocol.NotebookDocument{
+			Uri:          notebookUri,
+			NotebookType: "jupyter-notebook",
+			Version:      1,
+			Cells:        []protocol.NotebookCell{cell("cell:1"), cell("cell:2")},
+		},
+		CellTextDocuments: []protocol.TextDocumentItem{cellItem("cell:1", "a = 1"), cellItem("cell:2", "b = 2")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNotebookStoreChanges(t *testing.T) {
+	store := NewNotebookStore(protocol.NotebookDocumentSyncOptions{}, protocol.PositionEncodingKindUTF16)
+	openNotebook(t, store)
+
+	metadata := protocol.LSPObject{"kernel": "python3"}
+
+	err := store.DidChange(protocol.DidChangeNotebookDocumentParams{
+		NotebookDocument: protocol.VersionedNotebookDocumentIdentifier{Uri: notebookUri, Version: 2},
+		Change: protocol.NotebookDocumentChangeEvent{
+			Metadata: &metadata,
+			Cells: &protocol.NotebookDocumentCellChanges{
+				Structure: &protocol.NotebookDocumentCellChangeStructure{
+					Array:    protocol.NotebookCellArrayChange{Start: 0, DeleteCount: 1, Cells: []protocol.NotebookCell{cell("cell:3")}},
+					DidOpen:  []protocol.TextDocumentItem{cellItem("cell:3", "c = 3")},
+					DidClose: []protocol.TextDocumentIdentifier{{Uri: "cell:1"}},
+				},
+				Data: []protocol.NotebookCell{{Document: "cell:2", Kind: protocol.NotebookCellKindMarkup}},
+				TextContent: []protocol.NotebookDocumentCellContentChanges{{
+					Document: protocol.VersionedTextDocumentIdentifier{Uri: "cell:2", Version: 2},
+					Changes: []protocol.TextDocumentContentChangeEvent{{Value: protocol.TextDocumentContentChangePartial{
+						Range: protocol.Range{Start: protocol.Position{Line: 0, Character: 4}, End: protocol.Position{Line: 0, Character: 5}},
+						Text:  "20",
+					}}},
+				}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	notebook, exists := store.Notebook(notebookUri)
+	if !exists {
+		t.Fatal("Expected the notebook to be open")
+	}
+	if notebook.Version != 2 || notebook.Metadata == nil || (*notebook.Metadata)["kernel"] != "python3" {
+		t.Fatalf("Expected the version and metadata to be updated, got %+v", notebook)
+	}
+	if len(notebook.Cells) != 2 || notebook.Cells[0].Document != "cell:3" || notebook.Cells[1].Kind != protocol.NotebookCellKindMarkup {
+		t.Fatalf("Expected the cells to be updated, got %+v", notebook.Cells)
+	}
+
+	if _, exists := store.Cell("cell:1"); exists {
+		t.Fatal("Expected cell:1 to be closed")
+	}
+	if cell, _ := store.Cell("cell:2"); cell.Text != "b = 20" || cell.Version != 2 {
+		t.Fatalf("Expected the text of cell:2 to be changed, got %+v", cell)
+	}
+
+	uri, index, found := store.Locate("cell:2")
+	if !found || uri != notebookUri || index != 1 {
+		t.Fatalf("Expected cell:2 at index 1, got %s %d %v", uri, index, found)
+	}
+
+	if err := store.DidClose(protocol.DidCloseNotebookDocumentParams{NotebookDocument: protocol.NotebookDocumentIdentifier{Uri: notebookUri}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, exists := store.Cell("cell:3"); exists {
+		t.Fatal("Expected the cells to be closed with the notebook")
+	}
+}
+
+func TestNotebookStoreInvalidChangeIsNotApplied(t *testing.T) {
+	store := NewNotebookStore(protocol.NotebookDocumentSyncOptions{}, "")
+	openNotebook(t, store)
+
+	err := store.DidChange(protocol.DidChangeNotebookDocumentParams{
+		NotebookDocument: protocol.VersionedNotebookDocumentIdentifier{Uri: notebookUri, Version: 2},
+		Change: protocol.NotebookDocumentChangeEvent{
+			Cells: &protocol.NotebookDocumentCellChanges{
+				Structure: &protocol.NotebookDocumentCellChangeStructure{
+					Array:    protocol.NotebookCellArrayChange{Start: 1, DeleteCount: 1},
+					DidClose: []protocol.TextDocumentIdentifier{{Uri: "cell:2"}},
+				},
+				TextContent: []protocol.NotebookDocumentCellContentChanges{{
+					Document: protocol.VersionedTextDocumentIdentifier{Uri: "cell:2", Version: 2},
+				}},
+			},
+		},
+	})
+	if err == nil {
+		t.Fatal("Expected changing a closed cell to fail")
+	}
+
+	notebook, _ := store.Notebook(notebookUri)
+	if notebook.Version != 1 || len(notebook.Cells) != 2 {
+		t.Fatalf("Expected the notebook to be unchanged, got %+v", notebook)
+	}
+	if _, exists := store.Cell("cell:2"); !exists {
+		t.Fatal("Expected cell:2 to still be open")
+	}
+}
+
+func TestNotebookStoreSelector(t *testing.T) {
+	store := NewNotebookStore(protocol.NotebookDocumentSyncOptions{
+		NotebookSelector: []protocol.Or2[protocol.NotebookDocumentFilterWithNotebook, protocol.NotebookDocumentFilterWithCells]{
+			{Value: protocol.NotebookDocumentFilterWithNotebook{Notebook: protocol.Or2[string, protocol.NotebookDocumentFilter]{Value: "interactive"}}},
+		},
+	}, "")
+	openNotebook(t, store)
+
+	if _, exists := store.Notebook(notebookUri); exists {
+		t.Fatal("Expected a notebook that doesn't match the selector to be ignored")
+	}
+
+	// Later notifications for an ignored notebook are ignored too.
+	if err := store.DidClose(protocol.DidCloseNotebookDocumentParams{NotebookDocument: protocol.NotebookDocumentIdentifier{Uri: notebookUri}}); err != nil {
+		t.Fatal(err)
+	}
+	if !store.Synced("interactive", notebookUri, nil) {
+		t.Fatal("Expected interactive notebooks to be synced")
+	}
+}
+
+func TestNotebookStoreHandle(t *testing.T) {
+	store := NewNotebookStore(protocol.NotebookDocumentSyncOptions{}, "")
+
+	handled, err := store.Handle(protocol.DidOpenNotebookDocumentNotification{
+		Params: protocol.DidOpenNotebookDocumentParams{
+			NotebookDocument: protocol.NotebookDocument{Uri: notebookUri, NotebookType: "jupyter-notebook"},
+		},
+	})
+	if !handled || err != nil {
+		t.Fatalf("Expected didOpen to be handled, got %v %v", handled, err)
+	}
+
+	handled, _ = store.Handle(protocol.InitializedNotification{})
+	if handled {
+		t.Fatal("Expected other notifications not to be handled")
+	}
+}
+
+func TestNotebookStoreCellSelector(t *testing.T) {
+	store := NewNotebookStore(protocol.NotebookDocumentSyncOptions{
+		NotebookSelector: []protocol.Or2[protocol.NotebookDocumentFilterWithNotebook, protocol.NotebookDocumentFilterWithCells]{
+			{Value: protocol.NotebookDocumentFilterWithCells{Cells: []protocol.NotebookCellLanguage{{Language: "r"}}}},
+		},
+	}, "")
+	openNotebook(t, store)
+
+	if _, exists := store.Notebook(notebookUri); exists {
+		t.Fatal("Expected a notebook without a cell in the selected language to be ignored")
+	}
+
+	store = NewNotebookStore(protocol.NotebookDocumentSyncOptions{
+		NotebookSelector: []protocol.Or2[protocol.NotebookDocumentFilterWithNotebook, protocol.NotebookDocumentFilterWithCells]{
+			{Value: protocol.NotebookDocumentFilterWithCells{Cells: []protocol.NotebookCellLanguage{{Language: "python"}}}},
+		},
+	}, "")
+	openNotebook(t, store)
+
+	if _, exists := store.Notebook(notebookUri); !exists {
+		t.Fatal("Expected a notebook with a python cell to be synced")
+	}
+}
@@ -0,0 +1,202 @@
+package server
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+)
+
+// The delay DiagnosticsPublisher waits for further updates before publishing.
+const DefaultPublishDelay = 100 * time.Millisecond
+
+// DiagnosticsPublisher sends textDocument/publishDiagnostics notifications for
+// diagnostics that come from several named sources, e.g. a type checker and a
+// linter. Since each notification replaces all the diagnostics of a document,
+// the diagnostics of every source are merged into one notification. Updates
+// are debounced, so a burst of updates to a document is published once.
+type DiagnosticsPublisher struct {
+	conn           *protocol.Conn
+	versionSupport bool
+	delay          time.Duration
+
+	// Serializes notifications, so they are sent in the order their
+	// diagnostics were merged in.
+	sendMu sync.Mutex
+
+	mu        sync.Mutex
+	documents map[protocol.DocumentUri]*publishedDocument
+}
+
+type publishedDocument struct {
+	version *int32
+	sources map[string][]protocol.Diagnostic
+	timer   *time.Timer
+	// Incremented by every update, so a timer can tell if it was superseded.
+	generation uint64
+	scheduled  bool
+}
+
+// Creates a publisher sending notifications over conn to a client with
+// capabilities. A delay of 0 means DefaultPublishDelay.
+func NewDiagnosticsPublisher(conn *protocol.Conn, capabilities protocol.ClientCapabilities, delay time.Duration) *DiagnosticsPublisher {
+	publisher := &DiagnosticsPublisher{
+		conn:      conn,
+		delay:     delay,
+		documents: map[protocol.DocumentUri]*publishedDocument{},
+	}
+
+	if delay == 0 {
+		publisher.delay = DefaultPublishDelay
+	}
+
+	if capabilities.TextDocument != nil && capabilities.TextDocument.PublishDiagnostics != nil {
+		publisher.versionSupport = capabilities.TextDocument.PublishDiagnostics.VersionSupport
+	}
+
+	return publisher
+}
+
+// Replaces the diagnostics source reported for a document, and schedules the
+// merged diagnostics to be published. version is the version of the document
+// the diagnostics were computed for, or nil if it isn't known. It is only sent
+// to clients that support versionSupport.
+func (p *DiagnosticsPublisher) Update(uri protocol.DocumentUri, source string, version *int32, diagnostics []protocol.Diagnostic) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	document, exists := p.documents[uri]
+
+	if !exists {
+		document = &publishedDocument{sources: map[string][]protocol.Diagnostic{}}
+		p.documents[uri] = document
+	}
+
+	document.version = nil
+
+	if version != nil {
+		document.version = new(int32)
+		*document.version = *version
+	}
+
+	if len(diagnostics) == 0 {
+		delete(document.sources, source)
+	} else {
+		document.sources[source] = slices.Clone(diagnostics)
+	}
+
+	if document.timer != nil {
+		document.timer.Stop()
+	}
+
+	document.generation++
+	document.scheduled = true
+	generation := document.generation
+	document.timer = time.AfterFunc(p.delay, func() {
+		p.publish(context.Background(), uri, generation)
+	})
+}
+
+// Returns the merged diagnostics of a document, ordered by source name.
+func (p *DiagnosticsPublisher) Diagnostics(uri protocol.DocumentUri) []protocol.Diagnostic {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	document, exists := p.documents[uri]
+
+	if !exists {
+		return nil
+	}
+
+	return document.merged()
+}
+
+// Publishes every scheduled update right away.
+func (p *DiagnosticsPublisher) Flush(ctx context.Context) error {
+	p.mu.Lock()
+	scheduled := map[protocol.DocumentUri]uint64{}
+
+	for uri, document := range p.documents {
+		if document.scheduled {
+			document.timer.Stop()
+			scheduled[uri] = document.generation
+		}
+	}
+	p.mu.Unlock()
+
+	var err error
+
+	for uri, generation := range scheduled {
+		if publishErr := p.publish(ctx, uri, generation); publishErr != nil && err == nil {
+			err = publishErr
+		}
+	}
+
+	return err
+}
+
+// Clears the diagnostics of a document, e.g. once it is closed, by publishing
+// an empty set of diagnostics right away.
+func (p *DiagnosticsPublisher) Close(ctx context.Context, uri protocol.DocumentUri) error {
+	p.sendMu.Lock()
+	defer p.sendMu.Unlock()
+
+	p.mu.Lock()
+	if document, exists := p.documents[uri]; exists && document.scheduled {
+		document.timer.Stop()
+	}
+	delete(p.documents, uri)
+	p.mu.Unlock()
+
+	return p.conn.PublishDiagnostics(ctx, protocol.PublishDiagnosticsParams{
+		Uri:         uri,
+		Diagnostics: []protocol.Diagnostic{},
+	})
+}
+
+// Publishes the diagnostics of a document, unless the update of generation was
+// superseded, already published, or the document was closed since.
+func (p *DiagnosticsPublisher) publish(ctx context.Context, uri protocol.DocumentUri, generation uint64) error {
+	p.sendMu.Lock()
+	defer p.sendMu.Unlock()
+
+	p.mu.Lock()
+	document, exists := p.documents[uri]
+
+	if !exists || !document.scheduled || document.generation != generation {
+		p.mu.Unlock()
+		return nil
+	}
+	document.scheduled = false
+
+	params := protocol.PublishDiagnosticsParams{
+		Uri:         uri,
+		Diagnostics: document.merged(),
+	}
+
+	if p.versionSupport && document.version != nil {
+		params.Version = *document.version
+	}
+	p.mu.Unlock()
+
+	return p.conn.PublishDiagnostics(ctx, params)
+}
+
+func (d *publishedDocument) merged() []protocol.Diagnostic {
+	sources := make([]string, 0, len(d.sources))
+
+	for source := range d.sources {
+		sources = append(sources, source)
+	}
+	slices.Sort(sources)
+
+	diagnostics := []protocol.Diagnostic{}
+
+	for _, source := range sources {
+		diagnostics = append(diagnostics, d.sources[source]...)
+	}
+
+	return diagnostics
+}
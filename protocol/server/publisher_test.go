@@ -0,0 +1,107 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+)
+
+func expectPublished(t *testing.T, received chan protocol.IncomingMessage) protocol.PublishDiagnosticsParams {
+	select {
+	case message := <-received:
+		notification, ok := message.(protocol.PublishDiagnosticsNotification)
+		if !ok {
+			t.Fatalf("Expected a publishDiagnostics notification, got %T", message)
+		}
+		return notification.Params
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected diagnostics to be published")
+	}
+
+	return protocol.PublishDiagnosticsParams{}
+}
+
+func TestDiagnosticsPublisherMergesAndDebounces(t *testing.T) {
+	conn, received := serverConn(t)
+	publisher := NewDiagnosticsPublisher(conn, protocol.ClientCapabilities{}, 20*time.Millisecond)
+	version := int32(3)
+
+	publisher.Update("file:///a.go", "vet", &version, []protocol.Diagnostic{diagnostic("vet")})
+	publisher.Update("file:///a.go", "compiler", nil, []protocol.Diagnostic{diagnostic("old")})
+	publisher.Update("file:///a.go", "compiler", nil, []protocol.Diagnostic{diagnostic("compiler")})
+
+	params := expectPublished(t, received)
+	if len(params.Diagnostics) != 2 || params.Diagnostics[0].Message != "compiler" || params.Diagnostics[1].Message != "vet" {
+		t.Fatalf("Expected the merged diagnostics of both sources, got %+v", params.Diagnostics)
+	}
+	if params.Version != 0 {
+		t.Fatal("Expected no version for a client without versionSupport")
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	if len(received) != 0 {
+		t.Fatal("Expected the updates to be published once")
+	}
+}
+
+func TestDiagnosticsPublisherVersionAndClose(t *testing.T) {
+	conn, received := serverConn(t)
+	publisher := NewDiagnosticsPublisher(conn, protocol.ClientCapabilities{
+		TextDocument: &protocol.TextDocumentClientCapabilities{
+			PublishDiagnostics: &protocol.PublishDiagnosticsClientCapabilities{VersionSupport: true},
+		},
+	}, time.Hour)
+	version := int32(3)
+
+	publisher.Update("file:///a.go", "vet", &version, []protocol.Diagnostic{diagnostic("vet")})
+
+	if err := publisher.Flush(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if params := expectPublished(t, received); params.Version != 3 {
+		t.Fatalf("Expected version 3, got %d", params.Version)
+	}
+
+	publisher.Update("file:///a.go", "vet", nil, []protocol.Diagnostic{diagnostic("pending")})
+
+	if err := publisher.Close(context.Background(), "file:///a.go"); err != nil {
+		t.Fatal(err)
+	}
+	if params := expectPublished(t, received); len(params.Diagnostics) != 0 {
+		t.Fatalf("Expected the diagnostics to be cleared, got %+v", params.Diagnostics)
+	}
+	if diagnostics := publisher.Diagnostics("file:///a.go"); diagnostics != nil {
+		t.Fatalf("Expected the document to be forgotten, got %+v", diagnostics)
+	}
+}
+
+func TestDiagnosticsPublisherCopiesVersion(t *testing.T) {
+	conn, received := serverConn(t)
+	publisher := NewDiagnosticsPublisher(conn, protocol.ClientCapabilities{
+		TextDocument: &protocol.TextDocumentClientCapabilities{
+			PublishDiagnostics: &protocol.PublishDiagnosticsClientCapabilities{VersionSupport: true},
+		},
+	}, time.Hour)
+	version := int32(3)
+
+	publisher.Update("file:///a.go", "vet", &version, []protocol.Diagnostic{diagnostic("vet")})
+	version = 4
+
+	if err := publisher.Flush(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if params := expectPublished(t, received); params.Version != 3 {
+		t.Fatalf("Expected the version at the time of the update, got %d", params.Version)
+	}
+
+	publisher.Update("file:///a.go", "vet", nil, []protocol.Diagnostic{diagnostic("unversioned")})
+
+	if err := publisher.Flush(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if params := expectPublished(t, received); params.Version != 0 {
+		t.Fatalf("Expected no version once it is unknown, got %d", params.Version)
+	}
+}
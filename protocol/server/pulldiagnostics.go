@@ -0,0 +1,255 @@
+package server
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"strconv"
+	"sync"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+)
+
+// The number of reports sent in each $/progress notification of a streamed
+// workspace/diagnostic response.
+const DefaultWorkspaceBatchSize = 100
+
+// PullDiagnostics answers textDocument/diagnostic and workspace/diagnostic
+// requests from the diagnostics last set for each document. Each distinct set
+// of diagnostics gets a new resultId, so a client that already has the latest
+// one is sent an unchanged report instead of the diagnostics.
+type PullDiagnostics struct {
+	conn           *protocol.Conn
+	refreshSupport bool
+
+	// The number of reports sent in each $/progress notification. Defaults
+	// to DefaultWorkspaceBatchSize.
+	BatchSize int
+
+	mu      sync.Mutex
+	reports map[protocol.DocumentUri]*pulledReport
+	nextID  int
+	changed bool
+}
+
+type pulledReport struct {
+	resultId string
+	version  *int32
+	items    []protocol.Diagnostic
+	// Whether the diagnostics were cleared. The empty report of a cleared
+	// document is pulled once by textDocument/diagnostic and once by
+	// workspace/diagnostic, so the client drops the diagnostics either kept.
+	cleared         bool
+	documentPulled  bool
+	workspacePulled bool
+}
+
+// Creates a manager for a client with capabilities. Partial results and
+// refresh requests are sent over conn.
+func NewPullDiagnostics(conn *protocol.Conn, capabilities protocol.ClientCapabilities) *PullDiagnostics {
+	pull := &PullDiagnostics{
+		conn:      conn,
+		BatchSize: DefaultWorkspaceBatchSize,
+		reports:   map[protocol.DocumentUri]*pulledReport{},
+	}
+
+	if capabilities.Workspace != nil && capabilities.Workspace.Diagnostics != nil {
+		pull.refreshSupport = capabilities.Workspace.Diagnostics.RefreshSupport
+	}
+
+	return pull
+}
+
+// Sets the diagnostics of a document, along with the version of the document
+// they were computed for, if it is known. The resultId of the document only
+// changes when its diagnostics do.
+func (p *PullDiagnostics) Set(uri protocol.DocumentUri, version *int32, items []protocol.Diagnostic) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if items == nil {
+		items = []protocol.Diagnostic{}
+	}
+
+	if report, exists := p.reports[uri]; exists && !report.cleared && reflect.DeepEqual(report.items, items) {
+		report.version = version
+		return
+	}
+
+	p.nextID++
+	p.reports[uri] = &pulledReport{
+		resultId: strconv.Itoa(p.nextID),
+		version:  version,
+		items:    slices.Clone(items),
+	}
+	p.changed = true
+}
+
+// Forgets the diagnostics of a document, e.g. once it is deleted. The next
+// textDocument/diagnostic and workspace/diagnostic pulls each report the
+// document with no diagnostics under a new resultId, after which it is no
+// longer reported.
+func (p *PullDiagnostics) Clear(uri protocol.DocumentUri) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if report, exists := p.reports[uri]; exists && !report.cleared {
+		p.nextID++
+		p.reports[uri] = &pulledReport{
+			resultId: strconv.Itoa(p.nextID),
+			items:    []protocol.Diagnostic{},
+			cleared:  true,
+		}
+		p.changed = true
+	}
+}
+
+// Returns the report for a textDocument/diagnostic request. A document without
+// diagnostics gets a full report with no items.
+func (p *PullDiagnostics) Document(params protocol.DocumentDiagnosticParams) protocol.DocumentDiagnosticReport {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	report, exists := p.reports[params.TextDocument.Uri]
+	reported := false
+
+	if exists && report.cleared {
+		reported = report.documentPulled || params.PreviousResultId == report.resultId
+		report.documentPulled = true
+		p.forget(params.TextDocument.Uri, report)
+	}
+
+	if !exists || reported {
+		return protocol.DocumentDiagnosticReport{Value: protocol.RelatedFullDocumentDiagnosticReport{
+			Kind:  string(protocol.DocumentDiagnosticReportKindFull),
+			Items: []protocol.Diagnostic{},
+		}}
+	}
+
+	if params.PreviousResultId == report.resultId {
+		return protocol.DocumentDiagnosticReport{Value: protocol.RelatedUnchangedDocumentDiagnosticReport{
+			Kind:     string(protocol.DocumentDiagnosticReportKindUnchanged),
+			ResultId: report.resultId,
+		}}
+	}
+
+	return protocol.DocumentDiagnosticReport{Value: protocol.RelatedFullDocumentDiagnosticReport{
+		Kind:     string(protocol.DocumentDiagnosticReportKindFull),
+		ResultId: report.resultId,
+		Items:    slices.Clone(report.items),
+	}}
+}
+
+// Returns the report for a workspace/diagnostic request, with a report for
+// every document that has diagnostics, or had them cleared since the last
+// pull. Documents the client already has the
+// latest resultId of get an unchanged report.
+//
+// When the request has a partialResultToken, the reports are streamed in
+// batches as $/progress notifications, and the returned report is empty.
+func (p *PullDiagnostics) Workspace(ctx context.Context, params protocol.WorkspaceDiagnosticParams) (protocol.WorkspaceDiagnosticReport, error) {
+	items := p.workspaceItems(params)
+
+	if params.PartialResultToken == nil || p.conn == nil {
+		return protocol.WorkspaceDiagnosticReport{Items: items}, nil
+	}
+
+	size := p.BatchSize
+
+	if size <= 0 {
+		size = DefaultWorkspaceBatchSize
+	}
+
+	for batch := range slices.Chunk(items, size) {
+		err := p.conn.Progress(ctx, protocol.ProgressParams{
+			Token: *params.PartialResultToken,
+			Value: protocol.WorkspaceDiagnosticReportPartialResult{Items: batch},
+		})
+
+		if err != nil {
+			return protocol.WorkspaceDiagnosticReport{}, err
+		}
+	}
+
+	return protocol.WorkspaceDiagnosticReport{Items: []protocol.WorkspaceDocumentDiagnosticReport{}}, nil
+}
+
+func (p *PullDiagnostics) workspaceItems(params protocol.WorkspaceDiagnosticParams) []protocol.WorkspaceDocumentDiagnosticReport {
+	previous := map[protocol.DocumentUri]string{}
+
+	for _, id := range params.PreviousResultIds {
+		previous[id.Uri] = id.Value
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.changed = false
+	uris := make([]protocol.DocumentUri, 0, len(p.reports))
+
+	for uri := range p.reports {
+		uris = append(uris, uri)
+	}
+	slices.Sort(uris)
+
+	items := make([]protocol.WorkspaceDocumentDiagnosticReport, 0, len(uris))
+
+	for _, uri := range uris {
+		report := p.reports[uri]
+
+		if report.cleared {
+			reported := report.workspacePulled || previous[uri] == report.resultId
+			report.workspacePulled = true
+			p.forget(uri, report)
+
+			if reported {
+				continue
+			}
+		}
+
+		if previous[uri] == report.resultId {
+			items = append(items, protocol.WorkspaceDocumentDiagnosticReport{Value: protocol.WorkspaceUnchangedDocumentDiagnosticReport{
+				Kind:     string(protocol.DocumentDiagnosticReportKindUnchanged),
+				ResultId: report.resultId,
+				Uri:      uri,
+				Version:  report.version,
+			}})
+			continue
+		}
+
+		items = append(items, protocol.WorkspaceDocumentDiagnosticReport{Value: protocol.WorkspaceFullDocumentDiagnosticReport{
+			Kind:     string(protocol.DocumentDiagnosticReportKindFull),
+			ResultId: report.resultId,
+			Uri:      uri,
+			Version:  report.version,
+			Items:    slices.Clone(report.items),
+		}})
+	}
+
+	return items
+}
+
+// Forgets a cleared document once both kinds of pull reported it.
+func (p *PullDiagnostics) forget(uri protocol.DocumentUri, report *pulledReport) {
+	if report.documentPulled && report.workspacePulled {
+		delete(p.reports, uri)
+	}
+}
+
+// Asks the client to pull diagnostics again with a workspace/diagnostic/refresh
+// request. The request is only sent if the client supports it and diagnostics
+// were set or cleared since the last refresh or workspace pull.
+func (p *PullDiagnostics) Refresh(ctx context.Context) error {
+	p.mu.Lock()
+	needed := p.refreshSupport && p.changed && p.conn != nil
+	if needed {
+		p.changed = false
+	}
+	p.mu.Unlock()
+
+	if !needed {
+		return nil
+	}
+
+	return p.conn.DiagnosticRefresh(ctx)
+}
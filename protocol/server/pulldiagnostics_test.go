@@ -0,0 +1,190 @@
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+)
+
+// Returns a server connection, and the messages a client connected to it receives.
+func serverConn(t *testing.T) (*protocol.Conn, chan protocol.IncomingMessage) {
+	clientSide, serverSide := net.Pipe()
+	received := make(chan protocol.IncomingMessage, 100)
+
+	client := protocol.NewConn(clientSide, protocol.ConnOptions{
+		Handler: func(ctx context.Context, message protocol.IncomingMessage) (any, error) {
+			received <- message
+			return nil, nil
+		},
+	})
+	server := protocol.NewConn(serverSide, protocol.ConnOptions{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go client.Run(ctx)
+	go server.Run(ctx)
+
+	return server, received
+}
+
+func diagnostic(message string) protocol.Diagnostic {
+	return protocol.Diagnostic{Message: message}
+}
+
+func TestPullDiagnosticsDocument(t *testing.T) {
+	pull := NewPullDiagnostics(nil, protocol.ClientCapabilities{})
+	params := protocol.DocumentDiagnosticParams{TextDocument: protocol.TextDocumentIdentifier{Uri: "file:///a.go"}}
+
+	if _, ok := pull.Document(params).Value.(protocol.RelatedFullDocumentDiagnosticReport); !ok {
+		t.Fatal("Expected a full report for a document without diagnostics")
+	}
+
+	pull.Set("file:///a.go", nil, []protocol.Diagnostic{diagnostic("unused")})
+
+	full, ok := pull.Document(params).Value.(protocol.RelatedFullDocumentDiagnosticReport)
+	if !ok || len(full.Items) != 1 || full.ResultId == "" {
+		t.Fatalf("Expected a full report with a resultId, got %+v", full)
+	}
+
+	// Setting the same diagnostics keeps the resultId.
+	pull.Set("file:///a.go", nil, []protocol.Diagnostic{diagnostic("unused")})
+	params.PreviousResultId = full.ResultId
+
+	if _, ok := pull.Document(params).Value.(protocol.RelatedUnchangedDocumentDiagnosticReport); !ok {
+		t.Fatal("Expected an unchanged report for the latest resultId")
+	}
+
+	pull.Set("file:///a.go", nil, nil)
+
+	changed, ok := pull.Document(params).Value.(protocol.RelatedFullDocumentDiagnosticReport)
+	if !ok || len(changed.Items) != 0 || changed.ResultId == full.ResultId {
+		t.Fatalf("Expected a new full report once the diagnostics changed, got %+v", changed)
+	}
+}
+
+func TestPullDiagnosticsClear(t *testing.T) {
+	pull := NewPullDiagnostics(nil, protocol.ClientCapabilities{})
+	params := protocol.DocumentDiagnosticParams{TextDocument: protocol.TextDocumentIdentifier{Uri: "file:///a.go"}}
+
+	pull.Set("file:///a.go", nil, []protocol.Diagnostic{diagnostic("unused")})
+	pull.Set("file:///b.go", nil, []protocol.Diagnostic{diagnostic("unused")})
+	full := pull.Document(params).Value.(protocol.RelatedFullDocumentDiagnosticReport)
+
+	pull.Clear("file:///a.go")
+	params.PreviousResultId = full.ResultId
+
+	cleared, ok := pull.Document(params).Value.(protocol.RelatedFullDocumentDiagnosticReport)
+	if !ok || len(cleared.Items) != 0 || cleared.ResultId == "" || cleared.ResultId == full.ResultId {
+		t.Fatalf("Expected an empty full report with a new resultId once cleared, got %+v", cleared)
+	}
+
+	// The document pull reported a.go, but the workspace pull still has to.
+	pull.Clear("file:///b.go")
+
+	report, err := pull.Workspace(context.Background(), protocol.WorkspaceDiagnosticParams{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Items) != 2 {
+		t.Fatalf("Expected a.go and b.go to be reported, got %+v", report.Items)
+	}
+
+	for i, uri := range []protocol.DocumentUri{"file:///a.go", "file:///b.go"} {
+		item, ok := report.Items[i].Value.(protocol.WorkspaceFullDocumentDiagnosticReport)
+		if !ok || item.Uri != uri || len(item.Items) != 0 || item.ResultId == "" {
+			t.Fatalf("Expected an empty full report for %s, got %+v", uri, report.Items[i].Value)
+		}
+	}
+
+	report, _ = pull.Workspace(context.Background(), protocol.WorkspaceDiagnosticParams{})
+	if len(report.Items) != 0 {
+		t.Fatalf("Expected cleared documents to be reported once, got %+v", report.Items)
+	}
+
+	// The workspace pull reported b.go, but the document pull still has to.
+	params.TextDocument.Uri = "file:///b.go"
+	params.PreviousResultId = ""
+
+	b, ok := pull.Document(params).Value.(protocol.RelatedFullDocumentDiagnosticReport)
+	if !ok || len(b.Items) != 0 || b.ResultId == "" {
+		t.Fatalf("Expected an empty full report with a resultId for b.go, got %+v", b)
+	}
+
+	if len(pull.reports) != 0 {
+		t.Fatalf("Expected cleared documents to be forgotten once both pulls reported them, got %v", pull.reports)
+	}
+}
+
+func TestPullDiagnosticsWorkspacePartialResults(t *testing.T) {
+	conn, received := serverConn(t)
+	pull := NewPullDiagnostics(conn, protocol.ClientCapabilities{})
+	pull.BatchSize = 1
+
+	pull.Set("file:///a.go", nil, []protocol.Diagnostic{diagnostic("a")})
+	pull.Set("file:///b.go", nil, []protocol.Diagnostic{diagnostic("b")})
+
+	report, err := pull.Workspace(context.Background(), protocol.WorkspaceDiagnosticParams{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Items) != 2 {
+		t.Fatalf("Expected 2 reports, got %d", len(report.Items))
+	}
+
+	full := report.Items[0].Value.(protocol.WorkspaceFullDocumentDiagnosticReport)
+	token := protocol.ProgressToken{Value: "token"}
+
+	report, err = pull.Workspace(context.Background(), protocol.WorkspaceDiagnosticParams{
+		PartialResultToken: &token,
+		PreviousResultIds:  []protocol.PreviousResultId{{Uri: full.Uri, Value: full.ResultId}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Items) != 0 {
+		t.Fatalf("Expected the reports to be streamed, got %d in the response", len(report.Items))
+	}
+
+	for i := range 2 {
+		progress, ok := (<-received).(protocol.ProgressNotification)
+		if !ok {
+			t.Fatal("Expected a $/progress notification")
+		}
+		value, _ := progress.Params.Value.(map[string]any)
+		items, _ := value["items"].([]any)
+		if len(items) != 1 {
+			t.Fatalf("Expected a batch of 1 report, got %v", progress.Params.Value)
+		}
+		kind := items[0].(map[string]any)["kind"]
+		if (i == 0 && kind != "unchanged") || (i == 1 && kind != "full") {
+			t.Fatalf("Unexpected report %d: %v", i, items[0])
+		}
+	}
+}
+
+func TestPullDiagnosticsRefresh(t *testing.T) {
+	conn, received := serverConn(t)
+	pull := NewPullDiagnostics(conn, protocol.ClientCapabilities{
+		Workspace: &protocol.WorkspaceClientCapabilities{
+			Diagnostics: &protocol.DiagnosticWorkspaceClientCapabilities{RefreshSupport: true},
+		},
+	})
+
+	if err := pull.Refresh(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	pull.Set("file:///a.go", nil, []protocol.Diagnostic{diagnostic("a")})
+
+	if err := pull.Refresh(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := (<-received).(protocol.DiagnosticRefreshRequest); !ok {
+		t.Fatal("Expected a workspace/diagnostic/refresh request")
+	}
+	if len(received) != 0 {
+		t.Fatal("Expected a single refresh request")
+	}
+}
@@ -0,0 +1,166 @@
+package protocol
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// Helper function that converts a position into a byte offset into text. The
+// character of the position is counted in the given encoding, where an empty
+// encoding means utf-16, the protocol's default. Positions past the end of a
+// line or of the text are clamped to it.
+func OffsetAt(text string, position Position, encoding PositionEncodingKind) int {
+	offset := 0
+
+	for line := uint32(0); line < position.Line; line++ {
+		end := lineEnd(text, offset)
+
+		if end == len(text) {
+			return len(text)
+		}
+		offset = end + lineBreakLength(text, end)
+	}
+
+	end := lineEnd(text, offset)
+	units := uint32(0)
+
+	for offset < end && units < position.Character {
+		r, size := utf8.DecodeRuneInString(text[offset:])
+		units += runeUnits(r, size, encoding)
+		offset += size
+	}
+
+	return offset
+}
+
+// Helper function that converts a byte offset into text into a position, with
+// the character counted in the given encoding.
+func PositionAt(text string, offset int, encoding PositionEncodingKind) Position {
+	offset = min(max(offset, 0), len(text))
+	var position Position
+	start := 0
+
+	for {
+		end := lineEnd(text, start)
+
+		if end >= offset || end == len(text) {
+			break
+		}
+
+		next := end + lineBreakLength(text, end)
+
+		if next > offset {
+			// The offset is in the middle of a \r\n line break.
+			offset = end
+			break
+		}
+		start = next
+		position.Line++
+	}
+
+	for i := start; i < offset; {
+		r, size := utf8.DecodeRuneInString(text[i:])
+		position.Character += runeUnits(r, size, encoding)
+		i += size
+	}
+
+	return position
+}
+
+// Helper function that applies text edits to text. As in a WorkspaceEdit, all
+// edits refer to the original text and must not overlap.
+func ApplyTextEdits(text string, edits []TextEdit, encoding PositionEncodingKind) (string, error) {
+	type span struct {
+		start, end int
+		newText    string
+	}
+
+	spans := make([]span, 0, len(edits))
+
+	for _, edit := range edits {
+		start := OffsetAt(text, edit.Range.Start, encoding)
+		end := OffsetAt(text, edit.Range.End, encoding)
+
+		if end < start {
+			return "", fmt.Errorf("invalid range: end %v is before start %v", edit.Range.End, edit.Range.Start)
+		}
+		spans = append(spans, span{start, end, edit.NewText})
+	}
+
+	// Stable, so inserts at the same position are applied in the given order.
+	slices.SortStableFunc(spans, func(a, b span) int {
+		return a.start - b.start
+	})
+
+	var builder strings.Builder
+	last := 0
+
+	for _, span := range spans {
+		if span.start < last {
+			return "", fmt.Errorf("overlapping text edits")
+		}
+		builder.WriteString(text[last:span.start])
+		builder.WriteString(span.newText)
+		last = span.end
+	}
+	builder.WriteString(text[last:])
+
+	return builder.String(), nil
+}
+
+// Helper function that applies the content changes of a didChange notification
+// to text. Each change applies to the result of the previous one.
+func ApplyContentChanges(text string, changes []TextDocumentContentChangeEvent, encoding PositionEncodingKind) (string, error) {
+	for _, change := range changes {
+		switch change := change.Value.(type) {
+		case TextDocumentContentChangeWholeDocument:
+			text = change.Text
+		case TextDocumentContentChangePartial:
+			var err error
+			text, err = ApplyTextEdits(text, []TextEdit{{Range: change.Range, NewText: change.Text}}, encoding)
+
+			if err != nil {
+				return "", err
+			}
+		default:
+			return "", fmt.Errorf("unexpected content change: %T", change)
+		}
+	}
+
+	return text, nil
+}
+
+// Returns the offset of the line break ending the line that starts at start,
+// or the length of text for the last line.
+func lineEnd(text string, start int) int {
+	if i := strings.IndexAny(text[start:], "\r\n"); i >= 0 {
+		return start + i
+	}
+
+	return len(text)
+}
+
+func lineBreakLength(text string, end int) int {
+	if strings.HasPrefix(text[end:], "\r\n") {
+		return 2
+	}
+
+	return 1
+}
+
+func runeUnits(r rune, size int, encoding PositionEncodingKind) uint32 {
+	switch encoding {
+	case PositionEncodingKindUTF8:
+		return uint32(size)
+	case PositionEncodingKindUTF32:
+		return 1
+	}
+
+	if r >= 0x10000 {
+		return 2
+	}
+
+	return 1
+}
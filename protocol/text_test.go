@@ -0,0 +1,89 @@
+package protocol
+
+import (
+	"testing"
+)
+
+func TestOffsetAndPosition(t *testing.T) {
+	text := "a𐐀b\r\nsecond\nthird"
+
+	cases := []struct {
+		position Position
+		encoding PositionEncodingKind
+		offset   int
+	}{
+		{Position{Line: 0, Character: 0}, PositionEncodingKindUTF16, 0},
+		{Position{Line: 0, Character: 3}, PositionEncodingKindUTF16, 5},
+		{Position{Line: 0, Character: 2}, PositionEncodingKindUTF32, 5},
+		{Position{Line: 0, Character: 5}, PositionEncodingKindUTF8, 5},
+		{Position{Line: 1, Character: 0}, "", 8},
+		{Position{Line: 1, Character: 3}, "", 11},
+		{Position{Line: 2, Character: 5}, "", 20},
+	}
+
+	for _, c := range cases {
+		if got := OffsetAt(text, c.position, c.encoding); got != c.offset {
+			t.Fatalf("%v (%s): expected offset %d, got %d", c.position, c.encoding, c.offset, got)
+		}
+		if got := PositionAt(text, c.offset, c.encoding); got != c.position {
+			t.Fatalf("%d (%s): expected position %v, got %v", c.offset, c.encoding, c.position, got)
+		}
+	}
+
+	// Positions past the end of a line or the text are clamped.
+	if got := OffsetAt(text, Position{Line: 1, Character: 100}, ""); got != 14 {
+		t.Fatalf("Expected clamped offset 14, got %d", got)
+	}
+	if got := OffsetAt(text, Position{Line: 10, Character: 0}, ""); got != len(text) {
+		t.Fatalf("Expected offset at the end of the text, got %d", got)
+	}
+}
+
+func TestApplyTextEdits(t *testing.T) {
+	text := "hello world\nfoo"
+
+	result, err := ApplyTextEdits(text, []TextEdit{
+		{Range: Range{Start: Position{Line: 1, Character: 0}, End: Position{Line: 1, Character: 3}}, NewText: "bar"},
+		{Range: Range{Start: Position{Line: 0, Character: 0}, End: Position{Line: 0, Character: 5}}, NewText: "goodbye"},
+	}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result != "goodbye world\nbar" {
+		t.Fatalf("Unexpected result: %q", result)
+	}
+
+	_, err = ApplyTextEdits(text, []TextEdit{
+		{Range: Range{Start: Position{Line: 0, Character: 0}, End: Position{Line: 0, Character: 5}}, NewText: "a"},
+		{Range: Range{Start: Position{Line: 0, Character: 3}, End: Position{Line: 0, Character: 7}}, NewText: "b"},
+	}, "")
+	if err == nil {
+		t.Fatal("Expected error for overlapping edits")
+	}
+}
+
+func TestApplyContentChanges(t *testing.T) {
+	result, err := ApplyContentChanges("abc", []TextDocumentContentChangeEvent{
+		{Value: TextDocumentContentChangePartial{Range: Range{Start: Position{Character: 3}, End: Position{Character: 3}}, Text: "d"}},
+		{Value: TextDocumentContentChangePartial{Range: Range{Start: Position{Character: 0}, End: Position{Character: 1}}, Text: ""}},
+	}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result != "bcd" {
+		t.Fatalf("Unexpected result: %q", result)
+	}
+
+	result, err = ApplyContentChanges(result, []TextDocumentContentChangeEvent{
+		{Value: TextDocumentContentChangeWholeDocument{Text: "new"}},
+	}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result != "new" {
+		t.Fatalf("Unexpected result: %q", result)
+	}
+}
@@ -0,0 +1,283 @@
+package protocol
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Tracer traces the messages of a connection, the way VS Code does. It
+// follows the TraceValue set by InitializeParams.Trace and $/setTrace, in
+// either direction: with messages, a line is traced per message, and with
+// verbose, their params and results are included too.
+//
+// A Tracer is used by a single Conn, through ConnOptions.Tracer.
+type Tracer struct {
+	// Receives the trace in VS Code's "[Trace - time] Sending request" format.
+	Writer io.Writer
+	// Sends the trace to the other side as $/logTrace notifications, as
+	// servers do for their clients.
+	LogTrace bool
+
+	mu    sync.Mutex
+	value TraceValue
+	// Requests waiting for a response, by the direction they were sent in
+	// and their id.
+	requests map[string]tracedRequest
+	// $/logTrace notifications waiting to be sent, in order, and whether a
+	// goroutine is sending them.
+	logTraces []LogTraceParams
+	notifying bool
+}
+
+type tracedRequest struct {
+	method string
+	start  time.Time
+}
+
+// Returns the current trace value. Tracing is off until a value is set.
+func (t *Tracer) Value() TraceValue {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.value == "" {
+		return TraceValueOff
+	}
+
+	return t.value
+}
+
+// Sets the trace value, as $/setTrace does.
+func (t *Tracer) SetValue(value TraceValue) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.value = value
+}
+
+// Traces a message that is about to be written.
+func (t *Tracer) sending(c *Conn, message any) {
+	switch message := message.(type) {
+	case wireRequest:
+		if message.Method == OptionalLogTraceMethod {
+			return
+		}
+
+		var params json.RawMessage
+
+		if message.Params != nil {
+			params, _ = json.Marshal(message.Params)
+		}
+		t.follow(string(message.Method), params)
+
+		if message.ID == nil {
+			t.notification(c, "Sending", string(message.Method), params)
+			return
+		}
+
+		id, _ := json.Marshal(message.ID)
+		t.request(c, "Sending", string(message.Method), id, params)
+	case wireResponse:
+		t.response(c, "Sending", message.ID, message.Result, message.Error)
+	}
+}
+
+// Traces a message that was read.
+func (t *Tracer) received(c *Conn, message wireMessage) {
+	if message.Method == string(OptionalLogTraceMethod) {
+		return
+	}
+
+	if message.Method == "" {
+		t.response(c, "Received", message.ID, message.Result, message.Error)
+		return
+	}
+
+	t.follow(message.Method, message.Params)
+
+	if len(message.ID) == 0 {
+		t.notification(c, "Received", message.Method, message.Params)
+		return
+	}
+
+	t.request(c, "Received", message.Method, message.ID, message.Params)
+}
+
+// Updates the trace value from initialize and $/setTrace params.
+func (t *Tracer) follow(method string, params json.RawMessage) {
+	switch MethodKind(method) {
+	case InitializeMethod:
+		var initialize struct {
+			Trace TraceValue `json:"trace"`
+		}
+		if json.Unmarshal(params, &initialize) == nil && initialize.Trace != "" {
+			t.SetValue(initialize.Trace)
+		}
+	case OptionalSetTraceMethod:
+		var setTrace struct {
+			Value TraceValue `json:"value"`
+		}
+		if json.Unmarshal(params, &setTrace) == nil && setTrace.Value != "" {
+			t.SetValue(setTrace.Value)
+		}
+	}
+}
+
+func (t *Tracer) request(c *Conn, direction string, method string, id json.RawMessage, params json.RawMessage) {
+	t.mu.Lock()
+	if t.requests == nil {
+		t.requests = map[string]tracedRequest{}
+	}
+	t.requests[direction+idKey(id)] = tracedRequest{method: method, start: time.Now()}
+	t.mu.Unlock()
+
+	verbose := ""
+
+	if len(params) > 0 {
+		verbose = "Params: " + traceJSON(params) + "\n\n"
+	}
+
+	t.log(c, fmt.Sprintf("%s request '%s - (%s)'.", direction, method, traceID(id)), verbose)
+}
+
+func (t *Tracer) notification(c *Conn, direction string, method string, params json.RawMessage) {
+	verbose := "No parameters provided.\n\n"
+
+	if len(params) > 0 {
+		verbose = "Params: " + traceJSON(params) + "\n\n"
+	}
+
+	t.log(c, fmt.Sprintf("%s notification '%s'.", direction, method), verbose)
+}
+
+func (t *Tracer) response(c *Conn, direction string, id json.RawMessage, result json.RawMessage, responseError *ResponseError) {
+	// A response that is sent answers a request that was received, and the
+	// other way around.
+	requestDirection := "Received"
+	if direction == "Received" {
+		requestDirection = "Sending"
+	}
+
+	t.mu.Lock()
+	request, exists := t.requests[requestDirection+idKey(id)]
+	delete(t.requests, requestDirection+idKey(id))
+	t.mu.Unlock()
+
+	verbose := "No result returned.\n\n"
+
+	switch {
+	case responseError != nil && responseError.Data != nil:
+		data, _ := json.Marshal(responseError.Data)
+		verbose = "Error data: " + traceJSON(data) + "\n\n"
+	case responseError != nil:
+		verbose = ""
+	case len(result) > 0:
+		verbose = "Result: " + traceJSON(result) + "\n\n"
+	}
+
+	if !exists {
+		t.log(c, fmt.Sprintf("%s response %s without active response promise.", direction, traceID(id)), verbose)
+		return
+	}
+
+	took := time.Since(request.start).Milliseconds()
+
+	if direction == "Sending" {
+		t.log(c, fmt.Sprintf("Sending response '%s - (%s)'. Processing request took %dms", request.method, traceID(id), took), verbose)
+		return
+	}
+
+	failed := ""
+
+	if responseError != nil {
+		failed = fmt.Sprintf(" Request failed: %s (%d).", responseError.Message, responseError.Code)
+	}
+
+	t.log(c, fmt.Sprintf("Received response '%s - (%s)' in %dms.%s", request.method, traceID(id), took, failed), verbose)
+}
+
+// Writes a trace entry and sends it as $/logTrace, following the trace value.
+func (t *Tracer) log(c *Conn, message string, verbose string) {
+	value := t.Value()
+
+	if value == TraceValueOff {
+		return
+	}
+
+	if value != TraceValueVerbose {
+		verbose = ""
+	}
+
+	if t.Writer != nil {
+		entry := fmt.Sprintf("[Trace - %s] %s\n", time.Now().Format("3:04:05 PM"), message)
+
+		if verbose != "" {
+			entry += verbose + "\n"
+		}
+
+		t.mu.Lock()
+		io.WriteString(t.Writer, entry)
+		t.mu.Unlock()
+	}
+
+	if t.LogTrace {
+		t.queueLogTrace(c, LogTraceParams{Message: message, Verbose: verbose})
+	}
+}
+
+// Queues a $/logTrace notification. Messages are traced by the goroutine that
+// reads the connection, which can't wait on a write while the other side waits
+// for it to read, so notifications are sent from another goroutine.
+func (t *Tracer) queueLogTrace(c *Conn, params LogTraceParams) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.logTraces = append(t.logTraces, params)
+
+	if t.notifying {
+		return
+	}
+
+	t.notifying = true
+
+	go func() {
+		for {
+			t.mu.Lock()
+			if len(t.logTraces) == 0 {
+				t.notifying = false
+				t.mu.Unlock()
+				return
+			}
+			params := t.logTraces[0]
+			t.logTraces = t.logTraces[1:]
+			t.mu.Unlock()
+
+			c.Notify(context.Background(), OptionalLogTraceMethod, params)
+		}
+	}()
+}
+
+// Formats json the way VS Code traces it, indented with four spaces.
+func traceJSON(content json.RawMessage) string {
+	var buffer bytes.Buffer
+
+	if err := json.Indent(&buffer, content, "", "    "); err != nil {
+		return string(content)
+	}
+
+	return buffer.String()
+}
+
+// Formats an id without the quotes of string ids.
+func traceID(id json.RawMessage) string {
+	if unquoted, err := strconv.Unquote(string(id)); err == nil {
+		return unquoted
+	}
+
+	return string(id)
+}
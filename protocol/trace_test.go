@@ -0,0 +1,153 @@
+package protocol
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// A writer that can be read while a connection writes to it.
+type syncBuffer struct {
+	mu     sync.Mutex
+	buffer bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buffer.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buffer.String()
+}
+
+func TestTracerFollowsTraceValue(t *testing.T) {
+	var trace syncBuffer
+	logTraces := make(chan LogTraceParams, 10)
+	serverTracer := &Tracer{LogTrace: true}
+
+	client, _ := connPair(t, ConnOptions{
+		Handler: func(ctx context.Context, message IncomingMessage) (any, error) {
+			if logTrace, ok := message.(LogTraceNotification); ok {
+				logTraces <- logTrace.Params
+			}
+			return nil, nil
+		},
+		Tracer: &Tracer{Writer: &trace},
+	}, ConnOptions{
+		Handler: func(ctx context.Context, message IncomingMessage) (any, error) {
+			if _, ok := message.(InitializeRequest); ok {
+				return InitializeResult{}, nil
+			}
+			return nil, nil
+		},
+		Tracer: serverTracer,
+	})
+
+	verbose := TraceValueVerbose
+	if _, err := client.Initialize(context.Background(), InitializeParams{Trace: &verbose}); err != nil {
+		t.Fatal(err)
+	}
+
+	output := trace.String()
+	for _, expected := range []string{
+		"] Sending request 'initialize - (1)'.\nParams: {\n    \"",
+		"] Received response 'initialize - (1)' in ",
+		"Result: {\n    \"capabilities\": {}\n}\n\n\n",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Fatalf("Expected the trace to contain %q, got:\n%s", expected, output)
+		}
+	}
+	if !strings.HasPrefix(output, "[Trace - ") {
+		t.Fatalf("Expected VS Code's trace format, got:\n%s", output)
+	}
+
+	received := <-logTraces
+	if received.Message != "Received request 'initialize - (1)'." || !strings.HasPrefix(received.Verbose, "Params: {") {
+		t.Fatalf("Unexpected $/logTrace: %+v", received)
+	}
+	sent := <-logTraces
+	if !strings.HasPrefix(sent.Message, "Sending response 'initialize - (1)'. Processing request took ") {
+		t.Fatalf("Unexpected $/logTrace: %+v", sent)
+	}
+
+	if err := client.SetTrace(context.Background(), SetTraceParams{Value: TraceValueMessages}); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Initialized(context.Background(), InitializedParams{}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, method := range []string{"$/setTrace", "initialized"} {
+		if notification := <-logTraces; notification.Message != "Received notification '"+method+"'." || notification.Verbose != "" {
+			t.Fatalf("Expected a trace without params after $/setTrace, got %+v", notification)
+		}
+	}
+	if serverTracer.Value() != TraceValueMessages {
+		t.Fatalf("Expected messages, got %s", serverTracer.Value())
+	}
+}
+
+func TestTracerSendsLogTraceWithoutBlockingReads(t *testing.T) {
+	clientSide, serverSide := net.Pipe()
+	t.Cleanup(func() { clientSide.Close() })
+
+	tracer := &Tracer{LogTrace: true}
+	tracer.SetValue(TraceValueMessages)
+	server := NewConn(serverSide, ConnOptions{
+		Handler: func(ctx context.Context, message IncomingMessage) (any, error) {
+			return nil, nil
+		},
+		Tracer: tracer,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go server.Run(ctx)
+
+	// The pipe is unbuffered, so every notification is written before any
+	// $/logTrace is read, which blocks when the server traces from its reader.
+	written := make(chan error, 1)
+	go func() {
+		for range 3 {
+			content, _ := EncodeMessage(wireRequest{JsonRPC: "2.0", Method: InitializedMethod, Params: InitializedParams{}})
+			if _, err := clientSide.Write(content); err != nil {
+				written <- err
+				return
+			}
+		}
+		written <- nil
+	}()
+
+	select {
+	case err := <-written:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected the server to keep reading while $/logTrace is unread")
+	}
+
+	scanner := NewScanner(clientSide)
+	for range 3 {
+		if !scanner.Scan() {
+			t.Fatal(scanner.Err())
+		}
+		_, _, content, _ := SplitMessage(scanner.Bytes())
+		wire, err := decodeEnvelope(content)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if wire.Method != string(OptionalLogTraceMethod) || !strings.Contains(string(wire.Params), "Received notification 'initialized'.") {
+			t.Fatalf("Unexpected message: %s", content)
+		}
+	}
+}
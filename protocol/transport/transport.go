@@ -0,0 +1,197 @@
+// Package transport opens the connection a language server talks to its
+// client over, following the command-line conventions VS Code uses to launch
+// language servers.
+package transport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// The kind of connection a server was asked to use.
+type Kind string
+
+const (
+	KindStdio  Kind = "stdio"
+	KindSocket Kind = "socket"
+	KindPipe   Kind = "pipe"
+)
+
+// Options describes the transport selected on the command line.
+type Options struct {
+	Kind Kind
+	// The TCP port for KindSocket.
+	Port int
+	// The Unix domain socket path for KindPipe.
+	Pipe string
+	// The process id passed with --clientProcessId, or 0 if there was none.
+	ClientProcessID int
+}
+
+// Parses the transport flags out of args, e.g. os.Args[1:]. Flags may be given
+// as --flag=value or --flag value. Arguments that are not transport flags are
+// ignored, so they can be handled by the server's own flag parsing. Without any
+// transport flag, KindStdio is used. --node-ipc is an error, since it is only
+// supported by servers running in node.
+func ParseArgs(args []string) (Options, error) {
+	options := Options{Kind: KindStdio}
+	selected := false
+
+	selectKind := func(kind Kind) error {
+		if selected && options.Kind != kind {
+			return fmt.Errorf("conflicting transport flags: --%s and --%s", options.Kind, kind)
+		}
+		options.Kind = kind
+		selected = true
+		return nil
+	}
+
+	for i := 0; i < len(args); i++ {
+		if !strings.HasPrefix(args[i], "--") {
+			continue
+		}
+
+		name, value, hasValue := strings.Cut(args[i][2:], "=")
+
+		takeValue := func() (string, error) {
+			if hasValue {
+				return value, nil
+			}
+			if i+1 >= len(args) {
+				return "", fmt.Errorf("missing value for --%s", name)
+			}
+			i++
+			return args[i], nil
+		}
+
+		switch name {
+		case "stdio":
+			if err := selectKind(KindStdio); err != nil {
+				return Options{}, err
+			}
+		case "node-ipc":
+			return Options{}, errors.New("unsupported transport: --node-ipc is only supported by servers running in node")
+		case "socket":
+			if err := selectKind(KindSocket); err != nil {
+				return Options{}, err
+			}
+			raw, err := takeValue()
+			if err != nil {
+				return Options{}, err
+			}
+			port, err := strconv.Atoi(raw)
+			if err != nil || port <= 0 || port > 65535 {
+				return Options{}, fmt.Errorf("invalid port for --%s: %s", name, raw)
+			}
+			options.Port = port
+		case "pipe":
+			if err := selectKind(KindPipe); err != nil {
+				return Options{}, err
+			}
+			pipe, err := takeValue()
+			if err != nil {
+				return Options{}, err
+			}
+			if pipe == "" {
+				return Options{}, fmt.Errorf("missing value for --pipe")
+			}
+			options.Pipe = pipe
+		case "clientProcessId":
+			raw, err := takeValue()
+			if err != nil {
+				return Options{}, err
+			}
+			pid, err := strconv.Atoi(raw)
+			if err != nil || pid <= 0 {
+				return Options{}, fmt.Errorf("invalid process id for --clientProcessId: %s", raw)
+			}
+			options.ClientProcessID = pid
+		}
+	}
+
+	return options, nil
+}
+
+// Opens the connection described by options. As with VS Code, the client is the
+// one listening for --socket and --pipe, so the server dials it.
+func Open(ctx context.Context, options Options) (io.ReadWriteCloser, error) {
+	switch options.Kind {
+	case KindStdio, "":
+		return Stdio(), nil
+	case KindSocket:
+		return Dial(ctx, "tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(options.Port)))
+	case KindPipe:
+		return Dial(ctx, "unix", options.Pipe)
+	}
+
+	return nil, fmt.Errorf("unknown transport: %s", options.Kind)
+}
+
+// Dials address on network, e.g. "tcp" or "unix".
+func Dial(ctx context.Context, network string, address string) (io.ReadWriteCloser, error) {
+	var dialer net.Dialer
+
+	return dialer.DialContext(ctx, network, address)
+}
+
+// Listens on address and returns the first connection accepted on it. The
+// listener is closed once a connection is accepted or ctx is done.
+func Accept(ctx context.Context, network string, address string) (io.ReadWriteCloser, error) {
+	var config net.ListenConfig
+	listener, err := config.Listen(ctx, network, address)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return AcceptFrom(ctx, listener)
+}
+
+// Returns the first connection accepted on listener, and closes the listener.
+func AcceptFrom(ctx context.Context, listener net.Listener) (io.ReadWriteCloser, error) {
+	stop := context.AfterFunc(ctx, func() {
+		listener.Close()
+	})
+	defer stop()
+	defer listener.Close()
+
+	conn, err := listener.Accept()
+
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, err
+	}
+
+	return conn, nil
+}
+
+// Returns a connection over the process's stdin and stdout.
+func Stdio() io.ReadWriteCloser {
+	return ReadWriteCloser(os.Stdin, os.Stdout)
+}
+
+// Combines a reader and a writer into a single connection. Closing it closes both.
+func ReadWriteCloser(r io.ReadCloser, w io.WriteCloser) io.ReadWriteCloser {
+	return &pipe{r, w}
+}
+
+type pipe struct {
+	io.ReadCloser
+	w io.WriteCloser
+}
+
+func (p *pipe) Write(data []byte) (int, error) {
+	return p.w.Write(data)
+}
+
+func (p *pipe) Close() error {
+	return errors.Join(p.ReadCloser.Close(), p.w.Close())
+}
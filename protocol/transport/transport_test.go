@@ -0,0 +1,136 @@
+package transport
+
+import (
+	"context"
+	"io"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+)
+
+func TestParseArgs(t *testing.T) {
+	cases := []struct {
+		args     []string
+		expected Options
+	}{
+		{nil, Options{Kind: KindStdio}},
+		{[]string{"--stdio"}, Options{Kind: KindStdio}},
+		{[]string{"--socket=5007"}, Options{Kind: KindSocket, Port: 5007}},
+		{[]string{"--socket", "5007", "--clientProcessId=42"}, Options{Kind: KindSocket, Port: 5007, ClientProcessID: 42}},
+		{[]string{"-v", "--pipe=/tmp/lsp.sock", "other"}, Options{Kind: KindPipe, Pipe: "/tmp/lsp.sock"}},
+		{[]string{"--stdio", "--clientProcessId", "7"}, Options{Kind: KindStdio, ClientProcessID: 7}},
+	}
+
+	for _, c := range cases {
+		options, err := ParseArgs(c.args)
+		if err != nil {
+			t.Fatalf("%v: %s", c.args, err)
+		}
+		if options != c.expected {
+			t.Fatalf("%v: expected %+v, got %+v", c.args, c.expected, options)
+		}
+	}
+
+	invalid := [][]string{
+		{"--socket=abc"},
+		{"--socket"},
+		{"--stdio", "--socket=5007"},
+		{"--clientProcessId=-1"},
+		{"--pipe="},
+		{"--node-ipc"},
+	}
+
+	for _, args := range invalid {
+		if _, err := ParseArgs(args); err == nil {
+			t.Fatalf("%v: expected error", args)
+		}
+	}
+}
+
+func TestOpenSocket(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	options := Options{Kind: KindSocket, Port: listener.Addr().(*net.TCPAddr).Port}
+	testExchange(t, listener, options)
+}
+
+func TestOpenPipe(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lsp.sock")
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	options := Options{Kind: KindPipe, Pipe: path}
+	testExchange(t, listener, options)
+}
+
+func TestAcceptCancelled(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := Accept(ctx, "tcp", "127.0.0.1:0"); err != context.DeadlineExceeded {
+		t.Fatalf("Expected deadline exceeded, got %v", err)
+	}
+}
+
+// Plays the client, accepting on listener, while the server side opens options,
+// and sends a request from the server to the client.
+func testExchange(t *testing.T, listener net.Listener, options Options) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	accepted := make(chan io.ReadWriteCloser, 1)
+	go func() {
+		conn, err := AcceptFrom(ctx, listener)
+		if err != nil {
+			t.Error(err)
+		}
+		accepted <- conn
+	}()
+
+	server, err := Open(ctx, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+
+	client := <-accepted
+	if client == nil {
+		t.FailNow()
+	}
+	defer client.Close()
+
+	message, err := protocol.EncodeMessage(protocol.WorkspaceFoldersRequest{
+		JsonRPC: "2.0",
+		ID:      protocol.Or2[string, int32]{Value: int32(1)},
+		Method:  protocol.WorkspaceWorkspaceFoldersMethod,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := server.Write(message); err != nil {
+		t.Fatal(err)
+	}
+
+	scanner := protocol.NewScanner(client)
+	if !scanner.Scan() {
+		t.Fatalf("Expected a message, got error: %v", scanner.Err())
+	}
+
+	decoded, err := protocol.DecodeMessage(scanner.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, check := decoded.(protocol.WorkspaceFoldersRequest); !check {
+		t.Fatalf("Unexpected message type: %T", decoded)
+	}
+}
@@ -21,8 +21,8 @@ type Declaration Or2[Location, []Location]
 func (t *Declaration) UnmarshalJSON(x []byte) error {
 	return (*Or2[Location, []Location])(t).UnmarshalJSON(x)
 }
-func (t *Declaration) MarshalJSON() ([]byte, error) {
-	return (*Or2[Location, []Location])(t).MarshalJSON()
+func (t Declaration) MarshalJSON() ([]byte, error) {
+	return Or2[Location, []Location](t).MarshalJSON()
 }
 // Information about where a symbol is declared.
 // 
@@ -44,8 +44,8 @@ type Definition Or2[Location, []Location]
 func (t *Definition) UnmarshalJSON(x []byte) error {
 	return (*Or2[Location, []Location])(t).UnmarshalJSON(x)
 }
-func (t *Definition) MarshalJSON() ([]byte, error) {
-	return (*Or2[Location, []Location])(t).MarshalJSON()
+func (t Definition) MarshalJSON() ([]byte, error) {
+	return Or2[Location, []Location](t).MarshalJSON()
 }
 // Information about where a symbol is defined.
 // 
@@ -65,8 +65,8 @@ type DocumentDiagnosticReport Or2[RelatedFullDocumentDiagnosticReport, RelatedUn
 func (t *DocumentDiagnosticReport) UnmarshalJSON(x []byte) error {
 	return (*Or2[RelatedFullDocumentDiagnosticReport, RelatedUnchangedDocumentDiagnosticReport])(t).UnmarshalJSON(x)
 }
-func (t *DocumentDiagnosticReport) MarshalJSON() ([]byte, error) {
-	return (*Or2[RelatedFullDocumentDiagnosticReport, RelatedUnchangedDocumentDiagnosticReport])(t).MarshalJSON()
+func (t DocumentDiagnosticReport) MarshalJSON() ([]byte, error) {
+	return Or2[RelatedFullDocumentDiagnosticReport, RelatedUnchangedDocumentDiagnosticReport](t).MarshalJSON()
 }
 // A document filter describes a top level text document or
 // a notebook cell document.
@@ -77,8 +77,8 @@ type DocumentFilter Or2[TextDocumentFilter, NotebookCellTextDocumentFilter]
 func (t *DocumentFilter) UnmarshalJSON(x []byte) error {
 	return (*Or2[TextDocumentFilter, NotebookCellTextDocumentFilter])(t).UnmarshalJSON(x)
 }
-func (t *DocumentFilter) MarshalJSON() ([]byte, error) {
-	return (*Or2[TextDocumentFilter, NotebookCellTextDocumentFilter])(t).MarshalJSON()
+func (t DocumentFilter) MarshalJSON() ([]byte, error) {
+	return Or2[TextDocumentFilter, NotebookCellTextDocumentFilter](t).MarshalJSON()
 }
 // A document selector is the combination of one or many document filters.
 // 
@@ -95,8 +95,8 @@ type GlobPattern Or2[Pattern, RelativePattern]
 func (t *GlobPattern) UnmarshalJSON(x []byte) error {
 	return (*Or2[Pattern, RelativePattern])(t).UnmarshalJSON(x)
 }
-func (t *GlobPattern) MarshalJSON() ([]byte, error) {
-	return (*Or2[Pattern, RelativePattern])(t).MarshalJSON()
+func (t GlobPattern) MarshalJSON() ([]byte, error) {
+	return Or2[Pattern, RelativePattern](t).MarshalJSON()
 }
 // Inline value information can be provided by different means:
 // - directly as a text value (class InlineValueText).
@@ -110,8 +110,8 @@ type InlineValue Or3[InlineValueText, InlineValueVariableLookup, InlineValueEval
 func (t *InlineValue) UnmarshalJSON(x []byte) error {
 	return (*Or3[InlineValueText, InlineValueVariableLookup, InlineValueEvaluatableExpression])(t).UnmarshalJSON(x)
 }
-func (t *InlineValue) MarshalJSON() ([]byte, error) {
-	return (*Or3[InlineValueText, InlineValueVariableLookup, InlineValueEvaluatableExpression])(t).MarshalJSON()
+func (t InlineValue) MarshalJSON() ([]byte, error) {
+	return Or3[InlineValueText, InlineValueVariableLookup, InlineValueEvaluatableExpression](t).MarshalJSON()
 }
 // The LSP any type.
 // Please note that strictly speaking a property with the value `undefined`
@@ -146,8 +146,8 @@ type MarkedString Or2[string, MarkedStringWithLanguage]
 func (t *MarkedString) UnmarshalJSON(x []byte) error {
 	return (*Or2[string, MarkedStringWithLanguage])(t).UnmarshalJSON(x)
 }
-func (t *MarkedString) MarshalJSON() ([]byte, error) {
-	return (*Or2[string, MarkedStringWithLanguage])(t).MarshalJSON()
+func (t MarkedString) MarshalJSON() ([]byte, error) {
+	return Or2[string, MarkedStringWithLanguage](t).MarshalJSON()
 }
 // A notebook document filter denotes a notebook document by
 // different properties. The properties will be match
@@ -159,8 +159,8 @@ type NotebookDocumentFilter Or3[NotebookDocumentFilterNotebookType, NotebookDocu
 func (t *NotebookDocumentFilter) UnmarshalJSON(x []byte) error {
 	return (*Or3[NotebookDocumentFilterNotebookType, NotebookDocumentFilterScheme, NotebookDocumentFilterPattern])(t).UnmarshalJSON(x)
 }
-func (t *NotebookDocumentFilter) MarshalJSON() ([]byte, error) {
-	return (*Or3[NotebookDocumentFilterNotebookType, NotebookDocumentFilterScheme, NotebookDocumentFilterPattern])(t).MarshalJSON()
+func (t NotebookDocumentFilter) MarshalJSON() ([]byte, error) {
+	return Or3[NotebookDocumentFilterNotebookType, NotebookDocumentFilterScheme, NotebookDocumentFilterPattern](t).MarshalJSON()
 }
 // The glob pattern to watch relative to the base path. Glob patterns can have the following syntax:
 // - `*` to match one or more characters in a path segment
@@ -179,8 +179,8 @@ type PrepareRenameResult Or3[Range, PrepareRenamePlaceholder, PrepareRenameDefau
 func (t *PrepareRenameResult) UnmarshalJSON(x []byte) error {
 	return (*Or3[Range, PrepareRenamePlaceholder, PrepareRenameDefaultBehavior])(t).UnmarshalJSON(x)
 }
-func (t *PrepareRenameResult) MarshalJSON() ([]byte, error) {
-	return (*Or3[Range, PrepareRenamePlaceholder, PrepareRenameDefaultBehavior])(t).MarshalJSON()
+func (t PrepareRenameResult) MarshalJSON() ([]byte, error) {
+	return Or3[Range, PrepareRenamePlaceholder, PrepareRenameDefaultBehavior](t).MarshalJSON()
 }
 
 type ProgressToken Or2[int32, string]
@@ -188,8 +188,8 @@ type ProgressToken Or2[int32, string]
 func (t *ProgressToken) UnmarshalJSON(x []byte) error {
 	return (*Or2[int32, string])(t).UnmarshalJSON(x)
 }
-func (t *ProgressToken) MarshalJSON() ([]byte, error) {
-	return (*Or2[int32, string])(t).MarshalJSON()
+func (t ProgressToken) MarshalJSON() ([]byte, error) {
+	return Or2[int32, string](t).MarshalJSON()
 }
 
 type RegularExpressionEngineKind string
@@ -201,8 +201,8 @@ type TextDocumentContentChangeEvent Or2[TextDocumentContentChangePartial, TextDo
 func (t *TextDocumentContentChangeEvent) UnmarshalJSON(x []byte) error {
 	return (*Or2[TextDocumentContentChangePartial, TextDocumentContentChangeWholeDocument])(t).UnmarshalJSON(x)
 }
-func (t *TextDocumentContentChangeEvent) MarshalJSON() ([]byte, error) {
-	return (*Or2[TextDocumentContentChangePartial, TextDocumentContentChangeWholeDocument])(t).MarshalJSON()
+func (t TextDocumentContentChangeEvent) MarshalJSON() ([]byte, error) {
+	return Or2[TextDocumentContentChangePartial, TextDocumentContentChangeWholeDocument](t).MarshalJSON()
 }
 // A document filter denotes a document by different properties like
 // the {@link TextDocument.languageId language}, the {@link Uri.scheme scheme} of
@@ -225,8 +225,8 @@ type TextDocumentFilter Or3[TextDocumentFilterLanguage, TextDocumentFilterScheme
 func (t *TextDocumentFilter) UnmarshalJSON(x []byte) error {
 	return (*Or3[TextDocumentFilterLanguage, TextDocumentFilterScheme, TextDocumentFilterPattern])(t).UnmarshalJSON(x)
 }
-func (t *TextDocumentFilter) MarshalJSON() ([]byte, error) {
-	return (*Or3[TextDocumentFilterLanguage, TextDocumentFilterScheme, TextDocumentFilterPattern])(t).MarshalJSON()
+func (t TextDocumentFilter) MarshalJSON() ([]byte, error) {
+	return Or3[TextDocumentFilterLanguage, TextDocumentFilterScheme, TextDocumentFilterPattern](t).MarshalJSON()
 }
 // A workspace diagnostic document report.
 // 
@@ -236,8 +236,8 @@ type WorkspaceDocumentDiagnosticReport Or2[WorkspaceFullDocumentDiagnosticReport
 func (t *WorkspaceDocumentDiagnosticReport) UnmarshalJSON(x []byte) error {
 	return (*Or2[WorkspaceFullDocumentDiagnosticReport, WorkspaceUnchangedDocumentDiagnosticReport])(t).UnmarshalJSON(x)
 }
-func (t *WorkspaceDocumentDiagnosticReport) MarshalJSON() ([]byte, error) {
-	return (*Or2[WorkspaceFullDocumentDiagnosticReport, WorkspaceUnchangedDocumentDiagnosticReport])(t).MarshalJSON()
+func (t WorkspaceDocumentDiagnosticReport) MarshalJSON() ([]byte, error) {
+	return Or2[WorkspaceFullDocumentDiagnosticReport, WorkspaceUnchangedDocumentDiagnosticReport](t).MarshalJSON()
 }
 type UnmarshalError struct {
    msg string
@@ -343,7 +343,7 @@ type ApplyWorkspaceEditParams struct {
 	// 
 	// @since 3.18.0
 	// @proposed
-	Metadata *WorkspaceEditMetadata `json:"metadata,omitzero"`
+	Metadata *WorkspaceEditMetadata `json:"metadata,omitzero" since:"3.18.0" proposed:"true"`
 }
 func (t *ApplyWorkspaceEditParams) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -412,7 +412,7 @@ type BaseSymbolInformation struct {
 	// Tags for this symbol.
 	// 
 	// @since 3.16.0
-	Tags []SymbolTag `json:"tags,omitzero"`
+	Tags []SymbolTag `json:"tags,omitzero" since:"3.16.0"`
 }
 func (t *BaseSymbolInformation) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -819,11 +819,11 @@ type ClientCapabilities struct {
 	// General client capabilities.
 	// 
 	// @since 3.16.0
-	General *GeneralClientCapabilities `json:"general,omitzero"`
+	General *GeneralClientCapabilities `json:"general,omitzero" since:"3.16.0"`
 	// Capabilities specific to the notebook document support.
 	// 
 	// @since 3.17.0
-	NotebookDocument *NotebookDocumentClientCapabilities `json:"notebookDocument,omitzero"`
+	NotebookDocument *NotebookDocumentClientCapabilities `json:"notebookDocument,omitzero" since:"3.17.0"`
 	// Text document specific client capabilities.
 	TextDocument *TextDocumentClientCapabilities `json:"textDocument,omitzero"`
 	// Window specific client capabilities.
@@ -985,18 +985,18 @@ type ClientCompletionItemOptions struct {
 	// completion item is inserted in the text or should replace text.
 	// 
 	// @since 3.16.0
-	InsertReplaceSupport bool `json:"insertReplaceSupport,omitempty"`
+	InsertReplaceSupport bool `json:"insertReplaceSupport,omitempty" since:"3.16.0"`
 	// The client supports the `insertTextMode` property on
 	// a completion item to override the whitespace handling mode
 	// as defined by the client (see `insertTextMode`).
 	// 
 	// @since 3.16.0
-	InsertTextModeSupport *ClientCompletionItemInsertTextModeOptions `json:"insertTextModeSupport,omitzero"`
+	InsertTextModeSupport *ClientCompletionItemInsertTextModeOptions `json:"insertTextModeSupport,omitzero" since:"3.16.0"`
 	// The client has support for completion item label
 	// details (see also `CompletionItemLabelDetails`).
 	// 
 	// @since 3.17.0
-	LabelDetailsSupport bool `json:"labelDetailsSupport,omitempty"`
+	LabelDetailsSupport bool `json:"labelDetailsSupport,omitempty" since:"3.17.0"`
 	// Client supports the preselect property on a completion item.
 	PreselectSupport bool `json:"preselectSupport,omitempty"`
 	// Indicates which properties a client can resolve lazily on a completion
@@ -1004,7 +1004,7 @@ type ClientCompletionItemOptions struct {
 	// and `details` could be resolved lazily.
 	// 
 	// @since 3.16.0
-	ResolveSupport *ClientCompletionItemResolveOptions `json:"resolveSupport,omitzero"`
+	ResolveSupport *ClientCompletionItemResolveOptions `json:"resolveSupport,omitzero" since:"3.16.0"`
 	// Client supports snippets as insert text.
 	// 
 	// A snippet can define tab stops and placeholders with `$1`, `$2`
@@ -1018,7 +1018,7 @@ type ClientCompletionItemOptions struct {
 	// a resolve call.
 	// 
 	// @since 3.15.0
-	TagSupport *CompletionItemTagOptions `json:"tagSupport,omitzero"`
+	TagSupport *CompletionItemTagOptions `json:"tagSupport,omitzero" since:"3.15.0"`
 }
 func (t *ClientCompletionItemOptions) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -1145,7 +1145,7 @@ type ClientFoldingRangeOptions struct {
 	// folding ranges to display custom labels instead of the default text.
 	// 
 	// @since 3.17.0
-	CollapsedText bool `json:"collapsedText,omitempty"`
+	CollapsedText bool `json:"collapsedText,omitempty" since:"3.17.0"`
 }
 func (t *ClientFoldingRangeOptions) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -1296,7 +1296,7 @@ type ClientSignatureInformationOptions struct {
 	// literal.
 	// 
 	// @since 3.16.0
-	ActiveParameterSupport bool `json:"activeParameterSupport,omitempty"`
+	ActiveParameterSupport bool `json:"activeParameterSupport,omitempty" since:"3.16.0"`
 	// Client supports the following content formats for the documentation
 	// property. The order describes the preferred format of the client.
 	DocumentationFormat []MarkupKind `json:"documentationFormat,omitzero"`
@@ -1306,7 +1306,7 @@ type ClientSignatureInformationOptions struct {
 	// 
 	// @since 3.18.0
 	// @proposed
-	NoActiveParameterSupport bool `json:"noActiveParameterSupport,omitempty"`
+	NoActiveParameterSupport bool `json:"noActiveParameterSupport,omitempty" since:"3.18.0" proposed:"true"`
 	// Client capabilities specific to parameter information.
 	ParameterInformation *ClientSignatureParameterInformationOptions `json:"parameterInformation,omitzero"`
 }
@@ -1333,7 +1333,7 @@ type ClientSignatureParameterInformationOptions struct {
 	// simple label string.
 	// 
 	// @since 3.14.0
-	LabelOffsetSupport bool `json:"labelOffsetSupport,omitempty"`
+	LabelOffsetSupport bool `json:"labelOffsetSupport,omitempty" since:"3.14.0"`
 }
 func (t *ClientSignatureParameterInformationOptions) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -1443,7 +1443,7 @@ type CodeAction struct {
 	// a `textDocument/codeAction` and a `codeAction/resolve` request.
 	// 
 	// @since 3.16.0
-	Data any `json:"data,omitempty"`
+	Data any `json:"data,omitempty" since:"3.16.0"`
 	// The diagnostics that this code action resolves.
 	Diagnostics []Diagnostic `json:"diagnostics,omitzero"`
 	// Marks that the code action cannot currently be applied.
@@ -1461,7 +1461,7 @@ type CodeAction struct {
 	//     error message with `reason` in the editor.
 	// 
 	// @since 3.16.0
-	Disabled *CodeActionDisabled `json:"disabled,omitzero"`
+	Disabled *CodeActionDisabled `json:"disabled,omitzero" since:"3.16.0"`
 	// The workspace edit this code action performs.
 	Edit *WorkspaceEdit `json:"edit,omitzero"`
 	// Marks this as a preferred action. Preferred actions are used by the `auto fix` command and can be targeted
@@ -1471,7 +1471,7 @@ type CodeAction struct {
 	// A refactoring should be marked preferred if it is the most reasonable choice of actions to take.
 	// 
 	// @since 3.15.0
-	IsPreferred bool `json:"isPreferred,omitempty"`
+	IsPreferred bool `json:"isPreferred,omitempty" since:"3.15.0"`
 	// The kind of the code action.
 	// 
 	// Used to filter code actions.
@@ -1479,7 +1479,7 @@ type CodeAction struct {
 	// Tags for this code action.
 	// 
 	// @since 3.18.0 - proposed
-	Tags []CodeActionTag `json:"tags,omitzero"`
+	Tags []CodeActionTag `json:"tags,omitzero" since:"3.18.0"`
 	// A short, human-readable, title for this code action.
 	Title string `json:"title"`
 }
@@ -1509,23 +1509,23 @@ type CodeActionClientCapabilities struct {
 	// set the request can only return `Command` literals.
 	// 
 	// @since 3.8.0
-	CodeActionLiteralSupport *ClientCodeActionLiteralOptions `json:"codeActionLiteralSupport,omitzero"`
+	CodeActionLiteralSupport *ClientCodeActionLiteralOptions `json:"codeActionLiteralSupport,omitzero" since:"3.8.0"`
 	// Whether code action supports the `data` property which is
 	// preserved between a `textDocument/codeAction` and a
 	// `codeAction/resolve` request.
 	// 
 	// @since 3.16.0
-	DataSupport bool `json:"dataSupport,omitempty"`
+	DataSupport bool `json:"dataSupport,omitempty" since:"3.16.0"`
 	// Whether code action supports the `disabled` property.
 	// 
 	// @since 3.16.0
-	DisabledSupport bool `json:"disabledSupport,omitempty"`
+	DisabledSupport bool `json:"disabledSupport,omitempty" since:"3.16.0"`
 	// Whether the client supports documentation for a class of
 	// code actions.
 	// 
 	// @since 3.18.0
 	// @proposed
-	DocumentationSupport bool `json:"documentationSupport,omitempty"`
+	DocumentationSupport bool `json:"documentationSupport,omitempty" since:"3.18.0" proposed:"true"`
 	// Whether code action supports dynamic registration.
 	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
 	// Whether the client honors the change annotations in
@@ -1535,21 +1535,21 @@ type CodeActionClientCapabilities struct {
 	// for confirmation.
 	// 
 	// @since 3.16.0
-	HonorsChangeAnnotations bool `json:"honorsChangeAnnotations,omitempty"`
+	HonorsChangeAnnotations bool `json:"honorsChangeAnnotations,omitempty" since:"3.16.0"`
 	// Whether code action supports the `isPreferred` property.
 	// 
 	// @since 3.15.0
-	IsPreferredSupport bool `json:"isPreferredSupport,omitempty"`
+	IsPreferredSupport bool `json:"isPreferredSupport,omitempty" since:"3.15.0"`
 	// Whether the client supports resolving additional code action
 	// properties via a separate `codeAction/resolve` request.
 	// 
 	// @since 3.16.0
-	ResolveSupport *ClientCodeActionResolveOptions `json:"resolveSupport,omitzero"`
+	ResolveSupport *ClientCodeActionResolveOptions `json:"resolveSupport,omitzero" since:"3.16.0"`
 	// Client supports the tag property on a code action. Clients
 	// supporting tags have to handle unknown tags gracefully.
 	// 
 	// @since 3.18.0 - proposed
-	TagSupport *CodeActionTagOptions `json:"tagSupport,omitzero"`
+	TagSupport *CodeActionTagOptions `json:"tagSupport,omitzero" since:"3.18.0"`
 }
 func (t *CodeActionClientCapabilities) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -1585,7 +1585,7 @@ type CodeActionContext struct {
 	// The reason why code actions were requested.
 	// 
 	// @since 3.17.0
-	TriggerKind *CodeActionTriggerKind `json:"triggerKind,omitzero"`
+	TriggerKind *CodeActionTriggerKind `json:"triggerKind,omitzero" since:"3.17.0"`
 }
 func (t *CodeActionContext) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -1694,12 +1694,12 @@ type CodeActionOptions struct {
 	// 
 	// @since 3.18.0
 	// @proposed
-	Documentation []CodeActionKindDocumentation `json:"documentation,omitzero"`
+	Documentation []CodeActionKindDocumentation `json:"documentation,omitzero" since:"3.18.0" proposed:"true"`
 	// The server provides support to resolve additional
 	// information for a code action.
 	// 
 	// @since 3.16.0
-	ResolveProvider bool `json:"resolveProvider,omitempty"`
+	ResolveProvider bool `json:"resolveProvider,omitempty" since:"3.16.0"`
 
 	WorkDoneProgress bool `json:"workDoneProgress,omitempty"`
 }
@@ -1784,12 +1784,12 @@ type CodeActionRegistrationOptions struct {
 	// 
 	// @since 3.18.0
 	// @proposed
-	Documentation []CodeActionKindDocumentation `json:"documentation,omitzero"`
+	Documentation []CodeActionKindDocumentation `json:"documentation,omitzero" since:"3.18.0" proposed:"true"`
 	// The server provides support to resolve additional
 	// information for a code action.
 	// 
 	// @since 3.16.0
-	ResolveProvider bool `json:"resolveProvider,omitempty"`
+	ResolveProvider bool `json:"resolveProvider,omitempty" since:"3.16.0"`
 
 	WorkDoneProgress bool `json:"workDoneProgress,omitempty"`
 }
@@ -1903,7 +1903,7 @@ type CodeLensClientCapabilities struct {
 	// properties via a separate `codeLens/resolve` request.
 	// 
 	// @since 3.18.0
-	ResolveSupport *ClientCodeLensResolveOptions `json:"resolveSupport,omitzero"`
+	ResolveSupport *ClientCodeLensResolveOptions `json:"resolveSupport,omitzero" since:"3.18.0"`
 }
 func (t *CodeLensClientCapabilities) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -2188,7 +2188,7 @@ type Command struct {
 	// 
 	// @since 3.18.0
 	// @proposed
-	Tooltip string `json:"tooltip,omitempty"`
+	Tooltip string `json:"tooltip,omitempty" since:"3.18.0" proposed:"true"`
 }
 func (t *Command) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -2223,7 +2223,7 @@ type CompletionClientCapabilities struct {
 	// capabilities.
 	// 
 	// @since 3.17.0
-	CompletionList *CompletionListCapabilities `json:"completionList,omitzero"`
+	CompletionList *CompletionListCapabilities `json:"completionList,omitzero" since:"3.17.0"`
 	// The client supports to send additional context information for a
 	// `textDocument/completion` request.
 	ContextSupport bool `json:"contextSupport,omitempty"`
@@ -2234,7 +2234,7 @@ type CompletionClientCapabilities struct {
 	// text in either `insertText` or `textEdit`.
 	// 
 	// @since 3.17.0
-	InsertTextMode *InsertTextMode `json:"insertTextMode,omitzero"`
+	InsertTextMode *InsertTextMode `json:"insertTextMode,omitzero" since:"3.17.0"`
 }
 func (t *CompletionClientCapabilities) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -2338,7 +2338,7 @@ type CompletionItem struct {
 	// the `textDocument.completion.insertTextMode` client capability.
 	// 
 	// @since 3.16.0
-	InsertTextMode *InsertTextMode `json:"insertTextMode,omitzero"`
+	InsertTextMode *InsertTextMode `json:"insertTextMode,omitzero" since:"3.16.0"`
 	// The kind of this completion item. Based of the kind
 	// an icon is chosen by the editor.
 	Kind *CompletionItemKind `json:"kind,omitzero"`
@@ -2353,7 +2353,7 @@ type CompletionItem struct {
 	// Additional details for the label
 	// 
 	// @since 3.17.0
-	LabelDetails *CompletionItemLabelDetails `json:"labelDetails,omitzero"`
+	LabelDetails *CompletionItemLabelDetails `json:"labelDetails,omitzero" since:"3.17.0"`
 	// Select this item when showing.
 	// 
 	// *Note* that only one completion item can be selected and that the
@@ -2367,7 +2367,7 @@ type CompletionItem struct {
 	// Tags for this completion item.
 	// 
 	// @since 3.15.0
-	Tags []CompletionItemTag `json:"tags,omitzero"`
+	Tags []CompletionItemTag `json:"tags,omitzero" since:"3.15.0"`
 	// An {@link TextEdit edit} which is applied to a document when selecting
 	// this completion. When an edit is provided the value of
 	// {@link CompletionItem.insertText insertText} is ignored.
@@ -2399,7 +2399,7 @@ type CompletionItem struct {
 	// property is used as a text.
 	// 
 	// @since 3.17.0
-	TextEditText string `json:"textEditText,omitempty"`
+	TextEditText string `json:"textEditText,omitempty" since:"3.17.0"`
 }
 func (t *CompletionItem) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -2453,7 +2453,7 @@ type CompletionItemApplyKinds struct {
 	// and the completion's own `commitCharacters`.
 	// 
 	// @since 3.18.0
-	CommitCharacters *ApplyKind `json:"commitCharacters,omitzero"`
+	CommitCharacters *ApplyKind `json:"commitCharacters,omitzero" since:"3.18.0"`
 	// Specifies whether the `data` field on a completion will replace or
 	// be merged with data from `completionList.itemDefaults.data`.
 	// 
@@ -2476,7 +2476,7 @@ type CompletionItemApplyKinds struct {
 	//   within that value will occur.
 	// 
 	// @since 3.18.0
-	Data *ApplyKind `json:"data,omitzero"`
+	Data *ApplyKind `json:"data,omitzero" since:"3.18.0"`
 }
 func (t *CompletionItemApplyKinds) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -2514,23 +2514,23 @@ type CompletionItemDefaults struct {
 	// A default commit character set.
 	// 
 	// @since 3.17.0
-	CommitCharacters []string `json:"commitCharacters,omitzero"`
+	CommitCharacters []string `json:"commitCharacters,omitzero" since:"3.17.0"`
 	// A default data value.
 	// 
 	// @since 3.17.0
-	Data any `json:"data,omitempty"`
+	Data any `json:"data,omitempty" since:"3.17.0"`
 	// A default edit range.
 	// 
 	// @since 3.17.0
-	EditRange *Or2[Range, EditRangeWithInsertReplace] `json:"editRange,omitzero"`
+	EditRange *Or2[Range, EditRangeWithInsertReplace] `json:"editRange,omitzero" since:"3.17.0"`
 	// A default insert text format.
 	// 
 	// @since 3.17.0
-	InsertTextFormat *InsertTextFormat `json:"insertTextFormat,omitzero"`
+	InsertTextFormat *InsertTextFormat `json:"insertTextFormat,omitzero" since:"3.17.0"`
 	// A default insert text mode.
 	// 
 	// @since 3.17.0
-	InsertTextMode *InsertTextMode `json:"insertTextMode,omitzero"`
+	InsertTextMode *InsertTextMode `json:"insertTextMode,omitzero" since:"3.17.0"`
 }
 func (t *CompletionItemDefaults) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -2621,7 +2621,7 @@ type CompletionList struct {
 	// capability.
 	// 
 	// @since 3.18.0
-	ApplyKind *CompletionItemApplyKinds `json:"applyKind,omitzero"`
+	ApplyKind *CompletionItemApplyKinds `json:"applyKind,omitzero" since:"3.18.0"`
 	// This list it not complete. Further typing results in recomputing this list.
 	// 
 	// Recomputed lists have all their items replaced (not appended) in the
@@ -2642,7 +2642,7 @@ type CompletionList struct {
 	// capability.
 	// 
 	// @since 3.17.0
-	ItemDefaults *CompletionItemDefaults `json:"itemDefaults,omitzero"`
+	ItemDefaults *CompletionItemDefaults `json:"itemDefaults,omitzero" since:"3.17.0"`
 	// The completion items.
 	Items []CompletionItem `json:"items"`
 }
@@ -2684,7 +2684,7 @@ type CompletionListCapabilities struct {
 	// defined in `CompletionList.applyKind`.
 	// 
 	// @since 3.18.0
-	ApplyKindSupport bool `json:"applyKindSupport,omitempty"`
+	ApplyKindSupport bool `json:"applyKindSupport,omitempty" since:"3.18.0"`
 	// The client supports the following itemDefaults on
 	// a completion list.
 	// 
@@ -2693,7 +2693,7 @@ type CompletionListCapabilities struct {
 	// no properties are supported.
 	// 
 	// @since 3.17.0
-	ItemDefaults []string `json:"itemDefaults,omitzero"`
+	ItemDefaults []string `json:"itemDefaults,omitzero" since:"3.17.0"`
 }
 func (t *CompletionListCapabilities) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -2722,12 +2722,12 @@ type CompletionOptions struct {
 	// completion item the ones on the completion item win.
 	// 
 	// @since 3.2.0
-	AllCommitCharacters []string `json:"allCommitCharacters,omitzero"`
+	AllCommitCharacters []string `json:"allCommitCharacters,omitzero" since:"3.2.0"`
 	// The server supports the following `CompletionItem` specific
 	// capabilities.
 	// 
 	// @since 3.17.0
-	CompletionItem *ServerCompletionItemOptions `json:"completionItem,omitzero"`
+	CompletionItem *ServerCompletionItemOptions `json:"completionItem,omitzero" since:"3.17.0"`
 	// The server provides support to resolve additional
 	// information for a completion item.
 	ResolveProvider bool `json:"resolveProvider,omitempty"`
@@ -2807,12 +2807,12 @@ type CompletionRegistrationOptions struct {
 	// completion item the ones on the completion item win.
 	// 
 	// @since 3.2.0
-	AllCommitCharacters []string `json:"allCommitCharacters,omitzero"`
+	AllCommitCharacters []string `json:"allCommitCharacters,omitzero" since:"3.2.0"`
 	// The server supports the following `CompletionItem` specific
 	// capabilities.
 	// 
 	// @since 3.17.0
-	CompletionItem *ServerCompletionItemOptions `json:"completionItem,omitzero"`
+	CompletionItem *ServerCompletionItemOptions `json:"completionItem,omitzero" since:"3.17.0"`
 	// A document selector to identify the scope of the registration. If set to null
 	// the document selector provided on the client side will be used.
 	DocumentSelector *DocumentSelector `json:"documentSelector"`
@@ -2903,7 +2903,7 @@ type CreateFile struct {
 	// An optional annotation identifier describing the operation.
 	// 
 	// @since 3.16.0
-	AnnotationId *ChangeAnnotationIdentifier `json:"annotationId,omitzero"`
+	AnnotationId *ChangeAnnotationIdentifier `json:"annotationId,omitzero" since:"3.16.0"`
 	// A create
 	Kind string `json:"kind"`
 	// Additional options
@@ -3103,7 +3103,7 @@ type DefinitionClientCapabilities struct {
 	// The client supports additional metadata in the form of definition links.
 	// 
 	// @since 3.14.0
-	LinkSupport bool `json:"linkSupport,omitempty"`
+	LinkSupport bool `json:"linkSupport,omitempty" since:"3.14.0"`
 }
 func (t *DefinitionClientCapabilities) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -3210,7 +3210,7 @@ type DeleteFile struct {
 	// An optional annotation identifier describing the operation.
 	// 
 	// @since 3.16.0
-	AnnotationId *ChangeAnnotationIdentifier `json:"annotationId,omitzero"`
+	AnnotationId *ChangeAnnotationIdentifier `json:"annotationId,omitzero" since:"3.16.0"`
 	// A delete
 	Kind string `json:"kind"`
 	// Delete options.
@@ -3300,12 +3300,12 @@ type Diagnostic struct {
 	// Requires the code field (above) to be present/not null.
 	// 
 	// @since 3.16.0
-	CodeDescription *CodeDescription `json:"codeDescription,omitzero"`
+	CodeDescription *CodeDescription `json:"codeDescription,omitzero" since:"3.16.0"`
 	// A data entry field that is preserved between a `textDocument/publishDiagnostics`
 	// notification and `textDocument/codeAction` request.
 	// 
 	// @since 3.16.0
-	Data any `json:"data,omitempty"`
+	Data any `json:"data,omitempty" since:"3.16.0"`
 	// The diagnostic's message. It usually appears in the user interface
 	Message string `json:"message"`
 	// The range at which the message applies
@@ -3324,7 +3324,7 @@ type Diagnostic struct {
 	// Additional metadata about the diagnostic.
 	// 
 	// @since 3.15.0
-	Tags []DiagnosticTag `json:"tags,omitzero"`
+	Tags []DiagnosticTag `json:"tags,omitzero" since:"3.15.0"`
 }
 func (t *Diagnostic) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -3355,13 +3355,13 @@ type DiagnosticClientCapabilities struct {
 	// Client supports a codeDescription property
 	// 
 	// @since 3.16.0
-	CodeDescriptionSupport bool `json:"codeDescriptionSupport,omitempty"`
+	CodeDescriptionSupport bool `json:"codeDescriptionSupport,omitempty" since:"3.16.0"`
 	// Whether code action supports the `data` property which is
 	// preserved between a `textDocument/publishDiagnostics` and
 	// `textDocument/codeAction` request.
 	// 
 	// @since 3.16.0
-	DataSupport bool `json:"dataSupport,omitempty"`
+	DataSupport bool `json:"dataSupport,omitempty" since:"3.16.0"`
 	// Whether implementation supports dynamic registration. If this is set to `true`
 	// the client supports the new `(TextDocumentRegistrationOptions & StaticRegistrationOptions)`
 	// return value for the corresponding server capability as well.
@@ -3374,7 +3374,7 @@ type DiagnosticClientCapabilities struct {
 	// Clients supporting tags have to handle unknown tags gracefully.
 	// 
 	// @since 3.15.0
-	TagSupport *ClientDiagnosticsTagOptions `json:"tagSupport,omitzero"`
+	TagSupport *ClientDiagnosticsTagOptions `json:"tagSupport,omitzero" since:"3.15.0"`
 }
 func (t *DiagnosticClientCapabilities) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -3572,20 +3572,20 @@ type DiagnosticsCapabilities struct {
 	// Client supports a codeDescription property
 	// 
 	// @since 3.16.0
-	CodeDescriptionSupport bool `json:"codeDescriptionSupport,omitempty"`
+	CodeDescriptionSupport bool `json:"codeDescriptionSupport,omitempty" since:"3.16.0"`
 	// Whether code action supports the `data` property which is
 	// preserved between a `textDocument/publishDiagnostics` and
 	// `textDocument/codeAction` request.
 	// 
 	// @since 3.16.0
-	DataSupport bool `json:"dataSupport,omitempty"`
+	DataSupport bool `json:"dataSupport,omitempty" since:"3.16.0"`
 	// Whether the clients accepts diagnostics with related information.
 	RelatedInformation bool `json:"relatedInformation,omitempty"`
 	// Client supports the tag property to provide meta data about a diagnostic.
 	// Clients supporting tags have to handle unknown tags gracefully.
 	// 
 	// @since 3.15.0
-	TagSupport *ClientDiagnosticsTagOptions `json:"tagSupport,omitzero"`
+	TagSupport *ClientDiagnosticsTagOptions `json:"tagSupport,omitzero" since:"3.15.0"`
 }
 func (t *DiagnosticsCapabilities) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -3769,7 +3769,7 @@ type DidChangeWatchedFilesClientCapabilities struct {
 	// or not.
 	// 
 	// @since 3.17.0
-	RelativePatternSupport bool `json:"relativePatternSupport,omitempty"`
+	RelativePatternSupport bool `json:"relativePatternSupport,omitempty" since:"3.17.0"`
 }
 func (t *DidChangeWatchedFilesClientCapabilities) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -4443,7 +4443,7 @@ type DocumentLink struct {
 	// user settings, and localization.
 	// 
 	// @since 3.15.0
-	Tooltip string `json:"tooltip,omitempty"`
+	Tooltip string `json:"tooltip,omitempty" since:"3.15.0"`
 }
 func (t *DocumentLink) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -4471,7 +4471,7 @@ type DocumentLinkClientCapabilities struct {
 	// Whether the client supports the `tooltip` property on `DocumentLink`.
 	// 
 	// @since 3.15.0
-	TooltipSupport bool `json:"tooltipSupport,omitempty"`
+	TooltipSupport bool `json:"tooltipSupport,omitempty" since:"3.15.0"`
 }
 func (t *DocumentLinkClientCapabilities) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -4704,7 +4704,7 @@ type DocumentRangeFormattingClientCapabilities struct {
 	// 
 	// @since 3.18.0
 	// @proposed
-	RangesSupport bool `json:"rangesSupport,omitempty"`
+	RangesSupport bool `json:"rangesSupport,omitempty" since:"3.18.0" proposed:"true"`
 }
 func (t *DocumentRangeFormattingClientCapabilities) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -4729,7 +4729,7 @@ type DocumentRangeFormattingOptions struct {
 	// 
 	// @since 3.18.0
 	// @proposed
-	RangesSupport bool `json:"rangesSupport,omitempty"`
+	RangesSupport bool `json:"rangesSupport,omitempty" since:"3.18.0" proposed:"true"`
 
 	WorkDoneProgress bool `json:"workDoneProgress,omitempty"`
 }
@@ -4795,7 +4795,7 @@ type DocumentRangeFormattingRegistrationOptions struct {
 	// 
 	// @since 3.18.0
 	// @proposed
-	RangesSupport bool `json:"rangesSupport,omitempty"`
+	RangesSupport bool `json:"rangesSupport,omitempty" since:"3.18.0" proposed:"true"`
 
 	WorkDoneProgress bool `json:"workDoneProgress,omitempty"`
 }
@@ -4885,7 +4885,7 @@ type DocumentSymbol struct {
 	// Tags for this document symbol.
 	// 
 	// @since 3.16.0
-	Tags []SymbolTag `json:"tags,omitzero"`
+	Tags []SymbolTag `json:"tags,omitzero" since:"3.16.0"`
 }
 func (t *DocumentSymbol) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -4925,7 +4925,7 @@ type DocumentSymbolClientCapabilities struct {
 	// registering a document symbol provider.
 	// 
 	// @since 3.16.0
-	LabelSupport bool `json:"labelSupport,omitempty"`
+	LabelSupport bool `json:"labelSupport,omitempty" since:"3.16.0"`
 	// Specific capabilities for the `SymbolKind` in the
 	// `textDocument/documentSymbol` request.
 	SymbolKind *ClientSymbolKindOptions `json:"symbolKind,omitzero"`
@@ -4934,7 +4934,7 @@ type DocumentSymbolClientCapabilities struct {
 	// Clients supporting tags have to handle unknown tags gracefully.
 	// 
 	// @since 3.16.0
-	TagSupport *ClientSymbolTagOptions `json:"tagSupport,omitzero"`
+	TagSupport *ClientSymbolTagOptions `json:"tagSupport,omitzero" since:"3.16.0"`
 }
 func (t *DocumentSymbolClientCapabilities) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -4959,7 +4959,7 @@ type DocumentSymbolOptions struct {
 	// are shown for the same document.
 	// 
 	// @since 3.16.0
-	Label string `json:"label,omitempty"`
+	Label string `json:"label,omitempty" since:"3.16.0"`
 
 	WorkDoneProgress bool `json:"workDoneProgress,omitempty"`
 }
@@ -5018,7 +5018,7 @@ type DocumentSymbolRegistrationOptions struct {
 	// are shown for the same document.
 	// 
 	// @since 3.16.0
-	Label string `json:"label,omitempty"`
+	Label string `json:"label,omitempty" since:"3.16.0"`
 
 	WorkDoneProgress bool `json:"workDoneProgress,omitempty"`
 }
@@ -5544,7 +5544,7 @@ type FoldingRange struct {
 	// will be chosen by the client.
 	// 
 	// @since 3.17.0
-	CollapsedText string `json:"collapsedText,omitempty"`
+	CollapsedText string `json:"collapsedText,omitempty" since:"3.17.0"`
 	// The zero-based character offset before the folded range ends. If not defined, defaults to the length of the end line.
 	EndCharacter uint32 `json:"endCharacter,omitempty"`
 	// The zero-based end line of the range to fold. The folded area ends with the line's last character.
@@ -5592,11 +5592,11 @@ type FoldingRangeClientCapabilities struct {
 	// Specific options for the folding range.
 	// 
 	// @since 3.17.0
-	FoldingRange *ClientFoldingRangeOptions `json:"foldingRange,omitzero"`
+	FoldingRange *ClientFoldingRangeOptions `json:"foldingRange,omitzero" since:"3.17.0"`
 	// Specific options for the folding range kind.
 	// 
 	// @since 3.17.0
-	FoldingRangeKind *ClientFoldingRangeKindOptions `json:"foldingRangeKind,omitzero"`
+	FoldingRangeKind *ClientFoldingRangeKindOptions `json:"foldingRangeKind,omitzero" since:"3.17.0"`
 	// If set, the client signals that it only supports folding complete lines.
 	// If set, client will ignore specified `startCharacter` and `endCharacter`
 	// properties in a FoldingRange.
@@ -5719,7 +5719,7 @@ type FoldingRangeWorkspaceClientCapabilities struct {
 	// 
 	// @since 3.18.0
 	// @proposed
-	RefreshSupport bool `json:"refreshSupport,omitempty"`
+	RefreshSupport bool `json:"refreshSupport,omitempty" since:"3.18.0" proposed:"true"`
 }
 func (t *FoldingRangeWorkspaceClientCapabilities) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -5743,7 +5743,7 @@ type FormattingOptions struct {
 	// Insert a newline character at the end of the file if one does not exist.
 	// 
 	// @since 3.15.0
-	InsertFinalNewline bool `json:"insertFinalNewline,omitempty"`
+	InsertFinalNewline bool `json:"insertFinalNewline,omitempty" since:"3.15.0"`
 	// Prefer spaces over tabs.
 	InsertSpaces bool `json:"insertSpaces"`
 	// Size of a tab in spaces.
@@ -5751,11 +5751,11 @@ type FormattingOptions struct {
 	// Trim all newlines after the final newline at the end of the file.
 	// 
 	// @since 3.15.0
-	TrimFinalNewlines bool `json:"trimFinalNewlines,omitempty"`
+	TrimFinalNewlines bool `json:"trimFinalNewlines,omitempty" since:"3.15.0"`
 	// Trim trailing whitespace on a line.
 	// 
 	// @since 3.15.0
-	TrimTrailingWhitespace bool `json:"trimTrailingWhitespace,omitempty"`
+	TrimTrailingWhitespace bool `json:"trimTrailingWhitespace,omitempty" since:"3.15.0"`
 }
 func (t *FormattingOptions) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -5821,7 +5821,7 @@ type GeneralClientCapabilities struct {
 	// Client capabilities specific to the client's markdown parser.
 	// 
 	// @since 3.16.0
-	Markdown *MarkdownClientCapabilities `json:"markdown,omitzero"`
+	Markdown *MarkdownClientCapabilities `json:"markdown,omitzero" since:"3.16.0"`
 	// The position encodings supported by the client. Client and server
 	// have to agree on the same position encoding to ensure that offsets
 	// (e.g. character position in a line) are interpreted the same on both
@@ -5840,18 +5840,18 @@ type GeneralClientCapabilities struct {
 	// side.
 	// 
 	// @since 3.17.0
-	PositionEncodings []PositionEncodingKind `json:"positionEncodings,omitzero"`
+	PositionEncodings []PositionEncodingKind `json:"positionEncodings,omitzero" since:"3.17.0"`
 	// Client capabilities specific to regular expressions.
 	// 
 	// @since 3.16.0
-	RegularExpressions *RegularExpressionsClientCapabilities `json:"regularExpressions,omitzero"`
+	RegularExpressions *RegularExpressionsClientCapabilities `json:"regularExpressions,omitzero" since:"3.16.0"`
 	// Client capability that signals how the client
 	// handles stale requests (e.g. a request
 	// for which the client will not process the response
 	// anymore since the information is outdated).
 	// 
 	// @since 3.17.0
-	StaleRequestSupport *StaleRequestSupportOptions `json:"staleRequestSupport,omitzero"`
+	StaleRequestSupport *StaleRequestSupportOptions `json:"staleRequestSupport,omitzero" since:"3.17.0"`
 }
 func (t *GeneralClientCapabilities) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -6011,7 +6011,7 @@ type ImplementationClientCapabilities struct {
 	// The client supports additional metadata in the form of definition links.
 	// 
 	// @since 3.14.0
-	LinkSupport bool `json:"linkSupport,omitempty"`
+	LinkSupport bool `json:"linkSupport,omitempty" since:"3.14.0"`
 }
 func (t *ImplementationClientCapabilities) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -6151,7 +6151,7 @@ type InitializeParams struct {
 	// Information about the client
 	// 
 	// @since 3.15.0
-	ClientInfo *ClientInfo `json:"clientInfo,omitzero"`
+	ClientInfo *ClientInfo `json:"clientInfo,omitzero" since:"3.15.0"`
 	// User provided initialization options.
 	InitializationOptions any `json:"initializationOptions,omitempty"`
 	// The locale the client is currently showing the user interface
@@ -6162,7 +6162,7 @@ type InitializeParams struct {
 	// (See https://en.wikipedia.org/wiki/IETF_language_tag)
 	// 
 	// @since 3.16.0
-	Locale string `json:"locale,omitempty"`
+	Locale string `json:"locale,omitempty" since:"3.16.0"`
 	// The process Id of the parent process that started
 	// the server.
 	// 
@@ -6191,7 +6191,7 @@ type InitializeParams struct {
 	// configured.
 	// 
 	// @since 3.6.0
-	WorkspaceFolders *[]WorkspaceFolder `json:"workspaceFolders,omitzero"`
+	WorkspaceFolders *[]WorkspaceFolder `json:"workspaceFolders,omitzero" since:"3.6.0"`
 }
 func (t *InitializeParams) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -6225,7 +6225,7 @@ type InitializeResult struct {
 	// Information about the server.
 	// 
 	// @since 3.15.0
-	ServerInfo *ServerInfo `json:"serverInfo,omitzero"`
+	ServerInfo *ServerInfo `json:"serverInfo,omitzero" since:"3.15.0"`
 }
 func (t *InitializeResult) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -7338,7 +7338,7 @@ type MarkdownClientCapabilities struct {
 	// Markdown.
 	// 
 	// @since 3.17.0
-	AllowedTags []string `json:"allowedTags,omitzero"`
+	AllowedTags []string `json:"allowedTags,omitzero" since:"3.17.0"`
 	// The name of the parser.
 	Parser string `json:"parser"`
 	// The version of the parser.
@@ -7924,7 +7924,7 @@ type NotebookDocumentClientCapabilities struct {
 	// Capabilities specific to notebook document synchronization
 	// 
 	// @since 3.17.0
-	Synchronization NotebookDocumentSyncClientCapabilities `json:"synchronization"`
+	Synchronization NotebookDocumentSyncClientCapabilities `json:"synchronization" since:"3.17.0"`
 }
 func (t *NotebookDocumentClientCapabilities) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -8520,25 +8520,25 @@ type PublishDiagnosticsClientCapabilities struct {
 	// Client supports a codeDescription property
 	// 
 	// @since 3.16.0
-	CodeDescriptionSupport bool `json:"codeDescriptionSupport,omitempty"`
+	CodeDescriptionSupport bool `json:"codeDescriptionSupport,omitempty" since:"3.16.0"`
 	// Whether code action supports the `data` property which is
 	// preserved between a `textDocument/publishDiagnostics` and
 	// `textDocument/codeAction` request.
 	// 
 	// @since 3.16.0
-	DataSupport bool `json:"dataSupport,omitempty"`
+	DataSupport bool `json:"dataSupport,omitempty" since:"3.16.0"`
 	// Whether the clients accepts diagnostics with related information.
 	RelatedInformation bool `json:"relatedInformation,omitempty"`
 	// Client supports the tag property to provide meta data about a diagnostic.
 	// Clients supporting tags have to handle unknown tags gracefully.
 	// 
 	// @since 3.15.0
-	TagSupport *ClientDiagnosticsTagOptions `json:"tagSupport,omitzero"`
+	TagSupport *ClientDiagnosticsTagOptions `json:"tagSupport,omitzero" since:"3.15.0"`
 	// Whether the client interprets the version property of the
 	// `textDocument/publishDiagnostics` notification's parameter.
 	// 
 	// @since 3.15.0
-	VersionSupport bool `json:"versionSupport,omitempty"`
+	VersionSupport bool `json:"versionSupport,omitempty" since:"3.15.0"`
 }
 func (t *PublishDiagnosticsClientCapabilities) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -8566,7 +8566,7 @@ type PublishDiagnosticsParams struct {
 	// Optional the version number of the document the diagnostics are published for.
 	// 
 	// @since 3.15.0
-	Version int32 `json:"version,omitempty"`
+	Version int32 `json:"version,omitempty" since:"3.15.0"`
 }
 func (t *PublishDiagnosticsParams) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -8863,7 +8863,7 @@ type RelatedFullDocumentDiagnosticReport struct {
 	// a.cpp and result in errors in a header file b.hpp.
 	// 
 	// @since 3.17.0
-	RelatedDocuments map[DocumentUri]Or2[FullDocumentDiagnosticReport, UnchangedDocumentDiagnosticReport] `json:"relatedDocuments,omitzero"`
+	RelatedDocuments map[DocumentUri]Or2[FullDocumentDiagnosticReport, UnchangedDocumentDiagnosticReport] `json:"relatedDocuments,omitzero" since:"3.17.0"`
 	// An optional result id. If provided it will
 	// be sent on the next diagnostic request for the
 	// same document.
@@ -8907,7 +8907,7 @@ type RelatedUnchangedDocumentDiagnosticReport struct {
 	// a.cpp and result in errors in a header file b.hpp.
 	// 
 	// @since 3.17.0
-	RelatedDocuments map[DocumentUri]Or2[FullDocumentDiagnosticReport, UnchangedDocumentDiagnosticReport] `json:"relatedDocuments,omitzero"`
+	RelatedDocuments map[DocumentUri]Or2[FullDocumentDiagnosticReport, UnchangedDocumentDiagnosticReport] `json:"relatedDocuments,omitzero" since:"3.17.0"`
 	// A result id which will be sent on the next
 	// diagnostic request for the same document.
 	ResultId string `json:"resultId"`
@@ -8979,19 +8979,19 @@ type RenameClientCapabilities struct {
 	// for confirmation.
 	// 
 	// @since 3.16.0
-	HonorsChangeAnnotations bool `json:"honorsChangeAnnotations,omitempty"`
+	HonorsChangeAnnotations bool `json:"honorsChangeAnnotations,omitempty" since:"3.16.0"`
 	// Client supports testing for validity of rename operations
 	// before execution.
 	// 
 	// @since 3.12.0
-	PrepareSupport bool `json:"prepareSupport,omitempty"`
+	PrepareSupport bool `json:"prepareSupport,omitempty" since:"3.12.0"`
 	// Client supports the default behavior result.
 	// 
 	// The value indicates the default behavior used by the
 	// client.
 	// 
 	// @since 3.16.0
-	PrepareSupportDefaultBehavior *PrepareSupportDefaultBehavior `json:"prepareSupportDefaultBehavior,omitzero"`
+	PrepareSupportDefaultBehavior *PrepareSupportDefaultBehavior `json:"prepareSupportDefaultBehavior,omitzero" since:"3.16.0"`
 }
 func (t *RenameClientCapabilities) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -9015,7 +9015,7 @@ type RenameFile struct {
 	// An optional annotation identifier describing the operation.
 	// 
 	// @since 3.16.0
-	AnnotationId *ChangeAnnotationIdentifier `json:"annotationId,omitzero"`
+	AnnotationId *ChangeAnnotationIdentifier `json:"annotationId,omitzero" since:"3.16.0"`
 	// A rename
 	Kind string `json:"kind"`
 	// The new location.
@@ -9107,7 +9107,7 @@ type RenameOptions struct {
 	// Renames should be checked and tested before being executed.
 	// 
 	// @since version 3.12.0
-	PrepareProvider bool `json:"prepareProvider,omitempty"`
+	PrepareProvider bool `json:"prepareProvider,omitempty" since:"3.12.0"`
 
 	WorkDoneProgress bool `json:"workDoneProgress,omitempty"`
 }
@@ -9174,7 +9174,7 @@ type RenameRegistrationOptions struct {
 	// Renames should be checked and tested before being executed.
 	// 
 	// @since version 3.12.0
-	PrepareProvider bool `json:"prepareProvider,omitempty"`
+	PrepareProvider bool `json:"prepareProvider,omitempty" since:"3.12.0"`
 
 	WorkDoneProgress bool `json:"workDoneProgress,omitempty"`
 }
@@ -9202,7 +9202,7 @@ type ResourceOperation struct {
 	// An optional annotation identifier describing the operation.
 	// 
 	// @since 3.16.0
-	AnnotationId *ChangeAnnotationIdentifier `json:"annotationId,omitzero"`
+	AnnotationId *ChangeAnnotationIdentifier `json:"annotationId,omitzero" since:"3.16.0"`
 	// The resource operation kind.
 	Kind string `json:"kind"`
 }
@@ -9457,7 +9457,7 @@ type SemanticTokensClientCapabilities struct {
 	// specified.
 	// 
 	// @since 3.17.0
-	AugmentsSyntaxTokens bool `json:"augmentsSyntaxTokens,omitempty"`
+	AugmentsSyntaxTokens bool `json:"augmentsSyntaxTokens,omitempty" since:"3.17.0"`
 	// Whether implementation supports dynamic registration. If this is set to `true`
 	// the client supports the new `(TextDocumentRegistrationOptions & StaticRegistrationOptions)`
 	// return value for the corresponding server capability as well.
@@ -9483,7 +9483,7 @@ type SemanticTokensClientCapabilities struct {
 	// needs to retrigger the request.
 	// 
 	// @since 3.17.0
-	ServerCancelSupport bool `json:"serverCancelSupport,omitempty"`
+	ServerCancelSupport bool `json:"serverCancelSupport,omitempty" since:"3.17.0"`
 	// The token modifiers that the client supports.
 	TokenModifiers []string `json:"tokenModifiers"`
 	// The token types that the client supports.
@@ -9878,7 +9878,7 @@ type ServerCapabilities struct {
 	// The server provides call hierarchy support.
 	// 
 	// @since 3.16.0
-	CallHierarchyProvider *Or3[bool, CallHierarchyOptions, CallHierarchyRegistrationOptions] `json:"callHierarchyProvider,omitzero"`
+	CallHierarchyProvider *Or3[bool, CallHierarchyOptions, CallHierarchyRegistrationOptions] `json:"callHierarchyProvider,omitzero" since:"3.16.0"`
 	// The server provides code actions. CodeActionOptions may only be
 	// specified if the client states that it supports
 	// `codeActionLiteralSupport` in its initial `initialize` request.
@@ -9896,7 +9896,7 @@ type ServerCapabilities struct {
 	// The server has support for pull model diagnostics.
 	// 
 	// @since 3.17.0
-	DiagnosticProvider *Or2[DiagnosticOptions, DiagnosticRegistrationOptions] `json:"diagnosticProvider,omitzero"`
+	DiagnosticProvider *Or2[DiagnosticOptions, DiagnosticRegistrationOptions] `json:"diagnosticProvider,omitzero" since:"3.17.0"`
 	// The server provides document formatting.
 	DocumentFormattingProvider *Or2[bool, DocumentFormattingOptions] `json:"documentFormattingProvider,omitzero"`
 	// The server provides document highlight support.
@@ -9922,28 +9922,28 @@ type ServerCapabilities struct {
 	// The server provides inlay hints.
 	// 
 	// @since 3.17.0
-	InlayHintProvider *Or3[bool, InlayHintOptions, InlayHintRegistrationOptions] `json:"inlayHintProvider,omitzero"`
+	InlayHintProvider *Or3[bool, InlayHintOptions, InlayHintRegistrationOptions] `json:"inlayHintProvider,omitzero" since:"3.17.0"`
 	// Inline completion options used during static registration.
 	// 
 	// @since 3.18.0
 	// @proposed
-	InlineCompletionProvider *Or2[bool, InlineCompletionOptions] `json:"inlineCompletionProvider,omitzero"`
+	InlineCompletionProvider *Or2[bool, InlineCompletionOptions] `json:"inlineCompletionProvider,omitzero" since:"3.18.0" proposed:"true"`
 	// The server provides inline values.
 	// 
 	// @since 3.17.0
-	InlineValueProvider *Or3[bool, InlineValueOptions, InlineValueRegistrationOptions] `json:"inlineValueProvider,omitzero"`
+	InlineValueProvider *Or3[bool, InlineValueOptions, InlineValueRegistrationOptions] `json:"inlineValueProvider,omitzero" since:"3.17.0"`
 	// The server provides linked editing range support.
 	// 
 	// @since 3.16.0
-	LinkedEditingRangeProvider *Or3[bool, LinkedEditingRangeOptions, LinkedEditingRangeRegistrationOptions] `json:"linkedEditingRangeProvider,omitzero"`
+	LinkedEditingRangeProvider *Or3[bool, LinkedEditingRangeOptions, LinkedEditingRangeRegistrationOptions] `json:"linkedEditingRangeProvider,omitzero" since:"3.16.0"`
 	// The server provides moniker support.
 	// 
 	// @since 3.16.0
-	MonikerProvider *Or3[bool, MonikerOptions, MonikerRegistrationOptions] `json:"monikerProvider,omitzero"`
+	MonikerProvider *Or3[bool, MonikerOptions, MonikerRegistrationOptions] `json:"monikerProvider,omitzero" since:"3.16.0"`
 	// Defines how notebook documents are synced.
 	// 
 	// @since 3.17.0
-	NotebookDocumentSync *Or2[NotebookDocumentSyncOptions, NotebookDocumentSyncRegistrationOptions] `json:"notebookDocumentSync,omitzero"`
+	NotebookDocumentSync *Or2[NotebookDocumentSyncOptions, NotebookDocumentSyncRegistrationOptions] `json:"notebookDocumentSync,omitzero" since:"3.17.0"`
 	// The position encoding the server picked from the encodings offered
 	// by the client via the client capability `general.positionEncodings`.
 	// 
@@ -9953,7 +9953,7 @@ type ServerCapabilities struct {
 	// If omitted it defaults to 'utf-16'.
 	// 
 	// @since 3.17.0
-	PositionEncoding *PositionEncodingKind `json:"positionEncoding,omitzero"`
+	PositionEncoding *PositionEncodingKind `json:"positionEncoding,omitzero" since:"3.17.0"`
 	// The server provides find references support.
 	ReferencesProvider *Or2[bool, ReferenceOptions] `json:"referencesProvider,omitzero"`
 	// The server provides rename support. RenameOptions may only be
@@ -9965,7 +9965,7 @@ type ServerCapabilities struct {
 	// The server provides semantic tokens support.
 	// 
 	// @since 3.16.0
-	SemanticTokensProvider *Or2[SemanticTokensOptions, SemanticTokensRegistrationOptions] `json:"semanticTokensProvider,omitzero"`
+	SemanticTokensProvider *Or2[SemanticTokensOptions, SemanticTokensRegistrationOptions] `json:"semanticTokensProvider,omitzero" since:"3.16.0"`
 	// The server provides signature help support.
 	SignatureHelpProvider *SignatureHelpOptions `json:"signatureHelpProvider,omitzero"`
 	// Defines how text documents are synced. Is either a detailed structure
@@ -9977,7 +9977,7 @@ type ServerCapabilities struct {
 	// The server provides type hierarchy support.
 	// 
 	// @since 3.17.0
-	TypeHierarchyProvider *Or3[bool, TypeHierarchyOptions, TypeHierarchyRegistrationOptions] `json:"typeHierarchyProvider,omitzero"`
+	TypeHierarchyProvider *Or3[bool, TypeHierarchyOptions, TypeHierarchyRegistrationOptions] `json:"typeHierarchyProvider,omitzero" since:"3.17.0"`
 	// Workspace specific server capabilities.
 	Workspace *WorkspaceOptions `json:"workspace,omitzero"`
 	// The server provides workspace symbol support.
@@ -10007,7 +10007,7 @@ type ServerCompletionItemOptions struct {
 	// receiving a completion item in a resolve call.
 	// 
 	// @since 3.17.0
-	LabelDetailsSupport bool `json:"labelDetailsSupport,omitempty"`
+	LabelDetailsSupport bool `json:"labelDetailsSupport,omitempty" since:"3.17.0"`
 }
 func (t *ServerCompletionItemOptions) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -10315,7 +10315,7 @@ type SignatureHelpClientCapabilities struct {
 	// `SignatureHelpOptions`.
 	// 
 	// @since 3.15.0
-	ContextSupport bool `json:"contextSupport,omitempty"`
+	ContextSupport bool `json:"contextSupport,omitempty" since:"3.15.0"`
 	// Whether signature help supports dynamic registration.
 	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
 	// The client supports the following `SignatureInformation`
@@ -10390,7 +10390,7 @@ type SignatureHelpOptions struct {
 	// are also counted as re-trigger characters.
 	// 
 	// @since 3.15.0
-	RetriggerCharacters []string `json:"retriggerCharacters,omitzero"`
+	RetriggerCharacters []string `json:"retriggerCharacters,omitzero" since:"3.15.0"`
 	// List of characters that trigger signature help automatically.
 	TriggerCharacters []string `json:"triggerCharacters,omitzero"`
 
@@ -10419,7 +10419,7 @@ type SignatureHelpParams struct {
 	// to send this using the client capability `textDocument.signatureHelp.contextSupport === true`
 	// 
 	// @since 3.15.0
-	Context *SignatureHelpContext `json:"context,omitzero"`
+	Context *SignatureHelpContext `json:"context,omitzero" since:"3.15.0"`
 	// The position inside the text document.
 	Position Position `json:"position"`
 	// The text document.
@@ -10460,7 +10460,7 @@ type SignatureHelpRegistrationOptions struct {
 	// are also counted as re-trigger characters.
 	// 
 	// @since 3.15.0
-	RetriggerCharacters []string `json:"retriggerCharacters,omitzero"`
+	RetriggerCharacters []string `json:"retriggerCharacters,omitzero" since:"3.15.0"`
 	// List of characters that trigger signature help automatically.
 	TriggerCharacters []string `json:"triggerCharacters,omitzero"`
 
@@ -10500,7 +10500,7 @@ type SignatureInformation struct {
 	// `SignatureHelp.activeParameter`.
 	// 
 	// @since 3.16.0
-	ActiveParameter *uint32 `json:"activeParameter,omitzero"`
+	ActiveParameter *uint32 `json:"activeParameter,omitzero" since:"3.16.0"`
 	// The human-readable doc-comment of this signature. Will be shown
 	// in the UI but can be omitted.
 	Documentation *Or2[string, MarkupContent] `json:"documentation,omitzero"`
@@ -10685,7 +10685,7 @@ type SymbolInformation struct {
 	// Tags for this symbol.
 	// 
 	// @since 3.16.0
-	Tags []SymbolTag `json:"tags,omitzero"`
+	Tags []SymbolTag `json:"tags,omitzero" since:"3.16.0"`
 }
 func (t *SymbolInformation) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -10747,7 +10747,7 @@ type TextDocumentClientCapabilities struct {
 	// Capabilities specific to the various call hierarchy requests.
 	// 
 	// @since 3.16.0
-	CallHierarchy *CallHierarchyClientCapabilities `json:"callHierarchy,omitzero"`
+	CallHierarchy *CallHierarchyClientCapabilities `json:"callHierarchy,omitzero" since:"3.16.0"`
 	// Capabilities specific to the `textDocument/codeAction` request.
 	CodeAction *CodeActionClientCapabilities `json:"codeAction,omitzero"`
 	// Capabilities specific to the `textDocument/codeLens` request.
@@ -10756,19 +10756,19 @@ type TextDocumentClientCapabilities struct {
 	// `textDocument/colorPresentation` request.
 	// 
 	// @since 3.6.0
-	ColorProvider *DocumentColorClientCapabilities `json:"colorProvider,omitzero"`
+	ColorProvider *DocumentColorClientCapabilities `json:"colorProvider,omitzero" since:"3.6.0"`
 	// Capabilities specific to the `textDocument/completion` request.
 	Completion *CompletionClientCapabilities `json:"completion,omitzero"`
 	// Capabilities specific to the `textDocument/declaration` request.
 	// 
 	// @since 3.14.0
-	Declaration *DeclarationClientCapabilities `json:"declaration,omitzero"`
+	Declaration *DeclarationClientCapabilities `json:"declaration,omitzero" since:"3.14.0"`
 	// Capabilities specific to the `textDocument/definition` request.
 	Definition *DefinitionClientCapabilities `json:"definition,omitzero"`
 	// Capabilities specific to the diagnostic pull model.
 	// 
 	// @since 3.17.0
-	Diagnostic *DiagnosticClientCapabilities `json:"diagnostic,omitzero"`
+	Diagnostic *DiagnosticClientCapabilities `json:"diagnostic,omitzero" since:"3.17.0"`
 	// Capabilities specific to the `textDocument/documentHighlight` request.
 	DocumentHighlight *DocumentHighlightClientCapabilities `json:"documentHighlight,omitzero"`
 	// Capabilities specific to the `textDocument/documentLink` request.
@@ -10778,11 +10778,11 @@ type TextDocumentClientCapabilities struct {
 	// Defines which filters the client supports.
 	// 
 	// @since 3.18.0
-	Filters *TextDocumentFilterClientCapabilities `json:"filters,omitzero"`
+	Filters *TextDocumentFilterClientCapabilities `json:"filters,omitzero" since:"3.18.0"`
 	// Capabilities specific to the `textDocument/foldingRange` request.
 	// 
 	// @since 3.10.0
-	FoldingRange *FoldingRangeClientCapabilities `json:"foldingRange,omitzero"`
+	FoldingRange *FoldingRangeClientCapabilities `json:"foldingRange,omitzero" since:"3.10.0"`
 	// Capabilities specific to the `textDocument/formatting` request.
 	Formatting *DocumentFormattingClientCapabilities `json:"formatting,omitzero"`
 	// Capabilities specific to the `textDocument/hover` request.
@@ -10790,28 +10790,28 @@ type TextDocumentClientCapabilities struct {
 	// Capabilities specific to the `textDocument/implementation` request.
 	// 
 	// @since 3.6.0
-	Implementation *ImplementationClientCapabilities `json:"implementation,omitzero"`
+	Implementation *ImplementationClientCapabilities `json:"implementation,omitzero" since:"3.6.0"`
 	// Capabilities specific to the `textDocument/inlayHint` request.
 	// 
 	// @since 3.17.0
-	InlayHint *InlayHintClientCapabilities `json:"inlayHint,omitzero"`
+	InlayHint *InlayHintClientCapabilities `json:"inlayHint,omitzero" since:"3.17.0"`
 	// Client capabilities specific to inline completions.
 	// 
 	// @since 3.18.0
 	// @proposed
-	InlineCompletion *InlineCompletionClientCapabilities `json:"inlineCompletion,omitzero"`
+	InlineCompletion *InlineCompletionClientCapabilities `json:"inlineCompletion,omitzero" since:"3.18.0" proposed:"true"`
 	// Capabilities specific to the `textDocument/inlineValue` request.
 	// 
 	// @since 3.17.0
-	InlineValue *InlineValueClientCapabilities `json:"inlineValue,omitzero"`
+	InlineValue *InlineValueClientCapabilities `json:"inlineValue,omitzero" since:"3.17.0"`
 	// Capabilities specific to the `textDocument/linkedEditingRange` request.
 	// 
 	// @since 3.16.0
-	LinkedEditingRange *LinkedEditingRangeClientCapabilities `json:"linkedEditingRange,omitzero"`
+	LinkedEditingRange *LinkedEditingRangeClientCapabilities `json:"linkedEditingRange,omitzero" since:"3.16.0"`
 	// Client capabilities specific to the `textDocument/moniker` request.
 	// 
 	// @since 3.16.0
-	Moniker *MonikerClientCapabilities `json:"moniker,omitzero"`
+	Moniker *MonikerClientCapabilities `json:"moniker,omitzero" since:"3.16.0"`
 	// Capabilities specific to the `textDocument/onTypeFormatting` request.
 	OnTypeFormatting *DocumentOnTypeFormattingClientCapabilities `json:"onTypeFormatting,omitzero"`
 	// Capabilities specific to the `textDocument/publishDiagnostics` notification.
@@ -10825,11 +10825,11 @@ type TextDocumentClientCapabilities struct {
 	// Capabilities specific to the `textDocument/selectionRange` request.
 	// 
 	// @since 3.15.0
-	SelectionRange *SelectionRangeClientCapabilities `json:"selectionRange,omitzero"`
+	SelectionRange *SelectionRangeClientCapabilities `json:"selectionRange,omitzero" since:"3.15.0"`
 	// Capabilities specific to the various semantic token request.
 	// 
 	// @since 3.16.0
-	SemanticTokens *SemanticTokensClientCapabilities `json:"semanticTokens,omitzero"`
+	SemanticTokens *SemanticTokensClientCapabilities `json:"semanticTokens,omitzero" since:"3.16.0"`
 	// Capabilities specific to the `textDocument/signatureHelp` request.
 	SignatureHelp *SignatureHelpClientCapabilities `json:"signatureHelp,omitzero"`
 	// Defines which synchronization capabilities the client supports.
@@ -10837,11 +10837,11 @@ type TextDocumentClientCapabilities struct {
 	// Capabilities specific to the `textDocument/typeDefinition` request.
 	// 
 	// @since 3.6.0
-	TypeDefinition *TypeDefinitionClientCapabilities `json:"typeDefinition,omitzero"`
+	TypeDefinition *TypeDefinitionClientCapabilities `json:"typeDefinition,omitzero" since:"3.6.0"`
 	// Capabilities specific to the various type hierarchy requests.
 	// 
 	// @since 3.17.0
-	TypeHierarchy *TypeHierarchyClientCapabilities `json:"typeHierarchy,omitzero"`
+	TypeHierarchy *TypeHierarchyClientCapabilities `json:"typeHierarchy,omitzero" since:"3.17.0"`
 }
 func (t *TextDocumentClientCapabilities) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -11126,7 +11126,7 @@ type TextDocumentFilterClientCapabilities struct {
 	// The client supports Relative Patterns.
 	// 
 	// @since 3.18.0
-	RelativePatternSupport bool `json:"relativePatternSupport,omitempty"`
+	RelativePatternSupport bool `json:"relativePatternSupport,omitempty" since:"3.18.0"`
 }
 func (t *TextDocumentFilterClientCapabilities) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -12019,11 +12019,11 @@ type WindowClientCapabilities struct {
 	// Capabilities specific to the showDocument request.
 	// 
 	// @since 3.16.0
-	ShowDocument *ShowDocumentClientCapabilities `json:"showDocument,omitzero"`
+	ShowDocument *ShowDocumentClientCapabilities `json:"showDocument,omitzero" since:"3.16.0"`
 	// Capabilities specific to the showMessage request.
 	// 
 	// @since 3.16.0
-	ShowMessage *ShowMessageRequestClientCapabilities `json:"showMessage,omitzero"`
+	ShowMessage *ShowMessageRequestClientCapabilities `json:"showMessage,omitzero" since:"3.16.0"`
 	// It indicates whether the client supports server initiated
 	// progress using the `window/workDoneProgress/create` request.
 	// 
@@ -12033,7 +12033,7 @@ type WindowClientCapabilities struct {
 	// capabilities.
 	// 
 	// @since 3.15.0
-	WorkDoneProgress bool `json:"workDoneProgress,omitempty"`
+	WorkDoneProgress bool `json:"workDoneProgress,omitempty" since:"3.15.0"`
 }
 func (t *WindowClientCapabilities) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -12272,16 +12272,16 @@ type WorkspaceClientCapabilities struct {
 	// workspace.
 	// 
 	// @since 3.16.0.
-	CodeLens *CodeLensWorkspaceClientCapabilities `json:"codeLens,omitzero"`
+	CodeLens *CodeLensWorkspaceClientCapabilities `json:"codeLens,omitzero" since:"3.16.0"`
 	// The client supports `workspace/configuration` requests.
 	// 
 	// @since 3.6.0
-	Configuration bool `json:"configuration,omitempty"`
+	Configuration bool `json:"configuration,omitempty" since:"3.6.0"`
 	// Capabilities specific to the diagnostic requests scoped to the
 	// workspace.
 	// 
 	// @since 3.17.0.
-	Diagnostics *DiagnosticWorkspaceClientCapabilities `json:"diagnostics,omitzero"`
+	Diagnostics *DiagnosticWorkspaceClientCapabilities `json:"diagnostics,omitzero" since:"3.17.0"`
 	// Capabilities specific to the `workspace/didChangeConfiguration` notification.
 	DidChangeConfiguration *DidChangeConfigurationClientCapabilities `json:"didChangeConfiguration,omitzero"`
 	// Capabilities specific to the `workspace/didChangeWatchedFiles` notification.
@@ -12296,35 +12296,35 @@ type WorkspaceClientCapabilities struct {
 	// 
 	// @since 3.18.0
 	// @proposed
-	FoldingRange *FoldingRangeWorkspaceClientCapabilities `json:"foldingRange,omitzero"`
+	FoldingRange *FoldingRangeWorkspaceClientCapabilities `json:"foldingRange,omitzero" since:"3.18.0" proposed:"true"`
 	// Capabilities specific to the inlay hint requests scoped to the
 	// workspace.
 	// 
 	// @since 3.17.0.
-	InlayHint *InlayHintWorkspaceClientCapabilities `json:"inlayHint,omitzero"`
+	InlayHint *InlayHintWorkspaceClientCapabilities `json:"inlayHint,omitzero" since:"3.17.0"`
 	// Capabilities specific to the inline values requests scoped to the
 	// workspace.
 	// 
 	// @since 3.17.0.
-	InlineValue *InlineValueWorkspaceClientCapabilities `json:"inlineValue,omitzero"`
+	InlineValue *InlineValueWorkspaceClientCapabilities `json:"inlineValue,omitzero" since:"3.17.0"`
 	// Capabilities specific to the semantic token requests scoped to the
 	// workspace.
 	// 
 	// @since 3.16.0.
-	SemanticTokens *SemanticTokensWorkspaceClientCapabilities `json:"semanticTokens,omitzero"`
+	SemanticTokens *SemanticTokensWorkspaceClientCapabilities `json:"semanticTokens,omitzero" since:"3.16.0"`
 	// Capabilities specific to the `workspace/symbol` request.
 	Symbol *WorkspaceSymbolClientCapabilities `json:"symbol,omitzero"`
 	// Capabilities specific to the `workspace/textDocumentContent` request.
 	// 
 	// @since 3.18.0
 	// @proposed
-	TextDocumentContent *TextDocumentContentClientCapabilities `json:"textDocumentContent,omitzero"`
+	TextDocumentContent *TextDocumentContentClientCapabilities `json:"textDocumentContent,omitzero" since:"3.18.0" proposed:"true"`
 	// Capabilities specific to `WorkspaceEdit`s.
 	WorkspaceEdit *WorkspaceEditClientCapabilities `json:"workspaceEdit,omitzero"`
 	// The client has support for workspace folders.
 	// 
 	// @since 3.6.0
-	WorkspaceFolders bool `json:"workspaceFolders,omitempty"`
+	WorkspaceFolders bool `json:"workspaceFolders,omitempty" since:"3.6.0"`
 }
 func (t *WorkspaceClientCapabilities) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -12448,7 +12448,7 @@ type WorkspaceEdit struct {
 	// Whether clients honor this property depends on the client capability `workspace.changeAnnotationSupport`.
 	// 
 	// @since 3.16.0
-	ChangeAnnotations map[ChangeAnnotationIdentifier]ChangeAnnotation `json:"changeAnnotations,omitzero"`
+	ChangeAnnotations map[ChangeAnnotationIdentifier]ChangeAnnotation `json:"changeAnnotations,omitzero" since:"3.16.0"`
 	// Holds changes to existing resources.
 	Changes map[DocumentUri][]TextEdit `json:"changes,omitzero"`
 	// Depending on the client capability `workspace.workspaceEdit.resourceOperations` document changes
@@ -12486,19 +12486,19 @@ type WorkspaceEditClientCapabilities struct {
 	// create file, rename file and delete file changes.
 	// 
 	// @since 3.16.0
-	ChangeAnnotationSupport *ChangeAnnotationsSupportOptions `json:"changeAnnotationSupport,omitzero"`
+	ChangeAnnotationSupport *ChangeAnnotationsSupportOptions `json:"changeAnnotationSupport,omitzero" since:"3.16.0"`
 	// The client supports versioned document changes in `WorkspaceEdit`s
 	DocumentChanges bool `json:"documentChanges,omitempty"`
 	// The failure handling strategy of a client if applying the workspace edit
 	// fails.
 	// 
 	// @since 3.13.0
-	FailureHandling *FailureHandlingKind `json:"failureHandling,omitzero"`
+	FailureHandling *FailureHandlingKind `json:"failureHandling,omitzero" since:"3.13.0"`
 	// Whether the client supports `WorkspaceEditMetadata` in `WorkspaceEdit`s.
 	// 
 	// @since 3.18.0
 	// @proposed
-	MetadataSupport bool `json:"metadataSupport,omitempty"`
+	MetadataSupport bool `json:"metadataSupport,omitempty" since:"3.18.0" proposed:"true"`
 	// Whether the client normalizes line endings to the client specific
 	// setting.
 	// If set to `true` the client will normalize line ending characters
@@ -12506,17 +12506,17 @@ type WorkspaceEditClientCapabilities struct {
 	// character.
 	// 
 	// @since 3.16.0
-	NormalizesLineEndings bool `json:"normalizesLineEndings,omitempty"`
+	NormalizesLineEndings bool `json:"normalizesLineEndings,omitempty" since:"3.16.0"`
 	// The resource operations the client supports. Clients should at least
 	// support 'create', 'rename' and 'delete' files and folders.
 	// 
 	// @since 3.13.0
-	ResourceOperations []ResourceOperationKind `json:"resourceOperations,omitzero"`
+	ResourceOperations []ResourceOperationKind `json:"resourceOperations,omitzero" since:"3.13.0"`
 	// Whether the client supports snippets as text edits.
 	// 
 	// @since 3.18.0
 	// @proposed
-	SnippetEditSupport bool `json:"snippetEditSupport,omitempty"`
+	SnippetEditSupport bool `json:"snippetEditSupport,omitempty" since:"3.18.0" proposed:"true"`
 }
 func (t *WorkspaceEditClientCapabilities) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -12628,7 +12628,7 @@ type WorkspaceFoldersInitializeParams struct {
 	// configured.
 	// 
 	// @since 3.6.0
-	WorkspaceFolders *[]WorkspaceFolder `json:"workspaceFolders,omitzero"`
+	WorkspaceFolders *[]WorkspaceFolder `json:"workspaceFolders,omitzero" since:"3.6.0"`
 }
 func (t *WorkspaceFoldersInitializeParams) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -12730,16 +12730,16 @@ type WorkspaceOptions struct {
 	// The server is interested in notifications/requests for operations on files.
 	// 
 	// @since 3.16.0
-	FileOperations *FileOperationOptions `json:"fileOperations,omitzero"`
+	FileOperations *FileOperationOptions `json:"fileOperations,omitzero" since:"3.16.0"`
 	// The server supports the `workspace/textDocumentContent` request.
 	// 
 	// @since 3.18.0
 	// @proposed
-	TextDocumentContent *Or2[TextDocumentContentOptions, TextDocumentContentRegistrationOptions] `json:"textDocumentContent,omitzero"`
+	TextDocumentContent *Or2[TextDocumentContentOptions, TextDocumentContentRegistrationOptions] `json:"textDocumentContent,omitzero" since:"3.18.0" proposed:"true"`
 	// The server supports workspace folder.
 	// 
 	// @since 3.6.0
-	WorkspaceFolders *WorkspaceFoldersServerCapabilities `json:"workspaceFolders,omitzero"`
+	WorkspaceFolders *WorkspaceFoldersServerCapabilities `json:"workspaceFolders,omitzero" since:"3.6.0"`
 }
 func (t *WorkspaceOptions) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -12785,7 +12785,7 @@ type WorkspaceSymbol struct {
 	// Tags for this symbol.
 	// 
 	// @since 3.16.0
-	Tags []SymbolTag `json:"tags,omitzero"`
+	Tags []SymbolTag `json:"tags,omitzero" since:"3.16.0"`
 }
 func (t *WorkspaceSymbol) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -12821,14 +12821,14 @@ type WorkspaceSymbolClientCapabilities struct {
 	// properties.
 	// 
 	// @since 3.17.0
-	ResolveSupport *ClientSymbolResolveOptions `json:"resolveSupport,omitzero"`
+	ResolveSupport *ClientSymbolResolveOptions `json:"resolveSupport,omitzero" since:"3.17.0"`
 	// Specific capabilities for the `SymbolKind` in the `workspace/symbol` request.
 	SymbolKind *ClientSymbolKindOptions `json:"symbolKind,omitzero"`
 	// The client supports tags on `SymbolInformation`.
 	// Clients supporting tags have to handle unknown tags gracefully.
 	// 
 	// @since 3.16.0
-	TagSupport *ClientSymbolTagOptions `json:"tagSupport,omitzero"`
+	TagSupport *ClientSymbolTagOptions `json:"tagSupport,omitzero" since:"3.16.0"`
 }
 func (t *WorkspaceSymbolClientCapabilities) UnmarshalJSON(x []byte) error {
 	var m map[string]any
@@ -12853,7 +12853,7 @@ type WorkspaceSymbolOptions struct {
 	// information for a workspace symbol.
 	// 
 	// @since 3.17.0
-	ResolveProvider bool `json:"resolveProvider,omitempty"`
+	ResolveProvider bool `json:"resolveProvider,omitempty" since:"3.17.0"`
 
 	WorkDoneProgress bool `json:"workDoneProgress,omitempty"`
 }
@@ -12916,7 +12916,7 @@ type WorkspaceSymbolRegistrationOptions struct {
 	// information for a workspace symbol.
 	// 
 	// @since 3.17.0
-	ResolveProvider bool `json:"resolveProvider,omitempty"`
+	ResolveProvider bool `json:"resolveProvider,omitempty" since:"3.17.0"`
 
 	WorkDoneProgress bool `json:"workDoneProgress,omitempty"`
 }
@@ -12990,7 +12990,7 @@ type _InitializeParams struct {
 	// Information about the client
 	// 
 	// @since 3.15.0
-	ClientInfo *ClientInfo `json:"clientInfo,omitzero"`
+	ClientInfo *ClientInfo `json:"clientInfo,omitzero" since:"3.15.0"`
 	// User provided initialization options.
 	InitializationOptions any `json:"initializationOptions,omitempty"`
 	// The locale the client is currently showing the user interface
@@ -13001,7 +13001,7 @@ type _InitializeParams struct {
 	// (See https://en.wikipedia.org/wiki/IETF_language_tag)
 	// 
 	// @since 3.16.0
-	Locale string `json:"locale,omitempty"`
+	Locale string `json:"locale,omitempty" since:"3.16.0"`
 	// The process Id of the parent process that started
 	// the server.
 	// 
@@ -1,56 +1,182 @@
 package protocol
 
 import (
+	"bufio"
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"strconv"
 )
 
+// The largest message NewScanner will read.
+const MaxMessageSize = 64 * 1024 * 1024
+
+// DecodeError is returned when a message can't be decoded. Code is the jsonrpc
+// error code the message should be answered with.
+type DecodeError struct {
+	Code ErrorCodes
+	// The id of the message, or nil if it has none or it could not be read.
+	ID  json.RawMessage
+	Err error
+}
+
+func (e *DecodeError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
 // Helper function that takes in a full jsonrpc message, and returns
-// the corresponding message struct
-func DecodeMessage(message []byte) (Message, error) {
+// the corresponding message struct. Messages that can't be decoded
+// return a *DecodeError with one of the following codes:
+//   - ErrorCodesParseError for malformed framing or json
+//   - ErrorCodesInvalidRequest for json that isn't a jsonrpc request or notification
+//   - ErrorCodesMethodNotFound for methods missing from MessageRegistry and extensions
+//   - ErrorCodesInvalidParams for params that don't match the method
+func DecodeMessage(message []byte, extensions ...Extension) (Message, error) {
 	_, contentLength, content, err := SplitMessage(message)
 
 	if err != nil {
-		return nil, err
+		return nil, &DecodeError{Code: ErrorCodesParseError, Err: err}
 	}
-	var temp map[string]any
+	if len(content) < contentLength {
+		return nil, &DecodeError{Code: ErrorCodesParseError, Err: fmt.Errorf("message content is shorter than its content length")}
+	}
+	content = content[:contentLength]
+
+	wire, err := decodeEnvelope(content)
 
-	if err := json.Unmarshal(content[:contentLength], &temp); err != nil {
+	if err != nil {
 		return nil, err
 	}
 
-	method, exists := temp["method"]
+	if wire.Method == "" {
+		return nil, &DecodeError{Code: ErrorCodesInvalidRequest, ID: wire.ID, Err: fmt.Errorf("missing method")}
+	}
+
+	return decodeIncoming(wire, content, extensionsByMethod(extensions))
+}
+
+// Decodes the jsonrpc envelope of a message, and checks it is a valid request,
+// notification or response.
+func decodeEnvelope(content []byte) (wireMessage, error) {
+	var wire wireMessage
+
+	if !json.Valid(content) {
+		return wire, &DecodeError{Code: ErrorCodesParseError, Err: fmt.Errorf("invalid json")}
+	}
+
+	if err := json.Unmarshal(content, &wire); err != nil {
+		return wireMessage{}, &DecodeError{Code: ErrorCodesInvalidRequest, Err: fmt.Errorf("invalid message: %w", err)}
+	}
+
+	if string(wire.ID) == "null" {
+		wire.ID = nil
+	}
+
+	if len(wire.ID) > 0 {
+		var id any
+
+		if err := json.Unmarshal(wire.ID, &id); err != nil {
+			return wireMessage{}, &DecodeError{Code: ErrorCodesInvalidRequest, Err: err}
+		}
+
+		switch id.(type) {
+		case string, float64:
+		default:
+			return wireMessage{}, &DecodeError{Code: ErrorCodesInvalidRequest, Err: fmt.Errorf("invalid id: %s", wire.ID)}
+		}
+	}
+
+	if wire.JsonRPC != "2.0" {
+		return wireMessage{}, &DecodeError{Code: ErrorCodesInvalidRequest, ID: wire.ID, Err: fmt.Errorf("invalid jsonrpc version: %q", wire.JsonRPC)}
+	}
+
+	// Anything without a method must be a response, which has a result or an error.
+	if wire.Method == "" && len(wire.Result) == 0 && wire.Error == nil {
+		return wireMessage{}, &DecodeError{Code: ErrorCodesInvalidRequest, ID: wire.ID, Err: fmt.Errorf("missing method")}
+	}
+
+	return wire, nil
+}
+
+// Decodes a request or notification into its message struct.
+func decodeIncoming(wire wireMessage, content []byte, extensions map[string]Extension) (Message, error) {
+	decode, exists := MessageRegistry[wire.Method]
+
+	if extension, isExtension := extensions[wire.Method]; !exists && isExtension {
+		decode, exists = extension.decode, true
+	}
 
 	if !exists {
-		return nil, fmt.Errorf("Missing method from request")
+		return nil, &DecodeError{Code: ErrorCodesMethodNotFound, ID: wire.ID, Err: fmt.Errorf("method not found: %s", wire.Method)}
+	}
+
+	message, err := decode(content)
+
+	if err != nil {
+		return nil, &DecodeError{Code: ErrorCodesInvalidParams, ID: wire.ID, Err: err}
 	}
 
-	result, err := MessageRegistry[method.(string)](content)
+	return message, nil
+}
+
+// Helper function that takes in a message and returns the full jsonrpc
+// message, including the Content-Length header
+func EncodeMessage(message any) ([]byte, error) {
+	content, err := json.Marshal(message)
 
 	if err != nil {
 		return nil, err
 	}
 
-	return result, nil
+	header := "Content-Length: " + strconv.Itoa(len(content)) + "\r\n\r\n"
+
+	return append([]byte(header), content...), nil
 }
 
 // Helper function that can be used as a split function in a scanner
 func Split(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	if atEOF && len(data) == 0 {
+		return 0, nil, nil
+	}
+
+	if !bytes.Contains(data, []byte{'\r', '\n', '\r', '\n'}) {
+		if atEOF {
+			return 0, nil, io.ErrUnexpectedEOF
+		}
+		return 0, nil, nil
+	}
+
 	totalLength, contentLength, content, err := SplitMessage(data)
 
 	if err != nil {
 		return 0, nil, err
 	}
 
-	if contentLength < len(content) {
+	if len(content) < contentLength {
+		if atEOF {
+			return 0, nil, io.ErrUnexpectedEOF
+		}
 		return 0, nil, nil
 	}
 
 	return totalLength, data[:totalLength], nil
 }
 
+// Helper function that returns a scanner which splits r into full jsonrpc
+// messages, allowing messages up to MaxMessageSize
+func NewScanner(r io.Reader) *bufio.Scanner {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), MaxMessageSize)
+	scanner.Split(Split)
+
+	return scanner
+}
+
 // Helper function that produces a ResponseError
 func Error(code int32, err error) ResponseError {
 	return ResponseError{
@@ -59,6 +185,11 @@ func Error(code int32, err error) ResponseError {
 	}
 }
 
+// Allows a ResponseError to be returned as an error, e.g. from a Handler
+func (e ResponseError) Error() string {
+	return e.Message
+}
+
 // Helper function that takes in a jsonrpc message, and returns
 // the following:
 // [
@@ -82,12 +213,49 @@ func SplitMessage(data []byte) (int, int, []byte, error) {
 			continue
 		}
 
-		length, err := strconv.Atoi(string(header[len("Content-Length: "):]))
+		value, found := bytes.CutPrefix(header, []byte("Content-Length: "))
+		if !found {
+			return -1, -1, nil, fmt.Errorf("invalid header: %q", header)
+		}
+
+		length, err := strconv.Atoi(string(value))
 		if err != nil {
 			return -1, -1, nil, fmt.Errorf("invalid content length: %s", err)
 		}
 		contentLength = length
 	}
 
-	return len(data), contentLength, content, nil
+	return len(headerPart) + 4 + contentLength, contentLength, content, nil
+}
+
+// Helper function that encodes value as canonical, indented json, so equal
+// values always encode the same. Object keys are sorted, including the fields
+// of structs and the keys of maps such as WorkspaceEdit.Changes and LSPObject,
+// and numbers keep the representation they were encoded with.
+func MarshalCanonical(value any) ([]byte, error) {
+	content, err := json.Marshal(value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+
+	var generic any
+
+	if err := decoder.Decode(&generic); err != nil {
+		return nil, err
+	}
+
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(generic); err != nil {
+		return nil, err
+	}
+
+	return bytes.TrimSuffix(buffer.Bytes(), []byte("\n")), nil
 }
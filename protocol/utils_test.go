@@ -1,9 +1,14 @@
 package protocol
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"testing"
+	"testing/iotest"
 )
 
 func TestDecodeNotification(t *testing.T) {
@@ -37,3 +42,140 @@ func TestDecodeRequest(t *testing.T) {
 		t.Fatal("Expected WorkspaceDiagnosticRequest")
 	}
 }
+
+func TestDecodeMessageErrors(t *testing.T) {
+	cases := []struct {
+		content string
+		code    ErrorCodes
+		id      string
+	}{
+		{`{"jsonrpc": "2.0", "id": 1, "method": `, ErrorCodesParseError, ""},
+		{`[]`, ErrorCodesInvalidRequest, ""},
+		{`{"jsonrpc": "2.0", "id": 1}`, ErrorCodesInvalidRequest, "1"},
+		{`{"jsonrpc": "2.0", "id": {}, "method": "shutdown"}`, ErrorCodesInvalidRequest, ""},
+		{`{"jsonrpc": "1.0", "id": 1, "method": "shutdown"}`, ErrorCodesInvalidRequest, "1"},
+		{`{"jsonrpc": "2.0", "id": "a", "method": "acme/unknown"}`, ErrorCodesMethodNotFound, `"a"`},
+		{`{"jsonrpc": "2.0", "id": 2, "method": "textDocument/hover", "params": {}}`, ErrorCodesInvalidParams, "2"},
+	}
+
+	for _, c := range cases {
+		message := []byte("Content-Length: " + strconv.Itoa(len(c.content)) + "\r\n\r\n" + c.content)
+
+		_, err := DecodeMessage(message)
+
+		var decodeError *DecodeError
+		if !errors.As(err, &decodeError) {
+			t.Fatalf("%s: expected a DecodeError, got %v", c.content, err)
+		}
+		if decodeError.Code != c.code || string(decodeError.ID) != c.id {
+			t.Fatalf("%s: expected code %d and id %q, got %d and %q", c.content, c.code, c.id, decodeError.Code, decodeError.ID)
+		}
+	}
+}
+
+func TestScannerSplitsMultipleMessages(t *testing.T) {
+	var stream []byte
+
+	for i := range 3 {
+		message, err := EncodeMessage(map[string]any{"jsonrpc": "2.0", "id": i, "method": "shutdown"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		stream = append(stream, message...)
+	}
+
+	// Feed the stream one byte at a time, so the scanner sees partial headers and content.
+	scanner := NewScanner(iotest.OneByteReader(bytes.NewReader(stream)))
+	count := 0
+
+	for scanner.Scan() {
+		message, err := DecodeMessage(scanner.Bytes())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, check := message.(ShutdownRequest); !check {
+			t.Fatalf("Unexpected message type: %T", message)
+		}
+		count++
+	}
+
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	if count != 3 {
+		t.Fatalf("Expected 3 messages, got %d", count)
+	}
+}
+
+func TestScannerTruncatedMessage(t *testing.T) {
+	scanner := NewScanner(strings.NewReader("Content-Length: 20\r\n\r\n{}"))
+
+	if scanner.Scan() {
+		t.Fatal("Expected no message from a truncated stream")
+	}
+
+	if scanner.Err() == nil {
+		t.Fatal("Expected error from a truncated stream")
+	}
+}
+
+func TestScannerInvalidHeader(t *testing.T) {
+	scanner := NewScanner(strings.NewReader("X: 1\r\n\r\n{}"))
+
+	if scanner.Scan() {
+		t.Fatal("Expected no message from a stream with an invalid header")
+	}
+
+	if scanner.Err() == nil {
+		t.Fatal("Expected error from a stream with an invalid header")
+	}
+}
+
+func TestOrAliasMarshalsByValue(t *testing.T) {
+	// Aliases of Or types must marshal as their value even when they aren't
+	// addressable, e.g. inside a struct passed by value.
+	content, err := json.Marshal(ProgressParams{Token: ProgressToken{Value: "token"}, Value: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != `{"token":"token","value":1}` {
+		t.Fatalf("Expected the token to marshal as a string, got %s", content)
+	}
+}
+
+func TestMarshalCanonical(t *testing.T) {
+	edit := WorkspaceEdit{
+		Changes: map[DocumentUri][]TextEdit{
+			"file:///b.go": {{NewText: "<b>"}},
+			"file:///a.go": {{NewText: "a"}},
+		},
+	}
+	object := LSPObject{"z": 1.5, "a": LSPObject{"y": true, "b": nil}}
+
+	first, err := MarshalCanonical(edit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for range 10 {
+		again, _ := MarshalCanonical(edit)
+		if !bytes.Equal(first, again) {
+			t.Fatal("Expected the encoding to be deterministic")
+		}
+	}
+	if bytes.Index(first, []byte("a.go")) > bytes.Index(first, []byte("b.go")) || !bytes.Contains(first, []byte(`"<b>"`)) {
+		t.Fatalf("Expected sorted keys without escaping, got %s", first)
+	}
+	if bytes.Index(first, []byte(`"newText"`)) > bytes.Index(first, []byte(`"range"`)) {
+		t.Fatalf("Expected the fields of structs to be sorted, got %s", first)
+	}
+
+	content, err := MarshalCanonical(object)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "{\n  \"a\": {\n    \"b\": null,\n    \"y\": true\n  },\n  \"z\": 1.5\n}"
+	if string(content) != expected {
+		t.Fatalf("Expected %s, got %s", expected, content)
+	}
+}
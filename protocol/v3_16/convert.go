@@ -0,0 +1,806 @@
+package v3_16
+
+import "github.com/myleshyson/lsprotocol-go/protocol"
+
+// Converts a DocumentFilter of protocol 3.16 to the latest version.
+func DocumentFilterToLatest(value DocumentFilter) (protocol.DocumentFilter, error) {
+	return convert[protocol.DocumentFilter](value)
+}
+
+// Converts a DocumentFilter of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func DocumentFilterFromLatest(value protocol.DocumentFilter) (DocumentFilter, error) {
+	return convert[DocumentFilter](protocol.Downgrade(Features, value))
+}
+
+// Converts a DocumentSelector of protocol 3.16 to the latest version.
+func DocumentSelectorToLatest(value DocumentSelector) (protocol.DocumentSelector, error) {
+	return convert[protocol.DocumentSelector](value)
+}
+
+// Converts a DocumentSelector of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func DocumentSelectorFromLatest(value protocol.DocumentSelector) (DocumentSelector, error) {
+	return convert[DocumentSelector](protocol.Downgrade(Features, value))
+}
+
+// Converts a GlobPattern of protocol 3.16 to the latest version.
+func GlobPatternToLatest(value GlobPattern) (protocol.GlobPattern, error) {
+	return convert[protocol.GlobPattern](value)
+}
+
+// Converts a GlobPattern of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func GlobPatternFromLatest(value protocol.GlobPattern) (GlobPattern, error) {
+	return convert[GlobPattern](protocol.Downgrade(Features, value))
+}
+
+// Converts a TextDocumentFilter of protocol 3.16 to the latest version.
+func TextDocumentFilterToLatest(value TextDocumentFilter) (protocol.TextDocumentFilter, error) {
+	return convert[protocol.TextDocumentFilter](value)
+}
+
+// Converts a TextDocumentFilter of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func TextDocumentFilterFromLatest(value protocol.TextDocumentFilter) (TextDocumentFilter, error) {
+	return convert[TextDocumentFilter](protocol.Downgrade(Features, value))
+}
+
+// Converts a ApplyWorkspaceEditParams of protocol 3.16 to the latest version.
+func ApplyWorkspaceEditParamsToLatest(value ApplyWorkspaceEditParams) (protocol.ApplyWorkspaceEditParams, error) {
+	return convert[protocol.ApplyWorkspaceEditParams](value)
+}
+
+// Converts a ApplyWorkspaceEditParams of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func ApplyWorkspaceEditParamsFromLatest(value protocol.ApplyWorkspaceEditParams) (ApplyWorkspaceEditParams, error) {
+	return convert[ApplyWorkspaceEditParams](protocol.Downgrade(Features, value))
+}
+
+// Converts a CallHierarchyRegistrationOptions of protocol 3.16 to the latest version.
+func CallHierarchyRegistrationOptionsToLatest(value CallHierarchyRegistrationOptions) (protocol.CallHierarchyRegistrationOptions, error) {
+	return convert[protocol.CallHierarchyRegistrationOptions](value)
+}
+
+// Converts a CallHierarchyRegistrationOptions of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func CallHierarchyRegistrationOptionsFromLatest(value protocol.CallHierarchyRegistrationOptions) (CallHierarchyRegistrationOptions, error) {
+	return convert[CallHierarchyRegistrationOptions](protocol.Downgrade(Features, value))
+}
+
+// Converts a ClientCapabilities of protocol 3.16 to the latest version.
+func ClientCapabilitiesToLatest(value ClientCapabilities) (protocol.ClientCapabilities, error) {
+	return convert[protocol.ClientCapabilities](value)
+}
+
+// Converts a ClientCapabilities of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func ClientCapabilitiesFromLatest(value protocol.ClientCapabilities) (ClientCapabilities, error) {
+	return convert[ClientCapabilities](protocol.Downgrade(Features, value))
+}
+
+// Converts a ClientCompletionItemOptions of protocol 3.16 to the latest version.
+func ClientCompletionItemOptionsToLatest(value ClientCompletionItemOptions) (protocol.ClientCompletionItemOptions, error) {
+	return convert[protocol.ClientCompletionItemOptions](value)
+}
+
+// Converts a ClientCompletionItemOptions of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func ClientCompletionItemOptionsFromLatest(value protocol.ClientCompletionItemOptions) (ClientCompletionItemOptions, error) {
+	return convert[ClientCompletionItemOptions](protocol.Downgrade(Features, value))
+}
+
+// Converts a ClientSignatureInformationOptions of protocol 3.16 to the latest version.
+func ClientSignatureInformationOptionsToLatest(value ClientSignatureInformationOptions) (protocol.ClientSignatureInformationOptions, error) {
+	return convert[protocol.ClientSignatureInformationOptions](value)
+}
+
+// Converts a ClientSignatureInformationOptions of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func ClientSignatureInformationOptionsFromLatest(value protocol.ClientSignatureInformationOptions) (ClientSignatureInformationOptions, error) {
+	return convert[ClientSignatureInformationOptions](protocol.Downgrade(Features, value))
+}
+
+// Converts a CodeAction of protocol 3.16 to the latest version.
+func CodeActionToLatest(value CodeAction) (protocol.CodeAction, error) {
+	return convert[protocol.CodeAction](value)
+}
+
+// Converts a CodeAction of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func CodeActionFromLatest(value protocol.CodeAction) (CodeAction, error) {
+	return convert[CodeAction](protocol.Downgrade(Features, value))
+}
+
+// Converts a CodeActionClientCapabilities of protocol 3.16 to the latest version.
+func CodeActionClientCapabilitiesToLatest(value CodeActionClientCapabilities) (protocol.CodeActionClientCapabilities, error) {
+	return convert[protocol.CodeActionClientCapabilities](value)
+}
+
+// Converts a CodeActionClientCapabilities of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func CodeActionClientCapabilitiesFromLatest(value protocol.CodeActionClientCapabilities) (CodeActionClientCapabilities, error) {
+	return convert[CodeActionClientCapabilities](protocol.Downgrade(Features, value))
+}
+
+// Converts a CodeActionContext of protocol 3.16 to the latest version.
+func CodeActionContextToLatest(value CodeActionContext) (protocol.CodeActionContext, error) {
+	return convert[protocol.CodeActionContext](value)
+}
+
+// Converts a CodeActionContext of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func CodeActionContextFromLatest(value protocol.CodeActionContext) (CodeActionContext, error) {
+	return convert[CodeActionContext](protocol.Downgrade(Features, value))
+}
+
+// Converts a CodeActionOptions of protocol 3.16 to the latest version.
+func CodeActionOptionsToLatest(value CodeActionOptions) (protocol.CodeActionOptions, error) {
+	return convert[protocol.CodeActionOptions](value)
+}
+
+// Converts a CodeActionOptions of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func CodeActionOptionsFromLatest(value protocol.CodeActionOptions) (CodeActionOptions, error) {
+	return convert[CodeActionOptions](protocol.Downgrade(Features, value))
+}
+
+// Converts a CodeActionParams of protocol 3.16 to the latest version.
+func CodeActionParamsToLatest(value CodeActionParams) (protocol.CodeActionParams, error) {
+	return convert[protocol.CodeActionParams](value)
+}
+
+// Converts a CodeActionParams of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func CodeActionParamsFromLatest(value protocol.CodeActionParams) (CodeActionParams, error) {
+	return convert[CodeActionParams](protocol.Downgrade(Features, value))
+}
+
+// Converts a CodeActionRegistrationOptions of protocol 3.16 to the latest version.
+func CodeActionRegistrationOptionsToLatest(value CodeActionRegistrationOptions) (protocol.CodeActionRegistrationOptions, error) {
+	return convert[protocol.CodeActionRegistrationOptions](value)
+}
+
+// Converts a CodeActionRegistrationOptions of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func CodeActionRegistrationOptionsFromLatest(value protocol.CodeActionRegistrationOptions) (CodeActionRegistrationOptions, error) {
+	return convert[CodeActionRegistrationOptions](protocol.Downgrade(Features, value))
+}
+
+// Converts a CodeLens of protocol 3.16 to the latest version.
+func CodeLensToLatest(value CodeLens) (protocol.CodeLens, error) {
+	return convert[protocol.CodeLens](value)
+}
+
+// Converts a CodeLens of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func CodeLensFromLatest(value protocol.CodeLens) (CodeLens, error) {
+	return convert[CodeLens](protocol.Downgrade(Features, value))
+}
+
+// Converts a CodeLensClientCapabilities of protocol 3.16 to the latest version.
+func CodeLensClientCapabilitiesToLatest(value CodeLensClientCapabilities) (protocol.CodeLensClientCapabilities, error) {
+	return convert[protocol.CodeLensClientCapabilities](value)
+}
+
+// Converts a CodeLensClientCapabilities of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func CodeLensClientCapabilitiesFromLatest(value protocol.CodeLensClientCapabilities) (CodeLensClientCapabilities, error) {
+	return convert[CodeLensClientCapabilities](protocol.Downgrade(Features, value))
+}
+
+// Converts a CodeLensRegistrationOptions of protocol 3.16 to the latest version.
+func CodeLensRegistrationOptionsToLatest(value CodeLensRegistrationOptions) (protocol.CodeLensRegistrationOptions, error) {
+	return convert[protocol.CodeLensRegistrationOptions](value)
+}
+
+// Converts a CodeLensRegistrationOptions of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func CodeLensRegistrationOptionsFromLatest(value protocol.CodeLensRegistrationOptions) (CodeLensRegistrationOptions, error) {
+	return convert[CodeLensRegistrationOptions](protocol.Downgrade(Features, value))
+}
+
+// Converts a Command of protocol 3.16 to the latest version.
+func CommandToLatest(value Command) (protocol.Command, error) {
+	return convert[protocol.Command](value)
+}
+
+// Converts a Command of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func CommandFromLatest(value protocol.Command) (Command, error) {
+	return convert[Command](protocol.Downgrade(Features, value))
+}
+
+// Converts a CompletionClientCapabilities of protocol 3.16 to the latest version.
+func CompletionClientCapabilitiesToLatest(value CompletionClientCapabilities) (protocol.CompletionClientCapabilities, error) {
+	return convert[protocol.CompletionClientCapabilities](value)
+}
+
+// Converts a CompletionClientCapabilities of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func CompletionClientCapabilitiesFromLatest(value protocol.CompletionClientCapabilities) (CompletionClientCapabilities, error) {
+	return convert[CompletionClientCapabilities](protocol.Downgrade(Features, value))
+}
+
+// Converts a CompletionItem of protocol 3.16 to the latest version.
+func CompletionItemToLatest(value CompletionItem) (protocol.CompletionItem, error) {
+	return convert[protocol.CompletionItem](value)
+}
+
+// Converts a CompletionItem of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func CompletionItemFromLatest(value protocol.CompletionItem) (CompletionItem, error) {
+	return convert[CompletionItem](protocol.Downgrade(Features, value))
+}
+
+// Converts a CompletionList of protocol 3.16 to the latest version.
+func CompletionListToLatest(value CompletionList) (protocol.CompletionList, error) {
+	return convert[protocol.CompletionList](value)
+}
+
+// Converts a CompletionList of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func CompletionListFromLatest(value protocol.CompletionList) (CompletionList, error) {
+	return convert[CompletionList](protocol.Downgrade(Features, value))
+}
+
+// Converts a CompletionOptions of protocol 3.16 to the latest version.
+func CompletionOptionsToLatest(value CompletionOptions) (protocol.CompletionOptions, error) {
+	return convert[protocol.CompletionOptions](value)
+}
+
+// Converts a CompletionOptions of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func CompletionOptionsFromLatest(value protocol.CompletionOptions) (CompletionOptions, error) {
+	return convert[CompletionOptions](protocol.Downgrade(Features, value))
+}
+
+// Converts a CompletionRegistrationOptions of protocol 3.16 to the latest version.
+func CompletionRegistrationOptionsToLatest(value CompletionRegistrationOptions) (protocol.CompletionRegistrationOptions, error) {
+	return convert[protocol.CompletionRegistrationOptions](value)
+}
+
+// Converts a CompletionRegistrationOptions of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func CompletionRegistrationOptionsFromLatest(value protocol.CompletionRegistrationOptions) (CompletionRegistrationOptions, error) {
+	return convert[CompletionRegistrationOptions](protocol.Downgrade(Features, value))
+}
+
+// Converts a DeclarationRegistrationOptions of protocol 3.16 to the latest version.
+func DeclarationRegistrationOptionsToLatest(value DeclarationRegistrationOptions) (protocol.DeclarationRegistrationOptions, error) {
+	return convert[protocol.DeclarationRegistrationOptions](value)
+}
+
+// Converts a DeclarationRegistrationOptions of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func DeclarationRegistrationOptionsFromLatest(value protocol.DeclarationRegistrationOptions) (DeclarationRegistrationOptions, error) {
+	return convert[DeclarationRegistrationOptions](protocol.Downgrade(Features, value))
+}
+
+// Converts a DefinitionRegistrationOptions of protocol 3.16 to the latest version.
+func DefinitionRegistrationOptionsToLatest(value DefinitionRegistrationOptions) (protocol.DefinitionRegistrationOptions, error) {
+	return convert[protocol.DefinitionRegistrationOptions](value)
+}
+
+// Converts a DefinitionRegistrationOptions of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func DefinitionRegistrationOptionsFromLatest(value protocol.DefinitionRegistrationOptions) (DefinitionRegistrationOptions, error) {
+	return convert[DefinitionRegistrationOptions](protocol.Downgrade(Features, value))
+}
+
+// Converts a DidChangeWatchedFilesClientCapabilities of protocol 3.16 to the latest version.
+func DidChangeWatchedFilesClientCapabilitiesToLatest(value DidChangeWatchedFilesClientCapabilities) (protocol.DidChangeWatchedFilesClientCapabilities, error) {
+	return convert[protocol.DidChangeWatchedFilesClientCapabilities](value)
+}
+
+// Converts a DidChangeWatchedFilesClientCapabilities of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func DidChangeWatchedFilesClientCapabilitiesFromLatest(value protocol.DidChangeWatchedFilesClientCapabilities) (DidChangeWatchedFilesClientCapabilities, error) {
+	return convert[DidChangeWatchedFilesClientCapabilities](protocol.Downgrade(Features, value))
+}
+
+// Converts a DidChangeWatchedFilesRegistrationOptions of protocol 3.16 to the latest version.
+func DidChangeWatchedFilesRegistrationOptionsToLatest(value DidChangeWatchedFilesRegistrationOptions) (protocol.DidChangeWatchedFilesRegistrationOptions, error) {
+	return convert[protocol.DidChangeWatchedFilesRegistrationOptions](value)
+}
+
+// Converts a DidChangeWatchedFilesRegistrationOptions of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func DidChangeWatchedFilesRegistrationOptionsFromLatest(value protocol.DidChangeWatchedFilesRegistrationOptions) (DidChangeWatchedFilesRegistrationOptions, error) {
+	return convert[DidChangeWatchedFilesRegistrationOptions](protocol.Downgrade(Features, value))
+}
+
+// Converts a DocumentColorRegistrationOptions of protocol 3.16 to the latest version.
+func DocumentColorRegistrationOptionsToLatest(value DocumentColorRegistrationOptions) (protocol.DocumentColorRegistrationOptions, error) {
+	return convert[protocol.DocumentColorRegistrationOptions](value)
+}
+
+// Converts a DocumentColorRegistrationOptions of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func DocumentColorRegistrationOptionsFromLatest(value protocol.DocumentColorRegistrationOptions) (DocumentColorRegistrationOptions, error) {
+	return convert[DocumentColorRegistrationOptions](protocol.Downgrade(Features, value))
+}
+
+// Converts a DocumentFormattingRegistrationOptions of protocol 3.16 to the latest version.
+func DocumentFormattingRegistrationOptionsToLatest(value DocumentFormattingRegistrationOptions) (protocol.DocumentFormattingRegistrationOptions, error) {
+	return convert[protocol.DocumentFormattingRegistrationOptions](value)
+}
+
+// Converts a DocumentFormattingRegistrationOptions of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func DocumentFormattingRegistrationOptionsFromLatest(value protocol.DocumentFormattingRegistrationOptions) (DocumentFormattingRegistrationOptions, error) {
+	return convert[DocumentFormattingRegistrationOptions](protocol.Downgrade(Features, value))
+}
+
+// Converts a DocumentHighlightRegistrationOptions of protocol 3.16 to the latest version.
+func DocumentHighlightRegistrationOptionsToLatest(value DocumentHighlightRegistrationOptions) (protocol.DocumentHighlightRegistrationOptions, error) {
+	return convert[protocol.DocumentHighlightRegistrationOptions](value)
+}
+
+// Converts a DocumentHighlightRegistrationOptions of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func DocumentHighlightRegistrationOptionsFromLatest(value protocol.DocumentHighlightRegistrationOptions) (DocumentHighlightRegistrationOptions, error) {
+	return convert[DocumentHighlightRegistrationOptions](protocol.Downgrade(Features, value))
+}
+
+// Converts a DocumentLinkRegistrationOptions of protocol 3.16 to the latest version.
+func DocumentLinkRegistrationOptionsToLatest(value DocumentLinkRegistrationOptions) (protocol.DocumentLinkRegistrationOptions, error) {
+	return convert[protocol.DocumentLinkRegistrationOptions](value)
+}
+
+// Converts a DocumentLinkRegistrationOptions of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func DocumentLinkRegistrationOptionsFromLatest(value protocol.DocumentLinkRegistrationOptions) (DocumentLinkRegistrationOptions, error) {
+	return convert[DocumentLinkRegistrationOptions](protocol.Downgrade(Features, value))
+}
+
+// Converts a DocumentOnTypeFormattingRegistrationOptions of protocol 3.16 to the latest version.
+func DocumentOnTypeFormattingRegistrationOptionsToLatest(value DocumentOnTypeFormattingRegistrationOptions) (protocol.DocumentOnTypeFormattingRegistrationOptions, error) {
+	return convert[protocol.DocumentOnTypeFormattingRegistrationOptions](value)
+}
+
+// Converts a DocumentOnTypeFormattingRegistrationOptions of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func DocumentOnTypeFormattingRegistrationOptionsFromLatest(value protocol.DocumentOnTypeFormattingRegistrationOptions) (DocumentOnTypeFormattingRegistrationOptions, error) {
+	return convert[DocumentOnTypeFormattingRegistrationOptions](protocol.Downgrade(Features, value))
+}
+
+// Converts a DocumentRangeFormattingClientCapabilities of protocol 3.16 to the latest version.
+func DocumentRangeFormattingClientCapabilitiesToLatest(value DocumentRangeFormattingClientCapabilities) (protocol.DocumentRangeFormattingClientCapabilities, error) {
+	return convert[protocol.DocumentRangeFormattingClientCapabilities](value)
+}
+
+// Converts a DocumentRangeFormattingClientCapabilities of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func DocumentRangeFormattingClientCapabilitiesFromLatest(value protocol.DocumentRangeFormattingClientCapabilities) (DocumentRangeFormattingClientCapabilities, error) {
+	return convert[DocumentRangeFormattingClientCapabilities](protocol.Downgrade(Features, value))
+}
+
+// Converts a DocumentRangeFormattingOptions of protocol 3.16 to the latest version.
+func DocumentRangeFormattingOptionsToLatest(value DocumentRangeFormattingOptions) (protocol.DocumentRangeFormattingOptions, error) {
+	return convert[protocol.DocumentRangeFormattingOptions](value)
+}
+
+// Converts a DocumentRangeFormattingOptions of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func DocumentRangeFormattingOptionsFromLatest(value protocol.DocumentRangeFormattingOptions) (DocumentRangeFormattingOptions, error) {
+	return convert[DocumentRangeFormattingOptions](protocol.Downgrade(Features, value))
+}
+
+// Converts a DocumentRangeFormattingRegistrationOptions of protocol 3.16 to the latest version.
+func DocumentRangeFormattingRegistrationOptionsToLatest(value DocumentRangeFormattingRegistrationOptions) (protocol.DocumentRangeFormattingRegistrationOptions, error) {
+	return convert[protocol.DocumentRangeFormattingRegistrationOptions](value)
+}
+
+// Converts a DocumentRangeFormattingRegistrationOptions of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func DocumentRangeFormattingRegistrationOptionsFromLatest(value protocol.DocumentRangeFormattingRegistrationOptions) (DocumentRangeFormattingRegistrationOptions, error) {
+	return convert[DocumentRangeFormattingRegistrationOptions](protocol.Downgrade(Features, value))
+}
+
+// Converts a DocumentSymbolRegistrationOptions of protocol 3.16 to the latest version.
+func DocumentSymbolRegistrationOptionsToLatest(value DocumentSymbolRegistrationOptions) (protocol.DocumentSymbolRegistrationOptions, error) {
+	return convert[protocol.DocumentSymbolRegistrationOptions](value)
+}
+
+// Converts a DocumentSymbolRegistrationOptions of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func DocumentSymbolRegistrationOptionsFromLatest(value protocol.DocumentSymbolRegistrationOptions) (DocumentSymbolRegistrationOptions, error) {
+	return convert[DocumentSymbolRegistrationOptions](protocol.Downgrade(Features, value))
+}
+
+// Converts a FileSystemWatcher of protocol 3.16 to the latest version.
+func FileSystemWatcherToLatest(value FileSystemWatcher) (protocol.FileSystemWatcher, error) {
+	return convert[protocol.FileSystemWatcher](value)
+}
+
+// Converts a FileSystemWatcher of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func FileSystemWatcherFromLatest(value protocol.FileSystemWatcher) (FileSystemWatcher, error) {
+	return convert[FileSystemWatcher](protocol.Downgrade(Features, value))
+}
+
+// Converts a FoldingRange of protocol 3.16 to the latest version.
+func FoldingRangeToLatest(value FoldingRange) (protocol.FoldingRange, error) {
+	return convert[protocol.FoldingRange](value)
+}
+
+// Converts a FoldingRange of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func FoldingRangeFromLatest(value protocol.FoldingRange) (FoldingRange, error) {
+	return convert[FoldingRange](protocol.Downgrade(Features, value))
+}
+
+// Converts a FoldingRangeClientCapabilities of protocol 3.16 to the latest version.
+func FoldingRangeClientCapabilitiesToLatest(value FoldingRangeClientCapabilities) (protocol.FoldingRangeClientCapabilities, error) {
+	return convert[protocol.FoldingRangeClientCapabilities](value)
+}
+
+// Converts a FoldingRangeClientCapabilities of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func FoldingRangeClientCapabilitiesFromLatest(value protocol.FoldingRangeClientCapabilities) (FoldingRangeClientCapabilities, error) {
+	return convert[FoldingRangeClientCapabilities](protocol.Downgrade(Features, value))
+}
+
+// Converts a FoldingRangeRegistrationOptions of protocol 3.16 to the latest version.
+func FoldingRangeRegistrationOptionsToLatest(value FoldingRangeRegistrationOptions) (protocol.FoldingRangeRegistrationOptions, error) {
+	return convert[protocol.FoldingRangeRegistrationOptions](value)
+}
+
+// Converts a FoldingRangeRegistrationOptions of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func FoldingRangeRegistrationOptionsFromLatest(value protocol.FoldingRangeRegistrationOptions) (FoldingRangeRegistrationOptions, error) {
+	return convert[FoldingRangeRegistrationOptions](protocol.Downgrade(Features, value))
+}
+
+// Converts a GeneralClientCapabilities of protocol 3.16 to the latest version.
+func GeneralClientCapabilitiesToLatest(value GeneralClientCapabilities) (protocol.GeneralClientCapabilities, error) {
+	return convert[protocol.GeneralClientCapabilities](value)
+}
+
+// Converts a GeneralClientCapabilities of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func GeneralClientCapabilitiesFromLatest(value protocol.GeneralClientCapabilities) (GeneralClientCapabilities, error) {
+	return convert[GeneralClientCapabilities](protocol.Downgrade(Features, value))
+}
+
+// Converts a HoverRegistrationOptions of protocol 3.16 to the latest version.
+func HoverRegistrationOptionsToLatest(value HoverRegistrationOptions) (protocol.HoverRegistrationOptions, error) {
+	return convert[protocol.HoverRegistrationOptions](value)
+}
+
+// Converts a HoverRegistrationOptions of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func HoverRegistrationOptionsFromLatest(value protocol.HoverRegistrationOptions) (HoverRegistrationOptions, error) {
+	return convert[HoverRegistrationOptions](protocol.Downgrade(Features, value))
+}
+
+// Converts a ImplementationRegistrationOptions of protocol 3.16 to the latest version.
+func ImplementationRegistrationOptionsToLatest(value ImplementationRegistrationOptions) (protocol.ImplementationRegistrationOptions, error) {
+	return convert[protocol.ImplementationRegistrationOptions](value)
+}
+
+// Converts a ImplementationRegistrationOptions of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func ImplementationRegistrationOptionsFromLatest(value protocol.ImplementationRegistrationOptions) (ImplementationRegistrationOptions, error) {
+	return convert[ImplementationRegistrationOptions](protocol.Downgrade(Features, value))
+}
+
+// Converts a InitializeParams of protocol 3.16 to the latest version.
+func InitializeParamsToLatest(value InitializeParams) (protocol.InitializeParams, error) {
+	return convert[protocol.InitializeParams](value)
+}
+
+// Converts a InitializeParams of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func InitializeParamsFromLatest(value protocol.InitializeParams) (InitializeParams, error) {
+	return convert[InitializeParams](protocol.Downgrade(Features, value))
+}
+
+// Converts a InitializeResult of protocol 3.16 to the latest version.
+func InitializeResultToLatest(value InitializeResult) (protocol.InitializeResult, error) {
+	return convert[protocol.InitializeResult](value)
+}
+
+// Converts a InitializeResult of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func InitializeResultFromLatest(value protocol.InitializeResult) (InitializeResult, error) {
+	return convert[InitializeResult](protocol.Downgrade(Features, value))
+}
+
+// Converts a LinkedEditingRangeRegistrationOptions of protocol 3.16 to the latest version.
+func LinkedEditingRangeRegistrationOptionsToLatest(value LinkedEditingRangeRegistrationOptions) (protocol.LinkedEditingRangeRegistrationOptions, error) {
+	return convert[protocol.LinkedEditingRangeRegistrationOptions](value)
+}
+
+// Converts a LinkedEditingRangeRegistrationOptions of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func LinkedEditingRangeRegistrationOptionsFromLatest(value protocol.LinkedEditingRangeRegistrationOptions) (LinkedEditingRangeRegistrationOptions, error) {
+	return convert[LinkedEditingRangeRegistrationOptions](protocol.Downgrade(Features, value))
+}
+
+// Converts a MarkdownClientCapabilities of protocol 3.16 to the latest version.
+func MarkdownClientCapabilitiesToLatest(value MarkdownClientCapabilities) (protocol.MarkdownClientCapabilities, error) {
+	return convert[protocol.MarkdownClientCapabilities](value)
+}
+
+// Converts a MarkdownClientCapabilities of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func MarkdownClientCapabilitiesFromLatest(value protocol.MarkdownClientCapabilities) (MarkdownClientCapabilities, error) {
+	return convert[MarkdownClientCapabilities](protocol.Downgrade(Features, value))
+}
+
+// Converts a MonikerRegistrationOptions of protocol 3.16 to the latest version.
+func MonikerRegistrationOptionsToLatest(value MonikerRegistrationOptions) (protocol.MonikerRegistrationOptions, error) {
+	return convert[protocol.MonikerRegistrationOptions](value)
+}
+
+// Converts a MonikerRegistrationOptions of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func MonikerRegistrationOptionsFromLatest(value protocol.MonikerRegistrationOptions) (MonikerRegistrationOptions, error) {
+	return convert[MonikerRegistrationOptions](protocol.Downgrade(Features, value))
+}
+
+// Converts a ReferenceRegistrationOptions of protocol 3.16 to the latest version.
+func ReferenceRegistrationOptionsToLatest(value ReferenceRegistrationOptions) (protocol.ReferenceRegistrationOptions, error) {
+	return convert[protocol.ReferenceRegistrationOptions](value)
+}
+
+// Converts a ReferenceRegistrationOptions of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func ReferenceRegistrationOptionsFromLatest(value protocol.ReferenceRegistrationOptions) (ReferenceRegistrationOptions, error) {
+	return convert[ReferenceRegistrationOptions](protocol.Downgrade(Features, value))
+}
+
+// Converts a RenameRegistrationOptions of protocol 3.16 to the latest version.
+func RenameRegistrationOptionsToLatest(value RenameRegistrationOptions) (protocol.RenameRegistrationOptions, error) {
+	return convert[protocol.RenameRegistrationOptions](value)
+}
+
+// Converts a RenameRegistrationOptions of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func RenameRegistrationOptionsFromLatest(value protocol.RenameRegistrationOptions) (RenameRegistrationOptions, error) {
+	return convert[RenameRegistrationOptions](protocol.Downgrade(Features, value))
+}
+
+// Converts a SelectionRangeRegistrationOptions of protocol 3.16 to the latest version.
+func SelectionRangeRegistrationOptionsToLatest(value SelectionRangeRegistrationOptions) (protocol.SelectionRangeRegistrationOptions, error) {
+	return convert[protocol.SelectionRangeRegistrationOptions](value)
+}
+
+// Converts a SelectionRangeRegistrationOptions of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func SelectionRangeRegistrationOptionsFromLatest(value protocol.SelectionRangeRegistrationOptions) (SelectionRangeRegistrationOptions, error) {
+	return convert[SelectionRangeRegistrationOptions](protocol.Downgrade(Features, value))
+}
+
+// Converts a SemanticTokensClientCapabilities of protocol 3.16 to the latest version.
+func SemanticTokensClientCapabilitiesToLatest(value SemanticTokensClientCapabilities) (protocol.SemanticTokensClientCapabilities, error) {
+	return convert[protocol.SemanticTokensClientCapabilities](value)
+}
+
+// Converts a SemanticTokensClientCapabilities of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func SemanticTokensClientCapabilitiesFromLatest(value protocol.SemanticTokensClientCapabilities) (SemanticTokensClientCapabilities, error) {
+	return convert[SemanticTokensClientCapabilities](protocol.Downgrade(Features, value))
+}
+
+// Converts a SemanticTokensRegistrationOptions of protocol 3.16 to the latest version.
+func SemanticTokensRegistrationOptionsToLatest(value SemanticTokensRegistrationOptions) (protocol.SemanticTokensRegistrationOptions, error) {
+	return convert[protocol.SemanticTokensRegistrationOptions](value)
+}
+
+// Converts a SemanticTokensRegistrationOptions of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func SemanticTokensRegistrationOptionsFromLatest(value protocol.SemanticTokensRegistrationOptions) (SemanticTokensRegistrationOptions, error) {
+	return convert[SemanticTokensRegistrationOptions](protocol.Downgrade(Features, value))
+}
+
+// Converts a ServerCapabilities of protocol 3.16 to the latest version.
+func ServerCapabilitiesToLatest(value ServerCapabilities) (protocol.ServerCapabilities, error) {
+	return convert[protocol.ServerCapabilities](value)
+}
+
+// Converts a ServerCapabilities of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func ServerCapabilitiesFromLatest(value protocol.ServerCapabilities) (ServerCapabilities, error) {
+	return convert[ServerCapabilities](protocol.Downgrade(Features, value))
+}
+
+// Converts a SignatureHelpClientCapabilities of protocol 3.16 to the latest version.
+func SignatureHelpClientCapabilitiesToLatest(value SignatureHelpClientCapabilities) (protocol.SignatureHelpClientCapabilities, error) {
+	return convert[protocol.SignatureHelpClientCapabilities](value)
+}
+
+// Converts a SignatureHelpClientCapabilities of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func SignatureHelpClientCapabilitiesFromLatest(value protocol.SignatureHelpClientCapabilities) (SignatureHelpClientCapabilities, error) {
+	return convert[SignatureHelpClientCapabilities](protocol.Downgrade(Features, value))
+}
+
+// Converts a SignatureHelpRegistrationOptions of protocol 3.16 to the latest version.
+func SignatureHelpRegistrationOptionsToLatest(value SignatureHelpRegistrationOptions) (protocol.SignatureHelpRegistrationOptions, error) {
+	return convert[protocol.SignatureHelpRegistrationOptions](value)
+}
+
+// Converts a SignatureHelpRegistrationOptions of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func SignatureHelpRegistrationOptionsFromLatest(value protocol.SignatureHelpRegistrationOptions) (SignatureHelpRegistrationOptions, error) {
+	return convert[SignatureHelpRegistrationOptions](protocol.Downgrade(Features, value))
+}
+
+// Converts a TextDocumentChangeRegistrationOptions of protocol 3.16 to the latest version.
+func TextDocumentChangeRegistrationOptionsToLatest(value TextDocumentChangeRegistrationOptions) (protocol.TextDocumentChangeRegistrationOptions, error) {
+	return convert[protocol.TextDocumentChangeRegistrationOptions](value)
+}
+
+// Converts a TextDocumentChangeRegistrationOptions of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func TextDocumentChangeRegistrationOptionsFromLatest(value protocol.TextDocumentChangeRegistrationOptions) (TextDocumentChangeRegistrationOptions, error) {
+	return convert[TextDocumentChangeRegistrationOptions](protocol.Downgrade(Features, value))
+}
+
+// Converts a TextDocumentClientCapabilities of protocol 3.16 to the latest version.
+func TextDocumentClientCapabilitiesToLatest(value TextDocumentClientCapabilities) (protocol.TextDocumentClientCapabilities, error) {
+	return convert[protocol.TextDocumentClientCapabilities](value)
+}
+
+// Converts a TextDocumentClientCapabilities of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func TextDocumentClientCapabilitiesFromLatest(value protocol.TextDocumentClientCapabilities) (TextDocumentClientCapabilities, error) {
+	return convert[TextDocumentClientCapabilities](protocol.Downgrade(Features, value))
+}
+
+// Converts a TextDocumentEdit of protocol 3.16 to the latest version.
+func TextDocumentEditToLatest(value TextDocumentEdit) (protocol.TextDocumentEdit, error) {
+	return convert[protocol.TextDocumentEdit](value)
+}
+
+// Converts a TextDocumentEdit of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func TextDocumentEditFromLatest(value protocol.TextDocumentEdit) (TextDocumentEdit, error) {
+	return convert[TextDocumentEdit](protocol.Downgrade(Features, value))
+}
+
+// Converts a TextDocumentFilterLanguage of protocol 3.16 to the latest version.
+func TextDocumentFilterLanguageToLatest(value TextDocumentFilterLanguage) (protocol.TextDocumentFilterLanguage, error) {
+	return convert[protocol.TextDocumentFilterLanguage](value)
+}
+
+// Converts a TextDocumentFilterLanguage of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func TextDocumentFilterLanguageFromLatest(value protocol.TextDocumentFilterLanguage) (TextDocumentFilterLanguage, error) {
+	return convert[TextDocumentFilterLanguage](protocol.Downgrade(Features, value))
+}
+
+// Converts a TextDocumentFilterPattern of protocol 3.16 to the latest version.
+func TextDocumentFilterPatternToLatest(value TextDocumentFilterPattern) (protocol.TextDocumentFilterPattern, error) {
+	return convert[protocol.TextDocumentFilterPattern](value)
+}
+
+// Converts a TextDocumentFilterPattern of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func TextDocumentFilterPatternFromLatest(value protocol.TextDocumentFilterPattern) (TextDocumentFilterPattern, error) {
+	return convert[TextDocumentFilterPattern](protocol.Downgrade(Features, value))
+}
+
+// Converts a TextDocumentFilterScheme of protocol 3.16 to the latest version.
+func TextDocumentFilterSchemeToLatest(value TextDocumentFilterScheme) (protocol.TextDocumentFilterScheme, error) {
+	return convert[protocol.TextDocumentFilterScheme](value)
+}
+
+// Converts a TextDocumentFilterScheme of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func TextDocumentFilterSchemeFromLatest(value protocol.TextDocumentFilterScheme) (TextDocumentFilterScheme, error) {
+	return convert[TextDocumentFilterScheme](protocol.Downgrade(Features, value))
+}
+
+// Converts a TextDocumentRegistrationOptions of protocol 3.16 to the latest version.
+func TextDocumentRegistrationOptionsToLatest(value TextDocumentRegistrationOptions) (protocol.TextDocumentRegistrationOptions, error) {
+	return convert[protocol.TextDocumentRegistrationOptions](value)
+}
+
+// Converts a TextDocumentRegistrationOptions of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func TextDocumentRegistrationOptionsFromLatest(value protocol.TextDocumentRegistrationOptions) (TextDocumentRegistrationOptions, error) {
+	return convert[TextDocumentRegistrationOptions](protocol.Downgrade(Features, value))
+}
+
+// Converts a TextDocumentSaveRegistrationOptions of protocol 3.16 to the latest version.
+func TextDocumentSaveRegistrationOptionsToLatest(value TextDocumentSaveRegistrationOptions) (protocol.TextDocumentSaveRegistrationOptions, error) {
+	return convert[protocol.TextDocumentSaveRegistrationOptions](value)
+}
+
+// Converts a TextDocumentSaveRegistrationOptions of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func TextDocumentSaveRegistrationOptionsFromLatest(value protocol.TextDocumentSaveRegistrationOptions) (TextDocumentSaveRegistrationOptions, error) {
+	return convert[TextDocumentSaveRegistrationOptions](protocol.Downgrade(Features, value))
+}
+
+// Converts a TypeDefinitionRegistrationOptions of protocol 3.16 to the latest version.
+func TypeDefinitionRegistrationOptionsToLatest(value TypeDefinitionRegistrationOptions) (protocol.TypeDefinitionRegistrationOptions, error) {
+	return convert[protocol.TypeDefinitionRegistrationOptions](value)
+}
+
+// Converts a TypeDefinitionRegistrationOptions of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func TypeDefinitionRegistrationOptionsFromLatest(value protocol.TypeDefinitionRegistrationOptions) (TypeDefinitionRegistrationOptions, error) {
+	return convert[TypeDefinitionRegistrationOptions](protocol.Downgrade(Features, value))
+}
+
+// Converts a WorkspaceClientCapabilities of protocol 3.16 to the latest version.
+func WorkspaceClientCapabilitiesToLatest(value WorkspaceClientCapabilities) (protocol.WorkspaceClientCapabilities, error) {
+	return convert[protocol.WorkspaceClientCapabilities](value)
+}
+
+// Converts a WorkspaceClientCapabilities of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func WorkspaceClientCapabilitiesFromLatest(value protocol.WorkspaceClientCapabilities) (WorkspaceClientCapabilities, error) {
+	return convert[WorkspaceClientCapabilities](protocol.Downgrade(Features, value))
+}
+
+// Converts a WorkspaceEdit of protocol 3.16 to the latest version.
+func WorkspaceEditToLatest(value WorkspaceEdit) (protocol.WorkspaceEdit, error) {
+	return convert[protocol.WorkspaceEdit](value)
+}
+
+// Converts a WorkspaceEdit of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func WorkspaceEditFromLatest(value protocol.WorkspaceEdit) (WorkspaceEdit, error) {
+	return convert[WorkspaceEdit](protocol.Downgrade(Features, value))
+}
+
+// Converts a WorkspaceEditClientCapabilities of protocol 3.16 to the latest version.
+func WorkspaceEditClientCapabilitiesToLatest(value WorkspaceEditClientCapabilities) (protocol.WorkspaceEditClientCapabilities, error) {
+	return convert[protocol.WorkspaceEditClientCapabilities](value)
+}
+
+// Converts a WorkspaceEditClientCapabilities of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func WorkspaceEditClientCapabilitiesFromLatest(value protocol.WorkspaceEditClientCapabilities) (WorkspaceEditClientCapabilities, error) {
+	return convert[WorkspaceEditClientCapabilities](protocol.Downgrade(Features, value))
+}
+
+// Converts a WorkspaceOptions of protocol 3.16 to the latest version.
+func WorkspaceOptionsToLatest(value WorkspaceOptions) (protocol.WorkspaceOptions, error) {
+	return convert[protocol.WorkspaceOptions](value)
+}
+
+// Converts a WorkspaceOptions of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func WorkspaceOptionsFromLatest(value protocol.WorkspaceOptions) (WorkspaceOptions, error) {
+	return convert[WorkspaceOptions](protocol.Downgrade(Features, value))
+}
+
+// Converts a WorkspaceSymbolClientCapabilities of protocol 3.16 to the latest version.
+func WorkspaceSymbolClientCapabilitiesToLatest(value WorkspaceSymbolClientCapabilities) (protocol.WorkspaceSymbolClientCapabilities, error) {
+	return convert[protocol.WorkspaceSymbolClientCapabilities](value)
+}
+
+// Converts a WorkspaceSymbolClientCapabilities of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func WorkspaceSymbolClientCapabilitiesFromLatest(value protocol.WorkspaceSymbolClientCapabilities) (WorkspaceSymbolClientCapabilities, error) {
+	return convert[WorkspaceSymbolClientCapabilities](protocol.Downgrade(Features, value))
+}
+
+// Converts a WorkspaceSymbolOptions of protocol 3.16 to the latest version.
+func WorkspaceSymbolOptionsToLatest(value WorkspaceSymbolOptions) (protocol.WorkspaceSymbolOptions, error) {
+	return convert[protocol.WorkspaceSymbolOptions](value)
+}
+
+// Converts a WorkspaceSymbolOptions of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func WorkspaceSymbolOptionsFromLatest(value protocol.WorkspaceSymbolOptions) (WorkspaceSymbolOptions, error) {
+	return convert[WorkspaceSymbolOptions](protocol.Downgrade(Features, value))
+}
+
+// Converts a WorkspaceSymbolRegistrationOptions of protocol 3.16 to the latest version.
+func WorkspaceSymbolRegistrationOptionsToLatest(value WorkspaceSymbolRegistrationOptions) (protocol.WorkspaceSymbolRegistrationOptions, error) {
+	return convert[protocol.WorkspaceSymbolRegistrationOptions](value)
+}
+
+// Converts a WorkspaceSymbolRegistrationOptions of the latest version to protocol 3.16, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func WorkspaceSymbolRegistrationOptionsFromLatest(value protocol.WorkspaceSymbolRegistrationOptions) (WorkspaceSymbolRegistrationOptions, error) {
+	return convert[WorkspaceSymbolRegistrationOptions](protocol.Downgrade(Features, value))
+}
@@ -0,0 +1,4048 @@
+package v3_16
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+)
+
+type DocumentUri = protocol.DocumentUri
+
+type URI = protocol.URI
+
+// An identifier to refer to a change annotation stored with a workspace edit.
+type ChangeAnnotationIdentifier = protocol.ChangeAnnotationIdentifier
+
+// The declaration of a symbol representation as one or many {@link Location locations}.
+type Declaration = protocol.Declaration
+
+// Information about where a symbol is declared.
+//
+// Provides additional metadata over normal {@link Location location} declarations, including the range of
+// the declaring symbol.
+//
+// Servers should prefer returning `DeclarationLink` over `Declaration` if supported
+// by the client.
+type DeclarationLink = protocol.DeclarationLink
+
+// The definition of a symbol represented as one or many {@link Location locations}.
+// For most programming languages there is only one location at which a symbol is
+// defined.
+//
+// Servers should prefer returning `DefinitionLink` over `Definition` if supported
+// by the client.
+type Definition = protocol.Definition
+
+// Information about where a symbol is defined.
+//
+// Provides additional metadata over normal {@link Location location} definitions, including the range of
+// the defining symbol
+type DefinitionLink = protocol.DefinitionLink
+
+// A document filter describes a top level text document or
+// a notebook cell document.
+//
+// @since 3.17.0 - support for NotebookCellTextDocumentFilter.
+type DocumentFilter = TextDocumentFilter
+
+// A document selector is the combination of one or many document filters.
+//
+// @sample `let sel:DocumentSelector = [{ language: 'typescript' }, { language: 'json', pattern: '**∕tsconfig.json' }]`;
+//
+// The use of a string as a document filter is deprecated @since 3.16.0.
+type DocumentSelector []DocumentFilter
+
+// The glob pattern. Either a string pattern or a relative pattern.
+//
+// @since 3.17.0
+type GlobPattern = Pattern
+
+// The LSP any type.
+// Please note that strictly speaking a property with the value `undefined`
+// can't be converted into JSON preserving the property name. However for
+// convenience it is allowed and assumed that all these properties are
+// optional as well.
+// @since 3.17.0
+type LSPAny = protocol.LSPAny
+
+// LSP arrays.
+// @since 3.17.0
+type LSPArray = protocol.LSPArray
+
+// LSP object definition.
+// @since 3.17.0
+type LSPObject = protocol.LSPObject
+
+// MarkedString can be used to render human readable text. It is either a markdown string
+// or a code-block that provides a language and a code snippet. The language identifier
+// is semantically equal to the optional language identifier in fenced code blocks in GitHub
+// issues. See https://help.github.com/articles/creating-and-highlighting-code-blocks/#syntax-highlighting
+//
+// The pair of a language and a value is an equivalent to markdown:
+// ```${language}
+// ${value}
+// ```
+//
+// Note that markdown strings will be sanitized - that means html will be escaped.
+// @deprecated use MarkupContent instead.
+type MarkedString = protocol.MarkedString
+
+// The glob pattern to watch relative to the base path. Glob patterns can have the following syntax:
+// - `*` to match one or more characters in a path segment
+// - `?` to match on one character in a path segment
+// - `**` to match any number of path segments, including none
+// - `{}` to group conditions (e.g. `**​/*.{ts,js}` matches all TypeScript and JavaScript files)
+// - `[]` to declare a range of characters to match in a path segment (e.g., `example.[0-9]` to match on `example.0`, `example.1`, …)
+// - `[!...]` to negate a range of characters to match in a path segment (e.g., `example.[!0-9]` to match on `example.a`, `example.b`, but not `example.0`)
+//
+// @since 3.17.0
+type Pattern = protocol.Pattern
+
+type PrepareRenameResult = protocol.PrepareRenameResult
+
+type ProgressToken = protocol.ProgressToken
+
+type RegularExpressionEngineKind = protocol.RegularExpressionEngineKind
+
+// An event describing a change to a text document. If only a text is provided
+// it is considered to be the full content of the document.
+type TextDocumentContentChangeEvent = protocol.TextDocumentContentChangeEvent
+
+// A document filter denotes a document by different properties like
+// the {@link TextDocument.languageId language}, the {@link Uri.scheme scheme} of
+// its resource, or a glob-pattern that is applied to the {@link TextDocument.fileName path}.
+//
+// Glob patterns can have the following syntax:
+// - `*` to match one or more characters in a path segment
+// - `?` to match on one character in a path segment
+// - `**` to match any number of path segments, including none
+// - `{}` to group sub patterns into an OR expression. (e.g. `**​/*.{ts,js}` matches all TypeScript and JavaScript files)
+// - `[]` to declare a range of characters to match in a path segment (e.g., `example.[0-9]` to match on `example.0`, `example.1`, …)
+// - `[!...]` to negate a range of characters to match in a path segment (e.g., `example.[!0-9]` to match on `example.a`, `example.b`, but not `example.0`)
+//
+// @sample A language filter that applies to typescript files on disk: `{ language: 'typescript', scheme: 'file' }`
+// @sample A language filter that applies to all package.json paths: `{ language: 'json', pattern: '**package.json' }`
+//
+// @since 3.17.0
+type TextDocumentFilter protocol.Or3[TextDocumentFilterLanguage, TextDocumentFilterScheme, TextDocumentFilterPattern]
+
+func (t *TextDocumentFilter) UnmarshalJSON(x []byte) error {
+	return (*protocol.Or3[TextDocumentFilterLanguage, TextDocumentFilterScheme, TextDocumentFilterPattern])(t).UnmarshalJSON(x)
+}
+
+func (t TextDocumentFilter) MarshalJSON() ([]byte, error) {
+	return protocol.Or3[TextDocumentFilterLanguage, TextDocumentFilterScheme, TextDocumentFilterPattern](t).MarshalJSON()
+}
+
+type ResponseError = protocol.ResponseError
+
+type MethodKind = protocol.MethodKind
+
+const (
+	UnknownRequestMethod                      = protocol.UnknownRequestMethod
+	CallHierarchyIncomingCallsMethod          = protocol.CallHierarchyIncomingCallsMethod
+	CallHierarchyOutgoingCallsMethod          = protocol.CallHierarchyOutgoingCallsMethod
+	ClientRegisterCapabilityMethod            = protocol.ClientRegisterCapabilityMethod
+	ClientUnregisterCapabilityMethod          = protocol.ClientUnregisterCapabilityMethod
+	CodeActionResolveMethod                   = protocol.CodeActionResolveMethod
+	CodeLensResolveMethod                     = protocol.CodeLensResolveMethod
+	CompletionItemResolveMethod               = protocol.CompletionItemResolveMethod
+	DocumentLinkResolveMethod                 = protocol.DocumentLinkResolveMethod
+	InitializeMethod                          = protocol.InitializeMethod
+	ShutdownMethod                            = protocol.ShutdownMethod
+	TextDocumentCodeActionMethod              = protocol.TextDocumentCodeActionMethod
+	TextDocumentCodeLensMethod                = protocol.TextDocumentCodeLensMethod
+	TextDocumentColorPresentationMethod       = protocol.TextDocumentColorPresentationMethod
+	TextDocumentCompletionMethod              = protocol.TextDocumentCompletionMethod
+	TextDocumentDeclarationMethod             = protocol.TextDocumentDeclarationMethod
+	TextDocumentDefinitionMethod              = protocol.TextDocumentDefinitionMethod
+	TextDocumentDocumentColorMethod           = protocol.TextDocumentDocumentColorMethod
+	TextDocumentDocumentHighlightMethod       = protocol.TextDocumentDocumentHighlightMethod
+	TextDocumentDocumentLinkMethod            = protocol.TextDocumentDocumentLinkMethod
+	TextDocumentDocumentSymbolMethod          = protocol.TextDocumentDocumentSymbolMethod
+	TextDocumentFoldingRangeMethod            = protocol.TextDocumentFoldingRangeMethod
+	TextDocumentFormattingMethod              = protocol.TextDocumentFormattingMethod
+	TextDocumentHoverMethod                   = protocol.TextDocumentHoverMethod
+	TextDocumentImplementationMethod          = protocol.TextDocumentImplementationMethod
+	TextDocumentLinkedEditingRangeMethod      = protocol.TextDocumentLinkedEditingRangeMethod
+	TextDocumentMonikerMethod                 = protocol.TextDocumentMonikerMethod
+	TextDocumentOnTypeFormattingMethod        = protocol.TextDocumentOnTypeFormattingMethod
+	TextDocumentPrepareCallHierarchyMethod    = protocol.TextDocumentPrepareCallHierarchyMethod
+	TextDocumentPrepareRenameMethod           = protocol.TextDocumentPrepareRenameMethod
+	TextDocumentRangeFormattingMethod         = protocol.TextDocumentRangeFormattingMethod
+	TextDocumentReferencesMethod              = protocol.TextDocumentReferencesMethod
+	TextDocumentRenameMethod                  = protocol.TextDocumentRenameMethod
+	TextDocumentSelectionRangeMethod          = protocol.TextDocumentSelectionRangeMethod
+	TextDocumentSemanticTokensFullMethod      = protocol.TextDocumentSemanticTokensFullMethod
+	TextDocumentSemanticTokensFullDeltaMethod = protocol.TextDocumentSemanticTokensFullDeltaMethod
+	TextDocumentSemanticTokensRangeMethod     = protocol.TextDocumentSemanticTokensRangeMethod
+	TextDocumentSignatureHelpMethod           = protocol.TextDocumentSignatureHelpMethod
+	TextDocumentTypeDefinitionMethod          = protocol.TextDocumentTypeDefinitionMethod
+	TextDocumentWillSaveWaitUntilMethod       = protocol.TextDocumentWillSaveWaitUntilMethod
+	WindowShowDocumentMethod                  = protocol.WindowShowDocumentMethod
+	WindowShowMessageRequestMethod            = protocol.WindowShowMessageRequestMethod
+	WindowWorkDoneProgressCreateMethod        = protocol.WindowWorkDoneProgressCreateMethod
+	WorkspaceApplyEditMethod                  = protocol.WorkspaceApplyEditMethod
+	WorkspaceCodeLensRefreshMethod            = protocol.WorkspaceCodeLensRefreshMethod
+	WorkspaceConfigurationMethod              = protocol.WorkspaceConfigurationMethod
+	WorkspaceExecuteCommandMethod             = protocol.WorkspaceExecuteCommandMethod
+	WorkspaceSemanticTokensRefreshMethod      = protocol.WorkspaceSemanticTokensRefreshMethod
+	WorkspaceSymbolMethod                     = protocol.WorkspaceSymbolMethod
+	WorkspaceWillCreateFilesMethod            = protocol.WorkspaceWillCreateFilesMethod
+	WorkspaceWillDeleteFilesMethod            = protocol.WorkspaceWillDeleteFilesMethod
+	WorkspaceWillRenameFilesMethod            = protocol.WorkspaceWillRenameFilesMethod
+	WorkspaceWorkspaceFoldersMethod           = protocol.WorkspaceWorkspaceFoldersMethod
+	UnknownNotificationMethod                 = protocol.UnknownNotificationMethod
+	OptionalCancelRequestMethod               = protocol.OptionalCancelRequestMethod
+	OptionalLogTraceMethod                    = protocol.OptionalLogTraceMethod
+	OptionalProgressMethod                    = protocol.OptionalProgressMethod
+	OptionalSetTraceMethod                    = protocol.OptionalSetTraceMethod
+	ExitMethod                                = protocol.ExitMethod
+	InitializedMethod                         = protocol.InitializedMethod
+	NotebookDocumentDidChangeMethod           = protocol.NotebookDocumentDidChangeMethod
+	TelemetryEventMethod                      = protocol.TelemetryEventMethod
+	TextDocumentDidChangeMethod               = protocol.TextDocumentDidChangeMethod
+	TextDocumentDidCloseMethod                = protocol.TextDocumentDidCloseMethod
+	TextDocumentDidOpenMethod                 = protocol.TextDocumentDidOpenMethod
+	TextDocumentDidSaveMethod                 = protocol.TextDocumentDidSaveMethod
+	TextDocumentPublishDiagnosticsMethod      = protocol.TextDocumentPublishDiagnosticsMethod
+	TextDocumentWillSaveMethod                = protocol.TextDocumentWillSaveMethod
+	WindowLogMessageMethod                    = protocol.WindowLogMessageMethod
+	WindowShowMessageMethod                   = protocol.WindowShowMessageMethod
+	WindowWorkDoneProgressCancelMethod        = protocol.WindowWorkDoneProgressCancelMethod
+	WorkspaceDidChangeConfigurationMethod     = protocol.WorkspaceDidChangeConfigurationMethod
+	WorkspaceDidChangeWatchedFilesMethod      = protocol.WorkspaceDidChangeWatchedFilesMethod
+	WorkspaceDidChangeWorkspaceFoldersMethod  = protocol.WorkspaceDidChangeWorkspaceFoldersMethod
+	WorkspaceDidCreateFilesMethod             = protocol.WorkspaceDidCreateFilesMethod
+	WorkspaceDidDeleteFilesMethod             = protocol.WorkspaceDidDeleteFilesMethod
+	WorkspaceDidRenameFilesMethod             = protocol.WorkspaceDidRenameFilesMethod
+)
+
+// A special text edit with an additional change annotation.
+//
+// @since 3.16.0.
+type AnnotatedTextEdit = protocol.AnnotatedTextEdit
+
+// The parameters passed via an apply workspace edit request.
+type ApplyWorkspaceEditParams struct {
+	// The edits to apply.
+	Edit WorkspaceEdit `json:"edit"`
+	// An optional label of the workspace edit. This label is
+	// presented in the user interface for example on an undo
+	// stack to undo the workspace edit.
+	Label string `json:"label,omitempty"`
+}
+
+func (t *ApplyWorkspaceEditParams) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["edit"]; !exists {
+		return fmt.Errorf("missing required field: edit")
+	}
+	type Alias ApplyWorkspaceEditParams
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = ApplyWorkspaceEditParams(test)
+
+	return nil
+}
+
+// The result returned from the apply workspace edit request.
+//
+// @since 3.17 renamed from ApplyWorkspaceEditResponse
+type ApplyWorkspaceEditResult = protocol.ApplyWorkspaceEditResult
+
+// A base for all symbol information.
+type BaseSymbolInformation = protocol.BaseSymbolInformation
+
+// @since 3.16.0
+type CallHierarchyClientCapabilities = protocol.CallHierarchyClientCapabilities
+
+// Represents an incoming call, e.g. a caller of a method or constructor.
+//
+// @since 3.16.0
+type CallHierarchyIncomingCall = protocol.CallHierarchyIncomingCall
+
+// The parameter of a `callHierarchy/incomingCalls` request.
+//
+// @since 3.16.0
+type CallHierarchyIncomingCallsParams = protocol.CallHierarchyIncomingCallsParams
+
+// Represents programming constructs like functions or constructors in the context
+// of call hierarchy.
+//
+// @since 3.16.0
+type CallHierarchyItem = protocol.CallHierarchyItem
+
+// Call hierarchy options used during static registration.
+//
+// @since 3.16.0
+type CallHierarchyOptions = protocol.CallHierarchyOptions
+
+// Represents an outgoing call, e.g. calling a getter from a method or a method from a constructor etc.
+//
+// @since 3.16.0
+type CallHierarchyOutgoingCall = protocol.CallHierarchyOutgoingCall
+
+// The parameter of a `callHierarchy/outgoingCalls` request.
+//
+// @since 3.16.0
+type CallHierarchyOutgoingCallsParams = protocol.CallHierarchyOutgoingCallsParams
+
+// The parameter of a `textDocument/prepareCallHierarchy` request.
+//
+// @since 3.16.0
+type CallHierarchyPrepareParams = protocol.CallHierarchyPrepareParams
+
+// Call hierarchy options used during static or dynamic registration.
+//
+// @since 3.16.0
+type CallHierarchyRegistrationOptions struct {
+	// A document selector to identify the scope of the registration. If set to null
+	// the document selector provided on the client side will be used.
+	DocumentSelector *DocumentSelector `json:"documentSelector"`
+	// The id used to register the request. The id can be used to deregister
+	// the request again. See also Registration#id.
+	Id               string `json:"id,omitempty"`
+	WorkDoneProgress bool   `json:"workDoneProgress,omitempty"`
+}
+
+func (t *CallHierarchyRegistrationOptions) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["documentSelector"]; !exists {
+		return fmt.Errorf("missing required field: documentSelector")
+	}
+	type Alias CallHierarchyRegistrationOptions
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = CallHierarchyRegistrationOptions(test)
+
+	return nil
+}
+
+type CancelParams = protocol.CancelParams
+
+// Additional information that describes document changes.
+//
+// @since 3.16.0
+type ChangeAnnotation = protocol.ChangeAnnotation
+
+// @since 3.18.0
+type ChangeAnnotationsSupportOptions = protocol.ChangeAnnotationsSupportOptions
+
+// Defines the capabilities provided by the client.
+type ClientCapabilities struct {
+	// Experimental client capabilities.
+	Experimental any `json:"experimental,omitempty"`
+	// General client capabilities.
+	//
+	// @since 3.16.0
+	General *GeneralClientCapabilities `json:"general,omitzero" since:"3.16.0"`
+	// Text document specific client capabilities.
+	TextDocument *TextDocumentClientCapabilities `json:"textDocument,omitzero"`
+	// Window specific client capabilities.
+	Window *WindowClientCapabilities `json:"window,omitzero"`
+	// Workspace specific client capabilities.
+	Workspace *WorkspaceClientCapabilities `json:"workspace,omitzero"`
+}
+
+func (t *ClientCapabilities) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	type Alias ClientCapabilities
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = ClientCapabilities(test)
+
+	return nil
+}
+
+// @since 3.18.0
+type ClientCodeActionKindOptions = protocol.ClientCodeActionKindOptions
+
+// @since 3.18.0
+type ClientCodeActionLiteralOptions = protocol.ClientCodeActionLiteralOptions
+
+// @since 3.18.0
+type ClientCodeActionResolveOptions = protocol.ClientCodeActionResolveOptions
+
+// @since 3.18.0
+type ClientCompletionItemInsertTextModeOptions = protocol.ClientCompletionItemInsertTextModeOptions
+
+// @since 3.18.0
+type ClientCompletionItemOptions struct {
+	// Client supports commit characters on a completion item.
+	CommitCharactersSupport bool `json:"commitCharactersSupport,omitempty"`
+	// Client supports the deprecated property on a completion item.
+	DeprecatedSupport bool `json:"deprecatedSupport,omitempty"`
+	// Client supports the following content formats for the documentation
+	// property. The order describes the preferred format of the client.
+	DocumentationFormat []MarkupKind `json:"documentationFormat,omitzero"`
+	// Client support insert replace edit to control different behavior if a
+	// completion item is inserted in the text or should replace text.
+	//
+	// @since 3.16.0
+	InsertReplaceSupport bool `json:"insertReplaceSupport,omitempty" since:"3.16.0"`
+	// The client supports the `insertTextMode` property on
+	// a completion item to override the whitespace handling mode
+	// as defined by the client (see `insertTextMode`).
+	//
+	// @since 3.16.0
+	InsertTextModeSupport *ClientCompletionItemInsertTextModeOptions `json:"insertTextModeSupport,omitzero" since:"3.16.0"`
+	// Client supports the preselect property on a completion item.
+	PreselectSupport bool `json:"preselectSupport,omitempty"`
+	// Indicates which properties a client can resolve lazily on a completion
+	// item. Before version 3.16.0 only the predefined properties `documentation`
+	// and `details` could be resolved lazily.
+	//
+	// @since 3.16.0
+	ResolveSupport *ClientCompletionItemResolveOptions `json:"resolveSupport,omitzero" since:"3.16.0"`
+	// Client supports snippets as insert text.
+	//
+	// A snippet can define tab stops and placeholders with `$1`, `$2`
+	// and `${3:foo}`. `$0` defines the final tab stop, it defaults to
+	// the end of the snippet. Placeholders with equal identifiers are linked,
+	// that is typing in one will update others too.
+	SnippetSupport bool `json:"snippetSupport,omitempty"`
+	// Client supports the tag property on a completion item. Clients supporting
+	// tags have to handle unknown tags gracefully. Clients especially need to
+	// preserve unknown tags when sending a completion item back to the server in
+	// a resolve call.
+	//
+	// @since 3.15.0
+	TagSupport *CompletionItemTagOptions `json:"tagSupport,omitzero" since:"3.15.0"`
+}
+
+func (t *ClientCompletionItemOptions) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	type Alias ClientCompletionItemOptions
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = ClientCompletionItemOptions(test)
+
+	return nil
+}
+
+// @since 3.18.0
+type ClientCompletionItemOptionsKind = protocol.ClientCompletionItemOptionsKind
+
+// @since 3.18.0
+type ClientCompletionItemResolveOptions = protocol.ClientCompletionItemResolveOptions
+
+// @since 3.18.0
+type ClientDiagnosticsTagOptions = protocol.ClientDiagnosticsTagOptions
+
+// Information about the client
+//
+// @since 3.15.0
+// @since 3.18.0 ClientInfo type name added.
+type ClientInfo = protocol.ClientInfo
+
+// @since 3.18.0
+type ClientSemanticTokensRequestFullDelta = protocol.ClientSemanticTokensRequestFullDelta
+
+// @since 3.18.0
+type ClientSemanticTokensRequestOptions = protocol.ClientSemanticTokensRequestOptions
+
+// @since 3.18.0
+type ClientShowMessageActionItemOptions = protocol.ClientShowMessageActionItemOptions
+
+// @since 3.18.0
+type ClientSignatureInformationOptions struct {
+	// The client supports the `activeParameter` property on `SignatureInformation`
+	// literal.
+	//
+	// @since 3.16.0
+	ActiveParameterSupport bool `json:"activeParameterSupport,omitempty" since:"3.16.0"`
+	// Client supports the following content formats for the documentation
+	// property. The order describes the preferred format of the client.
+	DocumentationFormat []MarkupKind `json:"documentationFormat,omitzero"`
+	// Client capabilities specific to parameter information.
+	ParameterInformation *ClientSignatureParameterInformationOptions `json:"parameterInformation,omitzero"`
+}
+
+func (t *ClientSignatureInformationOptions) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	type Alias ClientSignatureInformationOptions
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = ClientSignatureInformationOptions(test)
+
+	return nil
+}
+
+// @since 3.18.0
+type ClientSignatureParameterInformationOptions = protocol.ClientSignatureParameterInformationOptions
+
+// @since 3.18.0
+type ClientSymbolKindOptions = protocol.ClientSymbolKindOptions
+
+// @since 3.18.0
+type ClientSymbolTagOptions = protocol.ClientSymbolTagOptions
+
+// A code action represents a change that can be performed in code, e.g. to fix a problem or
+// to refactor code.
+//
+// A CodeAction must set either `edit` and/or a `command`. If both are supplied, the `edit` is applied first, then the `command` is executed.
+type CodeAction struct {
+	// A command this code action executes. If a code action
+	// provides an edit and a command, first the edit is
+	// executed and then the command.
+	Command *Command `json:"command,omitzero"`
+	// A data entry field that is preserved on a code action between
+	// a `textDocument/codeAction` and a `codeAction/resolve` request.
+	//
+	// @since 3.16.0
+	Data any `json:"data,omitempty" since:"3.16.0"`
+	// The diagnostics that this code action resolves.
+	Diagnostics []Diagnostic `json:"diagnostics,omitzero"`
+	// Marks that the code action cannot currently be applied.
+	//
+	// Clients should follow the following guidelines regarding disabled code actions:
+	//
+	//   - Disabled code actions are not shown in automatic [lightbulbs](https://code.visualstudio.com/docs/editor/editingevolved#_code-action)
+	//     code action menus.
+	//
+	//   - Disabled actions are shown as faded out in the code action menu when the user requests a more specific type
+	//     of code action, such as refactorings.
+	//
+	//   - If the user has a [keybinding](https://code.visualstudio.com/docs/editor/refactoring#_keybindings-for-code-actions)
+	//     that auto applies a code action and only disabled code actions are returned, the client should show the user an
+	//     error message with `reason` in the editor.
+	//
+	// @since 3.16.0
+	Disabled *CodeActionDisabled `json:"disabled,omitzero" since:"3.16.0"`
+	// The workspace edit this code action performs.
+	Edit *WorkspaceEdit `json:"edit,omitzero"`
+	// Marks this as a preferred action. Preferred actions are used by the `auto fix` command and can be targeted
+	// by keybindings.
+	//
+	// A quick fix should be marked preferred if it properly addresses the underlying error.
+	// A refactoring should be marked preferred if it is the most reasonable choice of actions to take.
+	//
+	// @since 3.15.0
+	IsPreferred bool `json:"isPreferred,omitempty" since:"3.15.0"`
+	// The kind of the code action.
+	//
+	// Used to filter code actions.
+	Kind *CodeActionKind `json:"kind,omitzero"`
+	// A short, human-readable, title for this code action.
+	Title string `json:"title"`
+}
+
+func (t *CodeAction) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["title"]; !exists {
+		return fmt.Errorf("missing required field: title")
+	}
+	type Alias CodeAction
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = CodeAction(test)
+
+	return nil
+}
+
+// The Client Capabilities of a {@link CodeActionRequest}.
+type CodeActionClientCapabilities struct {
+	// The client support code action literals of type `CodeAction` as a valid
+	// response of the `textDocument/codeAction` request. If the property is not
+	// set the request can only return `Command` literals.
+	//
+	// @since 3.8.0
+	CodeActionLiteralSupport *ClientCodeActionLiteralOptions `json:"codeActionLiteralSupport,omitzero" since:"3.8.0"`
+	// Whether code action supports the `data` property which is
+	// preserved between a `textDocument/codeAction` and a
+	// `codeAction/resolve` request.
+	//
+	// @since 3.16.0
+	DataSupport bool `json:"dataSupport,omitempty" since:"3.16.0"`
+	// Whether code action supports the `disabled` property.
+	//
+	// @since 3.16.0
+	DisabledSupport bool `json:"disabledSupport,omitempty" since:"3.16.0"`
+	// Whether code action supports dynamic registration.
+	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+	// Whether the client honors the change annotations in
+	// text edits and resource operations returned via the
+	// `CodeAction#edit` property by for example presenting
+	// the workspace edit in the user interface and asking
+	// for confirmation.
+	//
+	// @since 3.16.0
+	HonorsChangeAnnotations bool `json:"honorsChangeAnnotations,omitempty" since:"3.16.0"`
+	// Whether code action supports the `isPreferred` property.
+	//
+	// @since 3.15.0
+	IsPreferredSupport bool `json:"isPreferredSupport,omitempty" since:"3.15.0"`
+	// Whether the client supports resolving additional code action
+	// properties via a separate `codeAction/resolve` request.
+	//
+	// @since 3.16.0
+	ResolveSupport *ClientCodeActionResolveOptions `json:"resolveSupport,omitzero" since:"3.16.0"`
+}
+
+func (t *CodeActionClientCapabilities) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	type Alias CodeActionClientCapabilities
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = CodeActionClientCapabilities(test)
+
+	return nil
+}
+
+// Contains additional diagnostic information about the context in which
+// a {@link CodeActionProvider.provideCodeActions code action} is run.
+type CodeActionContext struct {
+	// An array of diagnostics known on the client side overlapping the range provided to the
+	// `textDocument/codeAction` request. They are provided so that the server knows which
+	// errors are currently presented to the user for the given range. There is no guarantee
+	// that these accurately reflect the error state of the resource. The primary parameter
+	// to compute code actions is the provided range.
+	Diagnostics []Diagnostic `json:"diagnostics"`
+	// Requested kind of actions to return.
+	//
+	// Actions not of this kind are filtered out by the client before being shown. So servers
+	// can omit computing them.
+	Only []CodeActionKind `json:"only,omitzero"`
+}
+
+func (t *CodeActionContext) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["diagnostics"]; !exists {
+		return fmt.Errorf("missing required field: diagnostics")
+	}
+	type Alias CodeActionContext
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = CodeActionContext(test)
+
+	return nil
+}
+
+// Captures why the code action is currently disabled.
+//
+// @since 3.18.0
+type CodeActionDisabled = protocol.CodeActionDisabled
+
+// Provider options for a {@link CodeActionRequest}.
+type CodeActionOptions struct {
+	// CodeActionKinds that this server may return.
+	//
+	// The list of kinds may be generic, such as `CodeActionKind.Refactor`, or the server
+	// may list out every specific kind they provide.
+	CodeActionKinds []CodeActionKind `json:"codeActionKinds,omitzero"`
+	// The server provides support to resolve additional
+	// information for a code action.
+	//
+	// @since 3.16.0
+	ResolveProvider  bool `json:"resolveProvider,omitempty" since:"3.16.0"`
+	WorkDoneProgress bool `json:"workDoneProgress,omitempty"`
+}
+
+func (t *CodeActionOptions) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	type Alias CodeActionOptions
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = CodeActionOptions(test)
+
+	return nil
+}
+
+// The parameters of a {@link CodeActionRequest}.
+type CodeActionParams struct {
+	// Context carrying additional information.
+	Context CodeActionContext `json:"context"`
+	// An optional token that a server can use to report partial results (e.g. streaming) to
+	// the client.
+	PartialResultToken *ProgressToken `json:"partialResultToken,omitzero"`
+	// The range for which the command was invoked.
+	Range Range `json:"range"`
+	// The document in which the command was invoked.
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+	// An optional token that a server can use to report work done progress.
+	WorkDoneToken *ProgressToken `json:"workDoneToken,omitzero"`
+}
+
+func (t *CodeActionParams) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["context"]; !exists {
+		return fmt.Errorf("missing required field: context")
+	}
+	if _, exists := m["range"]; !exists {
+		return fmt.Errorf("missing required field: range")
+	}
+	if _, exists := m["textDocument"]; !exists {
+		return fmt.Errorf("missing required field: textDocument")
+	}
+	type Alias CodeActionParams
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = CodeActionParams(test)
+
+	return nil
+}
+
+// Registration options for a {@link CodeActionRequest}.
+type CodeActionRegistrationOptions struct {
+	// CodeActionKinds that this server may return.
+	//
+	// The list of kinds may be generic, such as `CodeActionKind.Refactor`, or the server
+	// may list out every specific kind they provide.
+	CodeActionKinds []CodeActionKind `json:"codeActionKinds,omitzero"`
+	// A document selector to identify the scope of the registration. If set to null
+	// the document selector provided on the client side will be used.
+	DocumentSelector *DocumentSelector `json:"documentSelector"`
+	// The server provides support to resolve additional
+	// information for a code action.
+	//
+	// @since 3.16.0
+	ResolveProvider  bool `json:"resolveProvider,omitempty" since:"3.16.0"`
+	WorkDoneProgress bool `json:"workDoneProgress,omitempty"`
+}
+
+func (t *CodeActionRegistrationOptions) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["documentSelector"]; !exists {
+		return fmt.Errorf("missing required field: documentSelector")
+	}
+	type Alias CodeActionRegistrationOptions
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = CodeActionRegistrationOptions(test)
+
+	return nil
+}
+
+// Structure to capture a description for an error code.
+//
+// @since 3.16.0
+type CodeDescription = protocol.CodeDescription
+
+// A code lens represents a {@link Command command} that should be shown along with
+// source text, like the number of references, a way to run tests, etc.
+//
+// A code lens is _unresolved_ when no command is associated to it. For performance
+// reasons the creation of a code lens and resolving should be done in two stages.
+type CodeLens struct {
+	// The command this code lens represents.
+	Command *Command `json:"command,omitzero"`
+	// A data entry field that is preserved on a code lens item between
+	// a {@link CodeLensRequest} and a {@link CodeLensResolveRequest}
+	Data any `json:"data,omitempty"`
+	// The range in which this code lens is valid. Should only span a single line.
+	Range Range `json:"range"`
+}
+
+func (t *CodeLens) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["range"]; !exists {
+		return fmt.Errorf("missing required field: range")
+	}
+	type Alias CodeLens
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = CodeLens(test)
+
+	return nil
+}
+
+// The client capabilities  of a {@link CodeLensRequest}.
+type CodeLensClientCapabilities struct {
+	// Whether code lens supports dynamic registration.
+	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+}
+
+func (t *CodeLensClientCapabilities) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	type Alias CodeLensClientCapabilities
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = CodeLensClientCapabilities(test)
+
+	return nil
+}
+
+// Code Lens provider options of a {@link CodeLensRequest}.
+type CodeLensOptions = protocol.CodeLensOptions
+
+// The parameters of a {@link CodeLensRequest}.
+type CodeLensParams = protocol.CodeLensParams
+
+// Registration options for a {@link CodeLensRequest}.
+type CodeLensRegistrationOptions struct {
+	// A document selector to identify the scope of the registration. If set to null
+	// the document selector provided on the client side will be used.
+	DocumentSelector *DocumentSelector `json:"documentSelector"`
+	// Code lens has a resolve provider as well.
+	ResolveProvider  bool `json:"resolveProvider,omitempty"`
+	WorkDoneProgress bool `json:"workDoneProgress,omitempty"`
+}
+
+func (t *CodeLensRegistrationOptions) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["documentSelector"]; !exists {
+		return fmt.Errorf("missing required field: documentSelector")
+	}
+	type Alias CodeLensRegistrationOptions
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = CodeLensRegistrationOptions(test)
+
+	return nil
+}
+
+// @since 3.16.0
+type CodeLensWorkspaceClientCapabilities = protocol.CodeLensWorkspaceClientCapabilities
+
+// Represents a color in RGBA space.
+type Color = protocol.Color
+
+// Represents a color range from a document.
+type ColorInformation = protocol.ColorInformation
+
+type ColorPresentation = protocol.ColorPresentation
+
+// Parameters for a {@link ColorPresentationRequest}.
+type ColorPresentationParams = protocol.ColorPresentationParams
+
+// Represents a reference to a command. Provides a title which
+// will be used to represent a command in the UI and, optionally,
+// an array of arguments which will be passed to the command handler
+// function when invoked.
+type Command struct {
+	// Arguments that the command handler should be
+	// invoked with.
+	Arguments []any `json:"arguments,omitzero"`
+	// The identifier of the actual command handler.
+	Command string `json:"command"`
+	// Title of the command, like `save`.
+	Title string `json:"title"`
+}
+
+func (t *Command) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["command"]; !exists {
+		return fmt.Errorf("missing required field: command")
+	}
+	if _, exists := m["title"]; !exists {
+		return fmt.Errorf("missing required field: title")
+	}
+	type Alias Command
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = Command(test)
+
+	return nil
+}
+
+// Completion client capabilities
+type CompletionClientCapabilities struct {
+	// The client supports the following `CompletionItem` specific
+	// capabilities.
+	CompletionItem     *ClientCompletionItemOptions     `json:"completionItem,omitzero"`
+	CompletionItemKind *ClientCompletionItemOptionsKind `json:"completionItemKind,omitzero"`
+	// The client supports to send additional context information for a
+	// `textDocument/completion` request.
+	ContextSupport bool `json:"contextSupport,omitempty"`
+	// Whether completion supports dynamic registration.
+	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+}
+
+func (t *CompletionClientCapabilities) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	type Alias CompletionClientCapabilities
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = CompletionClientCapabilities(test)
+
+	return nil
+}
+
+// Contains additional information about the context in which a completion request is triggered.
+type CompletionContext = protocol.CompletionContext
+
+// A completion item represents a text snippet that is
+// proposed to complete text that is being typed.
+type CompletionItem struct {
+	// An optional array of additional {@link TextEdit text edits} that are applied when
+	// selecting this completion. Edits must not overlap (including the same insert position)
+	// with the main {@link CompletionItem.textEdit edit} nor with themselves.
+	//
+	// Additional text edits should be used to change text unrelated to the current cursor position
+	// (for example adding an import statement at the top of the file if the completion item will
+	// insert an unqualified type).
+	AdditionalTextEdits []TextEdit `json:"additionalTextEdits,omitzero"`
+	// An optional {@link Command command} that is executed *after* inserting this completion. *Note* that
+	// additional modifications to the current document should be described with the
+	// {@link CompletionItem.additionalTextEdits additionalTextEdits}-property.
+	Command *Command `json:"command,omitzero"`
+	// An optional set of characters that when pressed while this completion is active will accept it first and
+	// then type that character. *Note* that all commit characters should have `length=1` and that superfluous
+	// characters will be ignored.
+	CommitCharacters []string `json:"commitCharacters,omitzero"`
+	// A data entry field that is preserved on a completion item between a
+	// {@link CompletionRequest} and a {@link CompletionResolveRequest}.
+	Data any `json:"data,omitempty"`
+	// Indicates if this item is deprecated.
+	// @deprecated Use `tags` instead.
+	Deprecated bool `json:"deprecated,omitempty"`
+	// A human-readable string with additional information
+	// about this item, like type or symbol information.
+	Detail string `json:"detail,omitempty"`
+	// A human-readable string that represents a doc-comment.
+	Documentation *protocol.Or2[string, MarkupContent] `json:"documentation,omitzero"`
+	// A string that should be used when filtering a set of
+	// completion items. When `falsy` the {@link CompletionItem.label label}
+	// is used.
+	FilterText string `json:"filterText,omitempty"`
+	// A string that should be inserted into a document when selecting
+	// this completion. When `falsy` the {@link CompletionItem.label label}
+	// is used.
+	//
+	// The `insertText` is subject to interpretation by the client side.
+	// Some tools might not take the string literally. For example
+	// VS Code when code complete is requested in this example
+	// `con<cursor position>` and a completion item with an `insertText` of
+	// `console` is provided it will only insert `sole`. Therefore it is
+	// recommended to use `textEdit` instead since it avoids additional client
+	// side interpretation.
+	InsertText string `json:"insertText,omitempty"`
+	// The format of the insert text. The format applies to both the
+	// `insertText` property and the `newText` property of a provided
+	// `textEdit`. If omitted defaults to `InsertTextFormat.PlainText`.
+	//
+	// Please note that the insertTextFormat doesn't apply to
+	// `additionalTextEdits`.
+	InsertTextFormat *InsertTextFormat `json:"insertTextFormat,omitzero"`
+	// How whitespace and indentation is handled during completion
+	// item insertion. If not provided the clients default value depends on
+	// the `textDocument.completion.insertTextMode` client capability.
+	//
+	// @since 3.16.0
+	InsertTextMode *InsertTextMode `json:"insertTextMode,omitzero" since:"3.16.0"`
+	// The kind of this completion item. Based of the kind
+	// an icon is chosen by the editor.
+	Kind *CompletionItemKind `json:"kind,omitzero"`
+	// The label of this completion item.
+	//
+	// The label property is also by default the text that
+	// is inserted when selecting this completion.
+	//
+	// If label details are provided the label itself should
+	// be an unqualified name of the completion item.
+	Label string `json:"label"`
+	// Select this item when showing.
+	//
+	// *Note* that only one completion item can be selected and that the
+	// tool / client decides which item that is. The rule is that the *first*
+	// item of those that match best is selected.
+	Preselect bool `json:"preselect,omitempty"`
+	// A string that should be used when comparing this item
+	// with other items. When `falsy` the {@link CompletionItem.label label}
+	// is used.
+	SortText string `json:"sortText,omitempty"`
+	// Tags for this completion item.
+	//
+	// @since 3.15.0
+	Tags []CompletionItemTag `json:"tags,omitzero" since:"3.15.0"`
+	// An {@link TextEdit edit} which is applied to a document when selecting
+	// this completion. When an edit is provided the value of
+	// {@link CompletionItem.insertText insertText} is ignored.
+	//
+	// Most editors support two different operations when accepting a completion
+	// item. One is to insert a completion text and the other is to replace an
+	// existing text with a completion text. Since this can usually not be
+	// predetermined by a server it can report both ranges. Clients need to
+	// signal support for `InsertReplaceEdits` via the
+	// `textDocument.completion.insertReplaceSupport` client capability
+	// property.
+	//
+	// *Note 1:* The text edit's range as well as both ranges from an insert
+	// replace edit must be a [single line] and they must contain the position
+	// at which completion has been requested.
+	// *Note 2:* If an `InsertReplaceEdit` is returned the edit's insert range
+	// must be a prefix of the edit's replace range, that means it must be
+	// contained and starting at the same position.
+	//
+	// @since 3.16.0 additional type `InsertReplaceEdit`
+	TextEdit *protocol.Or2[TextEdit, InsertReplaceEdit] `json:"textEdit,omitzero"`
+}
+
+func (t *CompletionItem) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["label"]; !exists {
+		return fmt.Errorf("missing required field: label")
+	}
+	type Alias CompletionItem
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = CompletionItem(test)
+
+	return nil
+}
+
+// @since 3.18.0
+type CompletionItemTagOptions = protocol.CompletionItemTagOptions
+
+// Represents a collection of {@link CompletionItem completion items} to be presented
+// in the editor.
+type CompletionList struct {
+	// This list it not complete. Further typing results in recomputing this list.
+	//
+	// Recomputed lists have all their items replaced (not appended) in the
+	// incomplete completion sessions.
+	IsIncomplete bool `json:"isIncomplete"`
+	// The completion items.
+	Items []CompletionItem `json:"items"`
+}
+
+func (t *CompletionList) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["isIncomplete"]; !exists {
+		return fmt.Errorf("missing required field: isIncomplete")
+	}
+	if _, exists := m["items"]; !exists {
+		return fmt.Errorf("missing required field: items")
+	}
+	type Alias CompletionList
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = CompletionList(test)
+
+	return nil
+}
+
+// Completion options.
+type CompletionOptions struct {
+	// The list of all possible characters that commit a completion. This field can be used
+	// if clients don't support individual commit characters per completion item. See
+	// `ClientCapabilities.textDocument.completion.completionItem.commitCharactersSupport`
+	//
+	// If a server provides both `allCommitCharacters` and commit characters on an individual
+	// completion item the ones on the completion item win.
+	//
+	// @since 3.2.0
+	AllCommitCharacters []string `json:"allCommitCharacters,omitzero" since:"3.2.0"`
+	// The server provides support to resolve additional
+	// information for a completion item.
+	ResolveProvider bool `json:"resolveProvider,omitempty"`
+	// Most tools trigger completion request automatically without explicitly requesting
+	// it using a keyboard shortcut (e.g. Ctrl+Space). Typically they do so when the user
+	// starts to type an identifier. For example if the user types `c` in a JavaScript file
+	// code complete will automatically pop up present `console` besides others as a
+	// completion item. Characters that make up identifiers don't need to be listed here.
+	//
+	// If code complete should automatically be trigger on characters not being valid inside
+	// an identifier (for example `.` in JavaScript) list them in `triggerCharacters`.
+	TriggerCharacters []string `json:"triggerCharacters,omitzero"`
+	WorkDoneProgress  bool     `json:"workDoneProgress,omitempty"`
+}
+
+func (t *CompletionOptions) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	type Alias CompletionOptions
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = CompletionOptions(test)
+
+	return nil
+}
+
+// Completion parameters
+type CompletionParams = protocol.CompletionParams
+
+// Registration options for a {@link CompletionRequest}.
+type CompletionRegistrationOptions struct {
+	// The list of all possible characters that commit a completion. This field can be used
+	// if clients don't support individual commit characters per completion item. See
+	// `ClientCapabilities.textDocument.completion.completionItem.commitCharactersSupport`
+	//
+	// If a server provides both `allCommitCharacters` and commit characters on an individual
+	// completion item the ones on the completion item win.
+	//
+	// @since 3.2.0
+	AllCommitCharacters []string `json:"allCommitCharacters,omitzero" since:"3.2.0"`
+	// A document selector to identify the scope of the registration. If set to null
+	// the document selector provided on the client side will be used.
+	DocumentSelector *DocumentSelector `json:"documentSelector"`
+	// The server provides support to resolve additional
+	// information for a completion item.
+	ResolveProvider bool `json:"resolveProvider,omitempty"`
+	// Most tools trigger completion request automatically without explicitly requesting
+	// it using a keyboard shortcut (e.g. Ctrl+Space). Typically they do so when the user
+	// starts to type an identifier. For example if the user types `c` in a JavaScript file
+	// code complete will automatically pop up present `console` besides others as a
+	// completion item. Characters that make up identifiers don't need to be listed here.
+	//
+	// If code complete should automatically be trigger on characters not being valid inside
+	// an identifier (for example `.` in JavaScript) list them in `triggerCharacters`.
+	TriggerCharacters []string `json:"triggerCharacters,omitzero"`
+	WorkDoneProgress  bool     `json:"workDoneProgress,omitempty"`
+}
+
+func (t *CompletionRegistrationOptions) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["documentSelector"]; !exists {
+		return fmt.Errorf("missing required field: documentSelector")
+	}
+	type Alias CompletionRegistrationOptions
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = CompletionRegistrationOptions(test)
+
+	return nil
+}
+
+type ConfigurationItem = protocol.ConfigurationItem
+
+// The parameters of a configuration request.
+type ConfigurationParams = protocol.ConfigurationParams
+
+// Create file operation.
+type CreateFile = protocol.CreateFile
+
+// Options to create a file.
+type CreateFileOptions = protocol.CreateFileOptions
+
+// The parameters sent in notifications/requests for user-initiated creation of
+// files.
+//
+// @since 3.16.0
+type CreateFilesParams = protocol.CreateFilesParams
+
+// @since 3.14.0
+type DeclarationClientCapabilities = protocol.DeclarationClientCapabilities
+
+type DeclarationOptions = protocol.DeclarationOptions
+
+type DeclarationParams = protocol.DeclarationParams
+
+type DeclarationRegistrationOptions struct {
+	// A document selector to identify the scope of the registration. If set to null
+	// the document selector provided on the client side will be used.
+	DocumentSelector *DocumentSelector `json:"documentSelector"`
+	// The id used to register the request. The id can be used to deregister
+	// the request again. See also Registration#id.
+	Id               string `json:"id,omitempty"`
+	WorkDoneProgress bool   `json:"workDoneProgress,omitempty"`
+}
+
+func (t *DeclarationRegistrationOptions) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["documentSelector"]; !exists {
+		return fmt.Errorf("missing required field: documentSelector")
+	}
+	type Alias DeclarationRegistrationOptions
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = DeclarationRegistrationOptions(test)
+
+	return nil
+}
+
+// Client Capabilities for a {@link DefinitionRequest}.
+type DefinitionClientCapabilities = protocol.DefinitionClientCapabilities
+
+// Server Capabilities for a {@link DefinitionRequest}.
+type DefinitionOptions = protocol.DefinitionOptions
+
+// Parameters for a {@link DefinitionRequest}.
+type DefinitionParams = protocol.DefinitionParams
+
+// Registration options for a {@link DefinitionRequest}.
+type DefinitionRegistrationOptions struct {
+	// A document selector to identify the scope of the registration. If set to null
+	// the document selector provided on the client side will be used.
+	DocumentSelector *DocumentSelector `json:"documentSelector"`
+	WorkDoneProgress bool              `json:"workDoneProgress,omitempty"`
+}
+
+func (t *DefinitionRegistrationOptions) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["documentSelector"]; !exists {
+		return fmt.Errorf("missing required field: documentSelector")
+	}
+	type Alias DefinitionRegistrationOptions
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = DefinitionRegistrationOptions(test)
+
+	return nil
+}
+
+// Delete file operation
+type DeleteFile = protocol.DeleteFile
+
+// Delete file options
+type DeleteFileOptions = protocol.DeleteFileOptions
+
+// The parameters sent in notifications/requests for user-initiated deletes of
+// files.
+//
+// @since 3.16.0
+type DeleteFilesParams = protocol.DeleteFilesParams
+
+// Represents a diagnostic, such as a compiler error or warning. Diagnostic objects
+// are only valid in the scope of a resource.
+type Diagnostic = protocol.Diagnostic
+
+// Represents a related message and source code location for a diagnostic. This should be
+// used to point to code locations that cause or related to a diagnostics, e.g when duplicating
+// a symbol in a scope.
+type DiagnosticRelatedInformation = protocol.DiagnosticRelatedInformation
+
+// General diagnostics capabilities for pull and push model.
+type DiagnosticsCapabilities = protocol.DiagnosticsCapabilities
+
+type DidChangeConfigurationClientCapabilities = protocol.DidChangeConfigurationClientCapabilities
+
+// The parameters of a change configuration notification.
+type DidChangeConfigurationParams = protocol.DidChangeConfigurationParams
+
+type DidChangeConfigurationRegistrationOptions = protocol.DidChangeConfigurationRegistrationOptions
+
+// The change text document notification's parameters.
+type DidChangeTextDocumentParams = protocol.DidChangeTextDocumentParams
+
+type DidChangeWatchedFilesClientCapabilities struct {
+	// Did change watched files notification supports dynamic registration. Please note
+	// that the current protocol doesn't support static configuration for file changes
+	// from the server side.
+	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+}
+
+func (t *DidChangeWatchedFilesClientCapabilities) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	type Alias DidChangeWatchedFilesClientCapabilities
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = DidChangeWatchedFilesClientCapabilities(test)
+
+	return nil
+}
+
+// The watched files change notification's parameters.
+type DidChangeWatchedFilesParams = protocol.DidChangeWatchedFilesParams
+
+// Describe options to be used when registered for text document change events.
+type DidChangeWatchedFilesRegistrationOptions struct {
+	// The watchers to register.
+	Watchers []FileSystemWatcher `json:"watchers"`
+}
+
+func (t *DidChangeWatchedFilesRegistrationOptions) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["watchers"]; !exists {
+		return fmt.Errorf("missing required field: watchers")
+	}
+	type Alias DidChangeWatchedFilesRegistrationOptions
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = DidChangeWatchedFilesRegistrationOptions(test)
+
+	return nil
+}
+
+// The parameters of a `workspace/didChangeWorkspaceFolders` notification.
+type DidChangeWorkspaceFoldersParams = protocol.DidChangeWorkspaceFoldersParams
+
+// The parameters sent in a close text document notification
+type DidCloseTextDocumentParams = protocol.DidCloseTextDocumentParams
+
+// The parameters sent in an open text document notification
+type DidOpenTextDocumentParams = protocol.DidOpenTextDocumentParams
+
+// The parameters sent in a save text document notification
+type DidSaveTextDocumentParams = protocol.DidSaveTextDocumentParams
+
+type DocumentColorClientCapabilities = protocol.DocumentColorClientCapabilities
+
+type DocumentColorOptions = protocol.DocumentColorOptions
+
+// Parameters for a {@link DocumentColorRequest}.
+type DocumentColorParams = protocol.DocumentColorParams
+
+type DocumentColorRegistrationOptions struct {
+	// A document selector to identify the scope of the registration. If set to null
+	// the document selector provided on the client side will be used.
+	DocumentSelector *DocumentSelector `json:"documentSelector"`
+	// The id used to register the request. The id can be used to deregister
+	// the request again. See also Registration#id.
+	Id               string `json:"id,omitempty"`
+	WorkDoneProgress bool   `json:"workDoneProgress,omitempty"`
+}
+
+func (t *DocumentColorRegistrationOptions) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["documentSelector"]; !exists {
+		return fmt.Errorf("missing required field: documentSelector")
+	}
+	type Alias DocumentColorRegistrationOptions
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = DocumentColorRegistrationOptions(test)
+
+	return nil
+}
+
+// Client capabilities of a {@link DocumentFormattingRequest}.
+type DocumentFormattingClientCapabilities = protocol.DocumentFormattingClientCapabilities
+
+// Provider options for a {@link DocumentFormattingRequest}.
+type DocumentFormattingOptions = protocol.DocumentFormattingOptions
+
+// The parameters of a {@link DocumentFormattingRequest}.
+type DocumentFormattingParams = protocol.DocumentFormattingParams
+
+// Registration options for a {@link DocumentFormattingRequest}.
+type DocumentFormattingRegistrationOptions struct {
+	// A document selector to identify the scope of the registration. If set to null
+	// the document selector provided on the client side will be used.
+	DocumentSelector *DocumentSelector `json:"documentSelector"`
+	WorkDoneProgress bool              `json:"workDoneProgress,omitempty"`
+}
+
+func (t *DocumentFormattingRegistrationOptions) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["documentSelector"]; !exists {
+		return fmt.Errorf("missing required field: documentSelector")
+	}
+	type Alias DocumentFormattingRegistrationOptions
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = DocumentFormattingRegistrationOptions(test)
+
+	return nil
+}
+
+// A document highlight is a range inside a text document which deserves
+// special attention. Usually a document highlight is visualized by changing
+// the background color of its range.
+type DocumentHighlight = protocol.DocumentHighlight
+
+// Client Capabilities for a {@link DocumentHighlightRequest}.
+type DocumentHighlightClientCapabilities = protocol.DocumentHighlightClientCapabilities
+
+// Provider options for a {@link DocumentHighlightRequest}.
+type DocumentHighlightOptions = protocol.DocumentHighlightOptions
+
+// Parameters for a {@link DocumentHighlightRequest}.
+type DocumentHighlightParams = protocol.DocumentHighlightParams
+
+// Registration options for a {@link DocumentHighlightRequest}.
+type DocumentHighlightRegistrationOptions struct {
+	// A document selector to identify the scope of the registration. If set to null
+	// the document selector provided on the client side will be used.
+	DocumentSelector *DocumentSelector `json:"documentSelector"`
+	WorkDoneProgress bool              `json:"workDoneProgress,omitempty"`
+}
+
+func (t *DocumentHighlightRegistrationOptions) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["documentSelector"]; !exists {
+		return fmt.Errorf("missing required field: documentSelector")
+	}
+	type Alias DocumentHighlightRegistrationOptions
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = DocumentHighlightRegistrationOptions(test)
+
+	return nil
+}
+
+// A document link is a range in a text document that links to an internal or external resource, like another
+// text document or a web site.
+type DocumentLink = protocol.DocumentLink
+
+// The client capabilities of a {@link DocumentLinkRequest}.
+type DocumentLinkClientCapabilities = protocol.DocumentLinkClientCapabilities
+
+// Provider options for a {@link DocumentLinkRequest}.
+type DocumentLinkOptions = protocol.DocumentLinkOptions
+
+// The parameters of a {@link DocumentLinkRequest}.
+type DocumentLinkParams = protocol.DocumentLinkParams
+
+// Registration options for a {@link DocumentLinkRequest}.
+type DocumentLinkRegistrationOptions struct {
+	// A document selector to identify the scope of the registration. If set to null
+	// the document selector provided on the client side will be used.
+	DocumentSelector *DocumentSelector `json:"documentSelector"`
+	// Document links have a resolve provider as well.
+	ResolveProvider  bool `json:"resolveProvider,omitempty"`
+	WorkDoneProgress bool `json:"workDoneProgress,omitempty"`
+}
+
+func (t *DocumentLinkRegistrationOptions) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["documentSelector"]; !exists {
+		return fmt.Errorf("missing required field: documentSelector")
+	}
+	type Alias DocumentLinkRegistrationOptions
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = DocumentLinkRegistrationOptions(test)
+
+	return nil
+}
+
+// Client capabilities of a {@link DocumentOnTypeFormattingRequest}.
+type DocumentOnTypeFormattingClientCapabilities = protocol.DocumentOnTypeFormattingClientCapabilities
+
+// Provider options for a {@link DocumentOnTypeFormattingRequest}.
+type DocumentOnTypeFormattingOptions = protocol.DocumentOnTypeFormattingOptions
+
+// The parameters of a {@link DocumentOnTypeFormattingRequest}.
+type DocumentOnTypeFormattingParams = protocol.DocumentOnTypeFormattingParams
+
+// Registration options for a {@link DocumentOnTypeFormattingRequest}.
+type DocumentOnTypeFormattingRegistrationOptions struct {
+	// A document selector to identify the scope of the registration. If set to null
+	// the document selector provided on the client side will be used.
+	DocumentSelector *DocumentSelector `json:"documentSelector"`
+	// A character on which formatting should be triggered, like `{`.
+	FirstTriggerCharacter string `json:"firstTriggerCharacter"`
+	// More trigger characters.
+	MoreTriggerCharacter []string `json:"moreTriggerCharacter,omitzero"`
+}
+
+func (t *DocumentOnTypeFormattingRegistrationOptions) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["documentSelector"]; !exists {
+		return fmt.Errorf("missing required field: documentSelector")
+	}
+	if _, exists := m["firstTriggerCharacter"]; !exists {
+		return fmt.Errorf("missing required field: firstTriggerCharacter")
+	}
+	type Alias DocumentOnTypeFormattingRegistrationOptions
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = DocumentOnTypeFormattingRegistrationOptions(test)
+
+	return nil
+}
+
+// Client capabilities of a {@link DocumentRangeFormattingRequest}.
+type DocumentRangeFormattingClientCapabilities struct {
+	// Whether range formatting supports dynamic registration.
+	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+}
+
+func (t *DocumentRangeFormattingClientCapabilities) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	type Alias DocumentRangeFormattingClientCapabilities
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = DocumentRangeFormattingClientCapabilities(test)
+
+	return nil
+}
+
+// Provider options for a {@link DocumentRangeFormattingRequest}.
+type DocumentRangeFormattingOptions struct {
+	WorkDoneProgress bool `json:"workDoneProgress,omitempty"`
+}
+
+func (t *DocumentRangeFormattingOptions) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	type Alias DocumentRangeFormattingOptions
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = DocumentRangeFormattingOptions(test)
+
+	return nil
+}
+
+// The parameters of a {@link DocumentRangeFormattingRequest}.
+type DocumentRangeFormattingParams = protocol.DocumentRangeFormattingParams
+
+// Registration options for a {@link DocumentRangeFormattingRequest}.
+type DocumentRangeFormattingRegistrationOptions struct {
+	// A document selector to identify the scope of the registration. If set to null
+	// the document selector provided on the client side will be used.
+	DocumentSelector *DocumentSelector `json:"documentSelector"`
+	WorkDoneProgress bool              `json:"workDoneProgress,omitempty"`
+}
+
+func (t *DocumentRangeFormattingRegistrationOptions) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["documentSelector"]; !exists {
+		return fmt.Errorf("missing required field: documentSelector")
+	}
+	type Alias DocumentRangeFormattingRegistrationOptions
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = DocumentRangeFormattingRegistrationOptions(test)
+
+	return nil
+}
+
+// Represents programming constructs like variables, classes, interfaces etc.
+// that appear in a document. Document symbols can be hierarchical and they
+// have two ranges: one that encloses its definition and one that points to
+// its most interesting range, e.g. the range of an identifier.
+type DocumentSymbol = protocol.DocumentSymbol
+
+// Client Capabilities for a {@link DocumentSymbolRequest}.
+type DocumentSymbolClientCapabilities = protocol.DocumentSymbolClientCapabilities
+
+// Provider options for a {@link DocumentSymbolRequest}.
+type DocumentSymbolOptions = protocol.DocumentSymbolOptions
+
+// Parameters for a {@link DocumentSymbolRequest}.
+type DocumentSymbolParams = protocol.DocumentSymbolParams
+
+// Registration options for a {@link DocumentSymbolRequest}.
+type DocumentSymbolRegistrationOptions struct {
+	// A document selector to identify the scope of the registration. If set to null
+	// the document selector provided on the client side will be used.
+	DocumentSelector *DocumentSelector `json:"documentSelector"`
+	// A human-readable string that is shown when multiple outlines trees
+	// are shown for the same document.
+	//
+	// @since 3.16.0
+	Label            string `json:"label,omitempty" since:"3.16.0"`
+	WorkDoneProgress bool   `json:"workDoneProgress,omitempty"`
+}
+
+func (t *DocumentSymbolRegistrationOptions) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["documentSelector"]; !exists {
+		return fmt.Errorf("missing required field: documentSelector")
+	}
+	type Alias DocumentSymbolRegistrationOptions
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = DocumentSymbolRegistrationOptions(test)
+
+	return nil
+}
+
+// The client capabilities of a {@link ExecuteCommandRequest}.
+type ExecuteCommandClientCapabilities = protocol.ExecuteCommandClientCapabilities
+
+// The server capabilities of a {@link ExecuteCommandRequest}.
+type ExecuteCommandOptions = protocol.ExecuteCommandOptions
+
+// The parameters of a {@link ExecuteCommandRequest}.
+type ExecuteCommandParams = protocol.ExecuteCommandParams
+
+// Registration options for a {@link ExecuteCommandRequest}.
+type ExecuteCommandRegistrationOptions = protocol.ExecuteCommandRegistrationOptions
+
+// Represents information on a file/folder create.
+//
+// @since 3.16.0
+type FileCreate = protocol.FileCreate
+
+// Represents information on a file/folder delete.
+//
+// @since 3.16.0
+type FileDelete = protocol.FileDelete
+
+// An event describing a file change.
+type FileEvent = protocol.FileEvent
+
+// Capabilities relating to events from file operations by the user in the client.
+//
+// These events do not come from the file system, they come from user operations
+// like renaming a file in the UI.
+//
+// @since 3.16.0
+type FileOperationClientCapabilities = protocol.FileOperationClientCapabilities
+
+// A filter to describe in which file operation requests or notifications
+// the server is interested in receiving.
+//
+// @since 3.16.0
+type FileOperationFilter = protocol.FileOperationFilter
+
+// Options for notifications/requests for user operations on files.
+//
+// @since 3.16.0
+type FileOperationOptions = protocol.FileOperationOptions
+
+// A pattern to describe in which file operation requests or notifications
+// the server is interested in receiving.
+//
+// @since 3.16.0
+type FileOperationPattern = protocol.FileOperationPattern
+
+// Matching options for the file operation pattern.
+//
+// @since 3.16.0
+type FileOperationPatternOptions = protocol.FileOperationPatternOptions
+
+// The options to register for file operations.
+//
+// @since 3.16.0
+type FileOperationRegistrationOptions = protocol.FileOperationRegistrationOptions
+
+// Represents information on a file/folder rename.
+//
+// @since 3.16.0
+type FileRename = protocol.FileRename
+
+type FileSystemWatcher struct {
+	// The glob pattern to watch. See {@link GlobPattern glob pattern} for more detail.
+	//
+	// @since 3.17.0 support for relative patterns.
+	GlobPattern GlobPattern `json:"globPattern"`
+	// The kind of events of interest. If omitted it defaults
+	// to WatchKind.Create | WatchKind.Change | WatchKind.Delete
+	// which is 7.
+	Kind *WatchKind `json:"kind,omitzero"`
+}
+
+func (t *FileSystemWatcher) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["globPattern"]; !exists {
+		return fmt.Errorf("missing required field: globPattern")
+	}
+	type Alias FileSystemWatcher
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = FileSystemWatcher(test)
+
+	return nil
+}
+
+// Represents a folding range. To be valid, start and end line must be bigger than zero and smaller
+// than the number of lines in the document. Clients are free to ignore invalid ranges.
+type FoldingRange struct {
+	// The zero-based character offset before the folded range ends. If not defined, defaults to the length of the end line.
+	EndCharacter uint32 `json:"endCharacter,omitempty"`
+	// The zero-based end line of the range to fold. The folded area ends with the line's last character.
+	// To be valid, the end must be zero or larger and smaller than the number of lines in the document.
+	EndLine uint32 `json:"endLine"`
+	// Describes the kind of the folding range such as 'comment' or 'region'. The kind
+	// is used to categorize folding ranges and used by commands like 'Fold all comments'.
+	// See {@link FoldingRangeKind} for an enumeration of standardized kinds.
+	Kind *FoldingRangeKind `json:"kind,omitzero"`
+	// The zero-based character offset from where the folded range starts. If not defined, defaults to the length of the start line.
+	StartCharacter uint32 `json:"startCharacter,omitempty"`
+	// The zero-based start line of the range to fold. The folded area starts after the line's last character.
+	// To be valid, the end must be zero or larger and smaller than the number of lines in the document.
+	StartLine uint32 `json:"startLine"`
+}
+
+func (t *FoldingRange) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["endLine"]; !exists {
+		return fmt.Errorf("missing required field: endLine")
+	}
+	if _, exists := m["startLine"]; !exists {
+		return fmt.Errorf("missing required field: startLine")
+	}
+	type Alias FoldingRange
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = FoldingRange(test)
+
+	return nil
+}
+
+type FoldingRangeClientCapabilities struct {
+	// Whether implementation supports dynamic registration for folding range
+	// providers. If this is set to `true` the client supports the new
+	// `FoldingRangeRegistrationOptions` return value for the corresponding
+	// server capability as well.
+	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+	// If set, the client signals that it only supports folding complete lines.
+	// If set, client will ignore specified `startCharacter` and `endCharacter`
+	// properties in a FoldingRange.
+	LineFoldingOnly bool `json:"lineFoldingOnly,omitempty"`
+	// The maximum number of folding ranges that the client prefers to receive
+	// per document. The value serves as a hint, servers are free to follow the
+	// limit.
+	RangeLimit uint32 `json:"rangeLimit,omitempty"`
+}
+
+func (t *FoldingRangeClientCapabilities) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	type Alias FoldingRangeClientCapabilities
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = FoldingRangeClientCapabilities(test)
+
+	return nil
+}
+
+type FoldingRangeOptions = protocol.FoldingRangeOptions
+
+// Parameters for a {@link FoldingRangeRequest}.
+type FoldingRangeParams = protocol.FoldingRangeParams
+
+type FoldingRangeRegistrationOptions struct {
+	// A document selector to identify the scope of the registration. If set to null
+	// the document selector provided on the client side will be used.
+	DocumentSelector *DocumentSelector `json:"documentSelector"`
+	// The id used to register the request. The id can be used to deregister
+	// the request again. See also Registration#id.
+	Id               string `json:"id,omitempty"`
+	WorkDoneProgress bool   `json:"workDoneProgress,omitempty"`
+}
+
+func (t *FoldingRangeRegistrationOptions) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["documentSelector"]; !exists {
+		return fmt.Errorf("missing required field: documentSelector")
+	}
+	type Alias FoldingRangeRegistrationOptions
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = FoldingRangeRegistrationOptions(test)
+
+	return nil
+}
+
+// Value-object describing what options formatting should use.
+type FormattingOptions = protocol.FormattingOptions
+
+// General client capabilities.
+//
+// @since 3.16.0
+type GeneralClientCapabilities struct {
+	// Client capabilities specific to the client's markdown parser.
+	//
+	// @since 3.16.0
+	Markdown *MarkdownClientCapabilities `json:"markdown,omitzero" since:"3.16.0"`
+	// Client capabilities specific to regular expressions.
+	//
+	// @since 3.16.0
+	RegularExpressions *RegularExpressionsClientCapabilities `json:"regularExpressions,omitzero" since:"3.16.0"`
+}
+
+func (t *GeneralClientCapabilities) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	type Alias GeneralClientCapabilities
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = GeneralClientCapabilities(test)
+
+	return nil
+}
+
+// The result of a hover request.
+type Hover = protocol.Hover
+
+type HoverClientCapabilities = protocol.HoverClientCapabilities
+
+// Hover options.
+type HoverOptions = protocol.HoverOptions
+
+// Parameters for a {@link HoverRequest}.
+type HoverParams = protocol.HoverParams
+
+// Registration options for a {@link HoverRequest}.
+type HoverRegistrationOptions struct {
+	// A document selector to identify the scope of the registration. If set to null
+	// the document selector provided on the client side will be used.
+	DocumentSelector *DocumentSelector `json:"documentSelector"`
+	WorkDoneProgress bool              `json:"workDoneProgress,omitempty"`
+}
+
+func (t *HoverRegistrationOptions) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["documentSelector"]; !exists {
+		return fmt.Errorf("missing required field: documentSelector")
+	}
+	type Alias HoverRegistrationOptions
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = HoverRegistrationOptions(test)
+
+	return nil
+}
+
+// @since 3.6.0
+type ImplementationClientCapabilities = protocol.ImplementationClientCapabilities
+
+type ImplementationOptions = protocol.ImplementationOptions
+
+type ImplementationParams = protocol.ImplementationParams
+
+type ImplementationRegistrationOptions struct {
+	// A document selector to identify the scope of the registration. If set to null
+	// the document selector provided on the client side will be used.
+	DocumentSelector *DocumentSelector `json:"documentSelector"`
+	// The id used to register the request. The id can be used to deregister
+	// the request again. See also Registration#id.
+	Id               string `json:"id,omitempty"`
+	WorkDoneProgress bool   `json:"workDoneProgress,omitempty"`
+}
+
+func (t *ImplementationRegistrationOptions) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["documentSelector"]; !exists {
+		return fmt.Errorf("missing required field: documentSelector")
+	}
+	type Alias ImplementationRegistrationOptions
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = ImplementationRegistrationOptions(test)
+
+	return nil
+}
+
+// The data type of the ResponseError if the
+// initialize request fails.
+type InitializeError = protocol.InitializeError
+
+type InitializeParams struct {
+	// The capabilities provided by the client (editor or tool)
+	Capabilities ClientCapabilities `json:"capabilities"`
+	// Information about the client
+	//
+	// @since 3.15.0
+	ClientInfo *ClientInfo `json:"clientInfo,omitzero" since:"3.15.0"`
+	// User provided initialization options.
+	InitializationOptions any `json:"initializationOptions,omitempty"`
+	// The locale the client is currently showing the user interface
+	// in. This must not necessarily be the locale of the operating
+	// system.
+	//
+	// Uses IETF language tags as the value's syntax
+	// (See https://en.wikipedia.org/wiki/IETF_language_tag)
+	//
+	// @since 3.16.0
+	Locale string `json:"locale,omitempty" since:"3.16.0"`
+	// The process Id of the parent process that started
+	// the server.
+	//
+	// Is `null` if the process has not been started by another process.
+	// If the parent process is not alive then the server should exit.
+	ProcessId *int32 `json:"processId"`
+	// The rootPath of the workspace. Is null
+	// if no folder is open.
+	//
+	// @deprecated in favour of rootUri.
+	RootPath *string `json:"rootPath,omitzero"`
+	// The rootUri of the workspace. Is null if no
+	// folder is open. If both `rootPath` and `rootUri` are set
+	// `rootUri` wins.
+	//
+	// @deprecated in favour of workspaceFolders.
+	RootUri *DocumentUri `json:"rootUri"`
+	// The initial trace setting. If omitted trace is disabled ('off').
+	Trace *TraceValue `json:"trace,omitzero"`
+	// An optional token that a server can use to report work done progress.
+	WorkDoneToken *ProgressToken `json:"workDoneToken,omitzero"`
+	// The workspace folders configured in the client when the server starts.
+	//
+	// This property is only available if the client supports workspace folders.
+	// It can be `null` if the client supports workspace folders but none are
+	// configured.
+	//
+	// @since 3.6.0
+	WorkspaceFolders *[]WorkspaceFolder `json:"workspaceFolders,omitzero" since:"3.6.0"`
+}
+
+func (t *InitializeParams) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["capabilities"]; !exists {
+		return fmt.Errorf("missing required field: capabilities")
+	}
+	if _, exists := m["processId"]; !exists {
+		return fmt.Errorf("missing required field: processId")
+	}
+	if _, exists := m["rootUri"]; !exists {
+		return fmt.Errorf("missing required field: rootUri")
+	}
+	type Alias InitializeParams
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = InitializeParams(test)
+
+	return nil
+}
+
+// The result returned from an initialize request.
+type InitializeResult struct {
+	// The capabilities the language server provides.
+	Capabilities ServerCapabilities `json:"capabilities"`
+	// Information about the server.
+	//
+	// @since 3.15.0
+	ServerInfo *ServerInfo `json:"serverInfo,omitzero" since:"3.15.0"`
+}
+
+func (t *InitializeResult) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["capabilities"]; !exists {
+		return fmt.Errorf("missing required field: capabilities")
+	}
+	type Alias InitializeResult
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = InitializeResult(test)
+
+	return nil
+}
+
+type InitializedParams = protocol.InitializedParams
+
+// A special text edit to provide an insert and a replace operation.
+//
+// @since 3.16.0
+type InsertReplaceEdit = protocol.InsertReplaceEdit
+
+// Client capabilities for the linked editing range request.
+//
+// @since 3.16.0
+type LinkedEditingRangeClientCapabilities = protocol.LinkedEditingRangeClientCapabilities
+
+type LinkedEditingRangeOptions = protocol.LinkedEditingRangeOptions
+
+type LinkedEditingRangeParams = protocol.LinkedEditingRangeParams
+
+type LinkedEditingRangeRegistrationOptions struct {
+	// A document selector to identify the scope of the registration. If set to null
+	// the document selector provided on the client side will be used.
+	DocumentSelector *DocumentSelector `json:"documentSelector"`
+	// The id used to register the request. The id can be used to deregister
+	// the request again. See also Registration#id.
+	Id               string `json:"id,omitempty"`
+	WorkDoneProgress bool   `json:"workDoneProgress,omitempty"`
+}
+
+func (t *LinkedEditingRangeRegistrationOptions) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["documentSelector"]; !exists {
+		return fmt.Errorf("missing required field: documentSelector")
+	}
+	type Alias LinkedEditingRangeRegistrationOptions
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = LinkedEditingRangeRegistrationOptions(test)
+
+	return nil
+}
+
+// The result of a linked editing range request.
+//
+// @since 3.16.0
+type LinkedEditingRanges = protocol.LinkedEditingRanges
+
+// Represents a location inside a resource, such as a line
+// inside a text file.
+type Location = protocol.Location
+
+// Represents the connection of two locations. Provides additional metadata over normal {@link Location locations},
+// including an origin range.
+type LocationLink = protocol.LocationLink
+
+// The log message parameters.
+type LogMessageParams = protocol.LogMessageParams
+
+type LogTraceParams = protocol.LogTraceParams
+
+// Client capabilities specific to the used markdown parser.
+//
+// @since 3.16.0
+type MarkdownClientCapabilities struct {
+	// The name of the parser.
+	Parser string `json:"parser"`
+	// The version of the parser.
+	Version string `json:"version,omitempty"`
+}
+
+func (t *MarkdownClientCapabilities) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["parser"]; !exists {
+		return fmt.Errorf("missing required field: parser")
+	}
+	type Alias MarkdownClientCapabilities
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = MarkdownClientCapabilities(test)
+
+	return nil
+}
+
+// @since 3.18.0
+// @deprecated use MarkupContent instead.
+type MarkedStringWithLanguage = protocol.MarkedStringWithLanguage
+
+// A `MarkupContent` literal represents a string value which content is interpreted base on its
+// kind flag. Currently the protocol supports `plaintext` and `markdown` as markup kinds.
+//
+// If the kind is `markdown` then the value can contain fenced code blocks like in GitHub issues.
+// See https://help.github.com/articles/creating-and-highlighting-code-blocks/#syntax-highlighting
+//
+// Here is an example how such a string can be constructed using JavaScript / TypeScript:
+// ```ts
+//
+//	let markdown: MarkdownContent = {
+//	 kind: MarkupKind.Markdown,
+//	 value: [
+//	   '# Header',
+//	   'Some text',
+//	   '```typescript',
+//	   'someCode();',
+//	   '```'
+//	 ].join('\n')
+//	};
+//
+// ```
+//
+// *Please Note* that clients might sanitize the return markdown. A client could decide to
+// remove HTML from the markdown to avoid script execution.
+type MarkupContent = protocol.MarkupContent
+
+type MessageActionItem = protocol.MessageActionItem
+
+// Moniker definition to match LSIF 0.5 moniker definition.
+//
+// @since 3.16.0
+type Moniker = protocol.Moniker
+
+// Client capabilities specific to the moniker request.
+//
+// @since 3.16.0
+type MonikerClientCapabilities = protocol.MonikerClientCapabilities
+
+type MonikerOptions = protocol.MonikerOptions
+
+type MonikerParams = protocol.MonikerParams
+
+type MonikerRegistrationOptions struct {
+	// A document selector to identify the scope of the registration. If set to null
+	// the document selector provided on the client side will be used.
+	DocumentSelector *DocumentSelector `json:"documentSelector"`
+	WorkDoneProgress bool              `json:"workDoneProgress,omitempty"`
+}
+
+func (t *MonikerRegistrationOptions) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["documentSelector"]; !exists {
+		return fmt.Errorf("missing required field: documentSelector")
+	}
+	type Alias MonikerRegistrationOptions
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = MonikerRegistrationOptions(test)
+
+	return nil
+}
+
+// A text document identifier to optionally denote a specific version of a text document.
+type OptionalVersionedTextDocumentIdentifier = protocol.OptionalVersionedTextDocumentIdentifier
+
+// Represents a parameter of a callable-signature. A parameter can
+// have a label and a doc-comment.
+type ParameterInformation = protocol.ParameterInformation
+
+type PartialResultParams = protocol.PartialResultParams
+
+// Position in a text document expressed as zero-based line and character
+// offset. Prior to 3.17 the offsets were always based on a UTF-16 string
+// representation. So a string of the form `a𐐀b` the character offset of the
+// character `a` is 0, the character offset of `𐐀` is 1 and the character
+// offset of b is 3 since `𐐀` is represented using two code units in UTF-16.
+// Since 3.17 clients and servers can agree on a different string encoding
+// representation (e.g. UTF-8). The client announces it's supported encoding
+// via the client capability [`general.positionEncodings`](https://microsoft.github.io/language-server-protocol/specifications/specification-current/#clientCapabilities).
+// The value is an array of position encodings the client supports, with
+// decreasing preference (e.g. the encoding at index `0` is the most preferred
+// one). To stay backwards compatible the only mandatory encoding is UTF-16
+// represented via the string `utf-16`. The server can pick one of the
+// encodings offered by the client and signals that encoding back to the
+// client via the initialize result's property
+// [`capabilities.positionEncoding`](https://microsoft.github.io/language-server-protocol/specifications/specification-current/#serverCapabilities). If the string value
+// `utf-16` is missing from the client's capability `general.positionEncodings`
+// servers can safely assume that the client supports UTF-16. If the server
+// omits the position encoding in its initialize result the encoding defaults
+// to the string value `utf-16`. Implementation considerations: since the
+// conversion from one encoding into another requires the content of the
+// file / line the conversion is best done where the file is read which is
+// usually on the server side.
+//
+// Positions are line end character agnostic. So you can not specify a position
+// that denotes `\r|\n` or `\n|` where `|` represents the character offset.
+//
+// @since 3.17.0 - support for negotiated position encoding.
+type Position = protocol.Position
+
+// @since 3.18.0
+type PrepareRenameDefaultBehavior = protocol.PrepareRenameDefaultBehavior
+
+type PrepareRenameParams = protocol.PrepareRenameParams
+
+// @since 3.18.0
+type PrepareRenamePlaceholder = protocol.PrepareRenamePlaceholder
+
+type ProgressParams = protocol.ProgressParams
+
+// The publish diagnostic client capabilities.
+type PublishDiagnosticsClientCapabilities = protocol.PublishDiagnosticsClientCapabilities
+
+// The publish diagnostic notification's parameters.
+type PublishDiagnosticsParams = protocol.PublishDiagnosticsParams
+
+// A range in a text document expressed as (zero-based) start and end positions.
+//
+// If you want to specify a range that contains a line including the line ending
+// character(s) then use an end position denoting the start of the next line.
+// For example:
+// ```ts
+//
+//	{
+//	    start: { line: 5, character: 23 }
+//	    end : { line 6, character : 0 }
+//	}
+//
+// ```
+type Range = protocol.Range
+
+// Client Capabilities for a {@link ReferencesRequest}.
+type ReferenceClientCapabilities = protocol.ReferenceClientCapabilities
+
+// Value-object that contains additional information when
+// requesting references.
+type ReferenceContext = protocol.ReferenceContext
+
+// Reference options.
+type ReferenceOptions = protocol.ReferenceOptions
+
+// Parameters for a {@link ReferencesRequest}.
+type ReferenceParams = protocol.ReferenceParams
+
+// Registration options for a {@link ReferencesRequest}.
+type ReferenceRegistrationOptions struct {
+	// A document selector to identify the scope of the registration. If set to null
+	// the document selector provided on the client side will be used.
+	DocumentSelector *DocumentSelector `json:"documentSelector"`
+	WorkDoneProgress bool              `json:"workDoneProgress,omitempty"`
+}
+
+func (t *ReferenceRegistrationOptions) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["documentSelector"]; !exists {
+		return fmt.Errorf("missing required field: documentSelector")
+	}
+	type Alias ReferenceRegistrationOptions
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = ReferenceRegistrationOptions(test)
+
+	return nil
+}
+
+// General parameters to register for a notification or to register a provider.
+type Registration = protocol.Registration
+
+type RegistrationParams = protocol.RegistrationParams
+
+// Client capabilities specific to regular expressions.
+//
+// @since 3.16.0
+type RegularExpressionsClientCapabilities = protocol.RegularExpressionsClientCapabilities
+
+type RenameClientCapabilities = protocol.RenameClientCapabilities
+
+// Rename file operation
+type RenameFile = protocol.RenameFile
+
+// Rename file options
+type RenameFileOptions = protocol.RenameFileOptions
+
+// The parameters sent in notifications/requests for user-initiated renames of
+// files.
+//
+// @since 3.16.0
+type RenameFilesParams = protocol.RenameFilesParams
+
+// Provider options for a {@link RenameRequest}.
+type RenameOptions = protocol.RenameOptions
+
+// The parameters of a {@link RenameRequest}.
+type RenameParams = protocol.RenameParams
+
+// Registration options for a {@link RenameRequest}.
+type RenameRegistrationOptions struct {
+	// A document selector to identify the scope of the registration. If set to null
+	// the document selector provided on the client side will be used.
+	DocumentSelector *DocumentSelector `json:"documentSelector"`
+	// Renames should be checked and tested before being executed.
+	//
+	// @since version 3.12.0
+	PrepareProvider  bool `json:"prepareProvider,omitempty" since:"3.12.0"`
+	WorkDoneProgress bool `json:"workDoneProgress,omitempty"`
+}
+
+func (t *RenameRegistrationOptions) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["documentSelector"]; !exists {
+		return fmt.Errorf("missing required field: documentSelector")
+	}
+	type Alias RenameRegistrationOptions
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = RenameRegistrationOptions(test)
+
+	return nil
+}
+
+// A generic resource operation.
+type ResourceOperation = protocol.ResourceOperation
+
+// Save options.
+type SaveOptions = protocol.SaveOptions
+
+// A selection range represents a part of a selection hierarchy. A selection range
+// may have a parent selection range that contains it.
+type SelectionRange = protocol.SelectionRange
+
+type SelectionRangeClientCapabilities = protocol.SelectionRangeClientCapabilities
+
+type SelectionRangeOptions = protocol.SelectionRangeOptions
+
+// A parameter literal used in selection range requests.
+type SelectionRangeParams = protocol.SelectionRangeParams
+
+type SelectionRangeRegistrationOptions struct {
+	// A document selector to identify the scope of the registration. If set to null
+	// the document selector provided on the client side will be used.
+	DocumentSelector *DocumentSelector `json:"documentSelector"`
+	// The id used to register the request. The id can be used to deregister
+	// the request again. See also Registration#id.
+	Id               string `json:"id,omitempty"`
+	WorkDoneProgress bool   `json:"workDoneProgress,omitempty"`
+}
+
+func (t *SelectionRangeRegistrationOptions) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["documentSelector"]; !exists {
+		return fmt.Errorf("missing required field: documentSelector")
+	}
+	type Alias SelectionRangeRegistrationOptions
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = SelectionRangeRegistrationOptions(test)
+
+	return nil
+}
+
+// @since 3.16.0
+type SemanticTokens = protocol.SemanticTokens
+
+// @since 3.16.0
+type SemanticTokensClientCapabilities struct {
+	// Whether implementation supports dynamic registration. If this is set to `true`
+	// the client supports the new `(TextDocumentRegistrationOptions & StaticRegistrationOptions)`
+	// return value for the corresponding server capability as well.
+	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+	// The token formats the clients supports.
+	Formats []TokenFormat `json:"formats"`
+	// Whether the client supports tokens that can span multiple lines.
+	MultilineTokenSupport bool `json:"multilineTokenSupport,omitempty"`
+	// Whether the client supports tokens that can overlap each other.
+	OverlappingTokenSupport bool `json:"overlappingTokenSupport,omitempty"`
+	// Which requests the client supports and might send to the server
+	// depending on the server's capability. Please note that clients might not
+	// show semantic tokens or degrade some of the user experience if a range
+	// or full request is advertised by the client but not provided by the
+	// server. If for example the client capability `requests.full` and
+	// `request.range` are both set to true but the server only provides a
+	// range provider the client might not render a minimap correctly or might
+	// even decide to not show any semantic tokens at all.
+	Requests ClientSemanticTokensRequestOptions `json:"requests"`
+	// The token modifiers that the client supports.
+	TokenModifiers []string `json:"tokenModifiers"`
+	// The token types that the client supports.
+	TokenTypes []string `json:"tokenTypes"`
+}
+
+func (t *SemanticTokensClientCapabilities) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["formats"]; !exists {
+		return fmt.Errorf("missing required field: formats")
+	}
+	if _, exists := m["requests"]; !exists {
+		return fmt.Errorf("missing required field: requests")
+	}
+	if _, exists := m["tokenModifiers"]; !exists {
+		return fmt.Errorf("missing required field: tokenModifiers")
+	}
+	if _, exists := m["tokenTypes"]; !exists {
+		return fmt.Errorf("missing required field: tokenTypes")
+	}
+	type Alias SemanticTokensClientCapabilities
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = SemanticTokensClientCapabilities(test)
+
+	return nil
+}
+
+// @since 3.16.0
+type SemanticTokensDelta = protocol.SemanticTokensDelta
+
+// @since 3.16.0
+type SemanticTokensDeltaParams = protocol.SemanticTokensDeltaParams
+
+// @since 3.16.0
+type SemanticTokensDeltaPartialResult = protocol.SemanticTokensDeltaPartialResult
+
+// @since 3.16.0
+type SemanticTokensEdit = protocol.SemanticTokensEdit
+
+// Semantic tokens options to support deltas for full documents
+//
+// @since 3.18.0
+type SemanticTokensFullDelta = protocol.SemanticTokensFullDelta
+
+// @since 3.16.0
+type SemanticTokensLegend = protocol.SemanticTokensLegend
+
+// @since 3.16.0
+type SemanticTokensOptions = protocol.SemanticTokensOptions
+
+// @since 3.16.0
+type SemanticTokensParams = protocol.SemanticTokensParams
+
+// @since 3.16.0
+type SemanticTokensPartialResult = protocol.SemanticTokensPartialResult
+
+// @since 3.16.0
+type SemanticTokensRangeParams = protocol.SemanticTokensRangeParams
+
+// @since 3.16.0
+type SemanticTokensRegistrationOptions struct {
+	// A document selector to identify the scope of the registration. If set to null
+	// the document selector provided on the client side will be used.
+	DocumentSelector *DocumentSelector `json:"documentSelector"`
+	// Server supports providing semantic tokens for a full document.
+	Full *protocol.Or2[bool, SemanticTokensFullDelta] `json:"full,omitzero"`
+	// The id used to register the request. The id can be used to deregister
+	// the request again. See also Registration#id.
+	Id string `json:"id,omitempty"`
+	// The legend used by the server
+	Legend SemanticTokensLegend `json:"legend"`
+	// Server supports providing semantic tokens for a specific range
+	// of a document.
+	Range            *protocol.Or2[bool, LSPObject] `json:"range,omitzero"`
+	WorkDoneProgress bool                           `json:"workDoneProgress,omitempty"`
+}
+
+func (t *SemanticTokensRegistrationOptions) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["documentSelector"]; !exists {
+		return fmt.Errorf("missing required field: documentSelector")
+	}
+	if _, exists := m["legend"]; !exists {
+		return fmt.Errorf("missing required field: legend")
+	}
+	type Alias SemanticTokensRegistrationOptions
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = SemanticTokensRegistrationOptions(test)
+
+	return nil
+}
+
+// @since 3.16.0
+type SemanticTokensWorkspaceClientCapabilities = protocol.SemanticTokensWorkspaceClientCapabilities
+
+// Defines the capabilities provided by a language
+// server.
+type ServerCapabilities struct {
+	// The server provides call hierarchy support.
+	//
+	// @since 3.16.0
+	CallHierarchyProvider *protocol.Or3[bool, CallHierarchyOptions, CallHierarchyRegistrationOptions] `json:"callHierarchyProvider,omitzero" since:"3.16.0"`
+	// The server provides code actions. CodeActionOptions may only be
+	// specified if the client states that it supports
+	// `codeActionLiteralSupport` in its initial `initialize` request.
+	CodeActionProvider *protocol.Or2[bool, CodeActionOptions] `json:"codeActionProvider,omitzero"`
+	// The server provides code lens.
+	CodeLensProvider *CodeLensOptions `json:"codeLensProvider,omitzero"`
+	// The server provides color provider support.
+	ColorProvider *protocol.Or3[bool, DocumentColorOptions, DocumentColorRegistrationOptions] `json:"colorProvider,omitzero"`
+	// The server provides completion support.
+	CompletionProvider *CompletionOptions `json:"completionProvider,omitzero"`
+	// The server provides Goto Declaration support.
+	DeclarationProvider *protocol.Or3[bool, DeclarationOptions, DeclarationRegistrationOptions] `json:"declarationProvider,omitzero"`
+	// The server provides goto definition support.
+	DefinitionProvider *protocol.Or2[bool, DefinitionOptions] `json:"definitionProvider,omitzero"`
+	// The server provides document formatting.
+	DocumentFormattingProvider *protocol.Or2[bool, DocumentFormattingOptions] `json:"documentFormattingProvider,omitzero"`
+	// The server provides document highlight support.
+	DocumentHighlightProvider *protocol.Or2[bool, DocumentHighlightOptions] `json:"documentHighlightProvider,omitzero"`
+	// The server provides document link support.
+	DocumentLinkProvider *DocumentLinkOptions `json:"documentLinkProvider,omitzero"`
+	// The server provides document formatting on typing.
+	DocumentOnTypeFormattingProvider *DocumentOnTypeFormattingOptions `json:"documentOnTypeFormattingProvider,omitzero"`
+	// The server provides document range formatting.
+	DocumentRangeFormattingProvider *protocol.Or2[bool, DocumentRangeFormattingOptions] `json:"documentRangeFormattingProvider,omitzero"`
+	// The server provides document symbol support.
+	DocumentSymbolProvider *protocol.Or2[bool, DocumentSymbolOptions] `json:"documentSymbolProvider,omitzero"`
+	// The server provides execute command support.
+	ExecuteCommandProvider *ExecuteCommandOptions `json:"executeCommandProvider,omitzero"`
+	// Experimental server capabilities.
+	Experimental any `json:"experimental,omitempty"`
+	// The server provides folding provider support.
+	FoldingRangeProvider *protocol.Or3[bool, FoldingRangeOptions, FoldingRangeRegistrationOptions] `json:"foldingRangeProvider,omitzero"`
+	// The server provides hover support.
+	HoverProvider *protocol.Or2[bool, HoverOptions] `json:"hoverProvider,omitzero"`
+	// The server provides Goto Implementation support.
+	ImplementationProvider *protocol.Or3[bool, ImplementationOptions, ImplementationRegistrationOptions] `json:"implementationProvider,omitzero"`
+	// The server provides linked editing range support.
+	//
+	// @since 3.16.0
+	LinkedEditingRangeProvider *protocol.Or3[bool, LinkedEditingRangeOptions, LinkedEditingRangeRegistrationOptions] `json:"linkedEditingRangeProvider,omitzero" since:"3.16.0"`
+	// The server provides moniker support.
+	//
+	// @since 3.16.0
+	MonikerProvider *protocol.Or3[bool, MonikerOptions, MonikerRegistrationOptions] `json:"monikerProvider,omitzero" since:"3.16.0"`
+	// The server provides find references support.
+	ReferencesProvider *protocol.Or2[bool, ReferenceOptions] `json:"referencesProvider,omitzero"`
+	// The server provides rename support. RenameOptions may only be
+	// specified if the client states that it supports
+	// `prepareSupport` in its initial `initialize` request.
+	RenameProvider *protocol.Or2[bool, RenameOptions] `json:"renameProvider,omitzero"`
+	// The server provides selection range support.
+	SelectionRangeProvider *protocol.Or3[bool, SelectionRangeOptions, SelectionRangeRegistrationOptions] `json:"selectionRangeProvider,omitzero"`
+	// The server provides semantic tokens support.
+	//
+	// @since 3.16.0
+	SemanticTokensProvider *protocol.Or2[SemanticTokensOptions, SemanticTokensRegistrationOptions] `json:"semanticTokensProvider,omitzero" since:"3.16.0"`
+	// The server provides signature help support.
+	SignatureHelpProvider *SignatureHelpOptions `json:"signatureHelpProvider,omitzero"`
+	// Defines how text documents are synced. Is either a detailed structure
+	// defining each notification or for backwards compatibility the
+	// TextDocumentSyncKind number.
+	TextDocumentSync *protocol.Or2[TextDocumentSyncOptions, TextDocumentSyncKind] `json:"textDocumentSync,omitzero"`
+	// The server provides Goto Type Definition support.
+	TypeDefinitionProvider *protocol.Or3[bool, TypeDefinitionOptions, TypeDefinitionRegistrationOptions] `json:"typeDefinitionProvider,omitzero"`
+	// Workspace specific server capabilities.
+	Workspace *WorkspaceOptions `json:"workspace,omitzero"`
+	// The server provides workspace symbol support.
+	WorkspaceSymbolProvider *protocol.Or2[bool, WorkspaceSymbolOptions] `json:"workspaceSymbolProvider,omitzero"`
+}
+
+func (t *ServerCapabilities) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	type Alias ServerCapabilities
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = ServerCapabilities(test)
+
+	return nil
+}
+
+// Information about the server
+//
+// @since 3.15.0
+// @since 3.18.0 ServerInfo type name added.
+type ServerInfo = protocol.ServerInfo
+
+type SetTraceParams = protocol.SetTraceParams
+
+// Client capabilities for the showDocument request.
+//
+// @since 3.16.0
+type ShowDocumentClientCapabilities = protocol.ShowDocumentClientCapabilities
+
+// Params to show a resource in the UI.
+//
+// @since 3.16.0
+type ShowDocumentParams = protocol.ShowDocumentParams
+
+// The result of a showDocument request.
+//
+// @since 3.16.0
+type ShowDocumentResult = protocol.ShowDocumentResult
+
+// The parameters of a notification message.
+type ShowMessageParams = protocol.ShowMessageParams
+
+// Show message request client capabilities
+type ShowMessageRequestClientCapabilities = protocol.ShowMessageRequestClientCapabilities
+
+type ShowMessageRequestParams = protocol.ShowMessageRequestParams
+
+// Signature help represents the signature of something
+// callable. There can be multiple signature but only one
+// active and only one active parameter.
+type SignatureHelp = protocol.SignatureHelp
+
+// Client Capabilities for a {@link SignatureHelpRequest}.
+type SignatureHelpClientCapabilities struct {
+	// The client supports to send additional context information for a
+	// `textDocument/signatureHelp` request. A client that opts into
+	// contextSupport will also support the `retriggerCharacters` on
+	// `SignatureHelpOptions`.
+	//
+	// @since 3.15.0
+	ContextSupport bool `json:"contextSupport,omitempty" since:"3.15.0"`
+	// Whether signature help supports dynamic registration.
+	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+	// The client supports the following `SignatureInformation`
+	// specific properties.
+	SignatureInformation *ClientSignatureInformationOptions `json:"signatureInformation,omitzero"`
+}
+
+func (t *SignatureHelpClientCapabilities) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	type Alias SignatureHelpClientCapabilities
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = SignatureHelpClientCapabilities(test)
+
+	return nil
+}
+
+// Additional information about the context in which a signature help request was triggered.
+//
+// @since 3.15.0
+type SignatureHelpContext = protocol.SignatureHelpContext
+
+// Server Capabilities for a {@link SignatureHelpRequest}.
+type SignatureHelpOptions = protocol.SignatureHelpOptions
+
+// Parameters for a {@link SignatureHelpRequest}.
+type SignatureHelpParams = protocol.SignatureHelpParams
+
+// Registration options for a {@link SignatureHelpRequest}.
+type SignatureHelpRegistrationOptions struct {
+	// A document selector to identify the scope of the registration. If set to null
+	// the document selector provided on the client side will be used.
+	DocumentSelector *DocumentSelector `json:"documentSelector"`
+	// List of characters that re-trigger signature help.
+	//
+	// These trigger characters are only active when signature help is already showing. All trigger characters
+	// are also counted as re-trigger characters.
+	//
+	// @since 3.15.0
+	RetriggerCharacters []string `json:"retriggerCharacters,omitzero" since:"3.15.0"`
+	// List of characters that trigger signature help automatically.
+	TriggerCharacters []string `json:"triggerCharacters,omitzero"`
+	WorkDoneProgress  bool     `json:"workDoneProgress,omitempty"`
+}
+
+func (t *SignatureHelpRegistrationOptions) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["documentSelector"]; !exists {
+		return fmt.Errorf("missing required field: documentSelector")
+	}
+	type Alias SignatureHelpRegistrationOptions
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = SignatureHelpRegistrationOptions(test)
+
+	return nil
+}
+
+// Represents the signature of something callable. A signature
+// can have a label, like a function-name, a doc-comment, and
+// a set of parameters.
+type SignatureInformation = protocol.SignatureInformation
+
+// Static registration options to be returned in the initialize
+// request.
+type StaticRegistrationOptions = protocol.StaticRegistrationOptions
+
+// Represents information about programming constructs like variables, classes,
+// interfaces etc.
+type SymbolInformation = protocol.SymbolInformation
+
+// Describe options to be used when registered for text document change events.
+type TextDocumentChangeRegistrationOptions struct {
+	// A document selector to identify the scope of the registration. If set to null
+	// the document selector provided on the client side will be used.
+	DocumentSelector *DocumentSelector `json:"documentSelector"`
+	// How documents are synced to the server.
+	SyncKind TextDocumentSyncKind `json:"syncKind"`
+}
+
+func (t *TextDocumentChangeRegistrationOptions) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["documentSelector"]; !exists {
+		return fmt.Errorf("missing required field: documentSelector")
+	}
+	if _, exists := m["syncKind"]; !exists {
+		return fmt.Errorf("missing required field: syncKind")
+	}
+	type Alias TextDocumentChangeRegistrationOptions
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = TextDocumentChangeRegistrationOptions(test)
+
+	return nil
+}
+
+// Text document specific client capabilities.
+type TextDocumentClientCapabilities struct {
+	// Capabilities specific to the various call hierarchy requests.
+	//
+	// @since 3.16.0
+	CallHierarchy *CallHierarchyClientCapabilities `json:"callHierarchy,omitzero" since:"3.16.0"`
+	// Capabilities specific to the `textDocument/codeAction` request.
+	CodeAction *CodeActionClientCapabilities `json:"codeAction,omitzero"`
+	// Capabilities specific to the `textDocument/codeLens` request.
+	CodeLens *CodeLensClientCapabilities `json:"codeLens,omitzero"`
+	// Capabilities specific to the `textDocument/documentColor` and the
+	// `textDocument/colorPresentation` request.
+	//
+	// @since 3.6.0
+	ColorProvider *DocumentColorClientCapabilities `json:"colorProvider,omitzero" since:"3.6.0"`
+	// Capabilities specific to the `textDocument/completion` request.
+	Completion *CompletionClientCapabilities `json:"completion,omitzero"`
+	// Capabilities specific to the `textDocument/declaration` request.
+	//
+	// @since 3.14.0
+	Declaration *DeclarationClientCapabilities `json:"declaration,omitzero" since:"3.14.0"`
+	// Capabilities specific to the `textDocument/definition` request.
+	Definition *DefinitionClientCapabilities `json:"definition,omitzero"`
+	// Capabilities specific to the `textDocument/documentHighlight` request.
+	DocumentHighlight *DocumentHighlightClientCapabilities `json:"documentHighlight,omitzero"`
+	// Capabilities specific to the `textDocument/documentLink` request.
+	DocumentLink *DocumentLinkClientCapabilities `json:"documentLink,omitzero"`
+	// Capabilities specific to the `textDocument/documentSymbol` request.
+	DocumentSymbol *DocumentSymbolClientCapabilities `json:"documentSymbol,omitzero"`
+	// Capabilities specific to the `textDocument/foldingRange` request.
+	//
+	// @since 3.10.0
+	FoldingRange *FoldingRangeClientCapabilities `json:"foldingRange,omitzero" since:"3.10.0"`
+	// Capabilities specific to the `textDocument/formatting` request.
+	Formatting *DocumentFormattingClientCapabilities `json:"formatting,omitzero"`
+	// Capabilities specific to the `textDocument/hover` request.
+	Hover *HoverClientCapabilities `json:"hover,omitzero"`
+	// Capabilities specific to the `textDocument/implementation` request.
+	//
+	// @since 3.6.0
+	Implementation *ImplementationClientCapabilities `json:"implementation,omitzero" since:"3.6.0"`
+	// Capabilities specific to the `textDocument/linkedEditingRange` request.
+	//
+	// @since 3.16.0
+	LinkedEditingRange *LinkedEditingRangeClientCapabilities `json:"linkedEditingRange,omitzero" since:"3.16.0"`
+	// Client capabilities specific to the `textDocument/moniker` request.
+	//
+	// @since 3.16.0
+	Moniker *MonikerClientCapabilities `json:"moniker,omitzero" since:"3.16.0"`
+	// Capabilities specific to the `textDocument/onTypeFormatting` request.
+	OnTypeFormatting *DocumentOnTypeFormattingClientCapabilities `json:"onTypeFormatting,omitzero"`
+	// Capabilities specific to the `textDocument/publishDiagnostics` notification.
+	PublishDiagnostics *PublishDiagnosticsClientCapabilities `json:"publishDiagnostics,omitzero"`
+	// Capabilities specific to the `textDocument/rangeFormatting` request.
+	RangeFormatting *DocumentRangeFormattingClientCapabilities `json:"rangeFormatting,omitzero"`
+	// Capabilities specific to the `textDocument/references` request.
+	References *ReferenceClientCapabilities `json:"references,omitzero"`
+	// Capabilities specific to the `textDocument/rename` request.
+	Rename *RenameClientCapabilities `json:"rename,omitzero"`
+	// Capabilities specific to the `textDocument/selectionRange` request.
+	//
+	// @since 3.15.0
+	SelectionRange *SelectionRangeClientCapabilities `json:"selectionRange,omitzero" since:"3.15.0"`
+	// Capabilities specific to the various semantic token request.
+	//
+	// @since 3.16.0
+	SemanticTokens *SemanticTokensClientCapabilities `json:"semanticTokens,omitzero" since:"3.16.0"`
+	// Capabilities specific to the `textDocument/signatureHelp` request.
+	SignatureHelp *SignatureHelpClientCapabilities `json:"signatureHelp,omitzero"`
+	// Defines which synchronization capabilities the client supports.
+	Synchronization *TextDocumentSyncClientCapabilities `json:"synchronization,omitzero"`
+	// Capabilities specific to the `textDocument/typeDefinition` request.
+	//
+	// @since 3.6.0
+	TypeDefinition *TypeDefinitionClientCapabilities `json:"typeDefinition,omitzero" since:"3.6.0"`
+}
+
+func (t *TextDocumentClientCapabilities) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	type Alias TextDocumentClientCapabilities
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = TextDocumentClientCapabilities(test)
+
+	return nil
+}
+
+// @since 3.18.0
+type TextDocumentContentChangePartial = protocol.TextDocumentContentChangePartial
+
+// @since 3.18.0
+type TextDocumentContentChangeWholeDocument = protocol.TextDocumentContentChangeWholeDocument
+
+// Describes textual changes on a text document. A TextDocumentEdit describes all changes
+// on a document version Si and after they are applied move the document to version Si+1.
+// So the creator of a TextDocumentEdit doesn't need to sort the array of edits or do any
+// kind of ordering. However the edits must be non overlapping.
+type TextDocumentEdit struct {
+	// The edits to be applied.
+	//
+	// @since 3.16.0 - support for AnnotatedTextEdit. This is guarded using a
+	// client capability.
+	//
+	// @since 3.18.0 - support for SnippetTextEdit. This is guarded using a
+	// client capability.
+	Edits []protocol.Or2[TextEdit, AnnotatedTextEdit] `json:"edits"`
+	// The text document to change.
+	TextDocument OptionalVersionedTextDocumentIdentifier `json:"textDocument"`
+}
+
+func (t *TextDocumentEdit) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["edits"]; !exists {
+		return fmt.Errorf("missing required field: edits")
+	}
+	if _, exists := m["textDocument"]; !exists {
+		return fmt.Errorf("missing required field: textDocument")
+	}
+	type Alias TextDocumentEdit
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = TextDocumentEdit(test)
+
+	return nil
+}
+
+// A document filter where `language` is required field.
+//
+// @since 3.18.0
+type TextDocumentFilterLanguage struct {
+	// A language id, like `typescript`.
+	Language string `json:"language"`
+	// A glob pattern, like **​/*.{ts,js}. See TextDocumentFilter for examples.
+	//
+	// @since 3.18.0 - support for relative patterns. Whether clients support
+	// relative patterns depends on the client capability
+	// `textDocuments.filters.relativePatternSupport`.
+	Pattern *GlobPattern `json:"pattern,omitzero"`
+	// A Uri {@link Uri.scheme scheme}, like `file` or `untitled`.
+	Scheme string `json:"scheme,omitempty"`
+}
+
+func (t *TextDocumentFilterLanguage) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["language"]; !exists {
+		return fmt.Errorf("missing required field: language")
+	}
+	type Alias TextDocumentFilterLanguage
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = TextDocumentFilterLanguage(test)
+
+	return nil
+}
+
+// A document filter where `pattern` is required field.
+//
+// @since 3.18.0
+type TextDocumentFilterPattern struct {
+	// A language id, like `typescript`.
+	Language string `json:"language,omitempty"`
+	// A glob pattern, like **​/*.{ts,js}. See TextDocumentFilter for examples.
+	//
+	// @since 3.18.0 - support for relative patterns. Whether clients support
+	// relative patterns depends on the client capability
+	// `textDocuments.filters.relativePatternSupport`.
+	Pattern GlobPattern `json:"pattern"`
+	// A Uri {@link Uri.scheme scheme}, like `file` or `untitled`.
+	Scheme string `json:"scheme,omitempty"`
+}
+
+func (t *TextDocumentFilterPattern) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["pattern"]; !exists {
+		return fmt.Errorf("missing required field: pattern")
+	}
+	type Alias TextDocumentFilterPattern
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = TextDocumentFilterPattern(test)
+
+	return nil
+}
+
+// A document filter where `scheme` is required field.
+//
+// @since 3.18.0
+type TextDocumentFilterScheme struct {
+	// A language id, like `typescript`.
+	Language string `json:"language,omitempty"`
+	// A glob pattern, like **​/*.{ts,js}. See TextDocumentFilter for examples.
+	//
+	// @since 3.18.0 - support for relative patterns. Whether clients support
+	// relative patterns depends on the client capability
+	// `textDocuments.filters.relativePatternSupport`.
+	Pattern *GlobPattern `json:"pattern,omitzero"`
+	// A Uri {@link Uri.scheme scheme}, like `file` or `untitled`.
+	Scheme string `json:"scheme"`
+}
+
+func (t *TextDocumentFilterScheme) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["scheme"]; !exists {
+		return fmt.Errorf("missing required field: scheme")
+	}
+	type Alias TextDocumentFilterScheme
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = TextDocumentFilterScheme(test)
+
+	return nil
+}
+
+// A literal to identify a text document in the client.
+type TextDocumentIdentifier = protocol.TextDocumentIdentifier
+
+// An item to transfer a text document from the client to the
+// server.
+type TextDocumentItem = protocol.TextDocumentItem
+
+// A parameter literal used in requests to pass a text document and a position inside that
+// document.
+type TextDocumentPositionParams = protocol.TextDocumentPositionParams
+
+// General text document registration options.
+type TextDocumentRegistrationOptions struct {
+	// A document selector to identify the scope of the registration. If set to null
+	// the document selector provided on the client side will be used.
+	DocumentSelector *DocumentSelector `json:"documentSelector"`
+}
+
+func (t *TextDocumentRegistrationOptions) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["documentSelector"]; !exists {
+		return fmt.Errorf("missing required field: documentSelector")
+	}
+	type Alias TextDocumentRegistrationOptions
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = TextDocumentRegistrationOptions(test)
+
+	return nil
+}
+
+// Save registration options.
+type TextDocumentSaveRegistrationOptions struct {
+	// A document selector to identify the scope of the registration. If set to null
+	// the document selector provided on the client side will be used.
+	DocumentSelector *DocumentSelector `json:"documentSelector"`
+	// The client is supposed to include the content on save.
+	IncludeText bool `json:"includeText,omitempty"`
+}
+
+func (t *TextDocumentSaveRegistrationOptions) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["documentSelector"]; !exists {
+		return fmt.Errorf("missing required field: documentSelector")
+	}
+	type Alias TextDocumentSaveRegistrationOptions
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = TextDocumentSaveRegistrationOptions(test)
+
+	return nil
+}
+
+type TextDocumentSyncClientCapabilities = protocol.TextDocumentSyncClientCapabilities
+
+type TextDocumentSyncOptions = protocol.TextDocumentSyncOptions
+
+// A text edit applicable to a text document.
+type TextEdit = protocol.TextEdit
+
+// Since 3.6.0
+type TypeDefinitionClientCapabilities = protocol.TypeDefinitionClientCapabilities
+
+type TypeDefinitionOptions = protocol.TypeDefinitionOptions
+
+type TypeDefinitionParams = protocol.TypeDefinitionParams
+
+type TypeDefinitionRegistrationOptions struct {
+	// A document selector to identify the scope of the registration. If set to null
+	// the document selector provided on the client side will be used.
+	DocumentSelector *DocumentSelector `json:"documentSelector"`
+	// The id used to register the request. The id can be used to deregister
+	// the request again. See also Registration#id.
+	Id               string `json:"id,omitempty"`
+	WorkDoneProgress bool   `json:"workDoneProgress,omitempty"`
+}
+
+func (t *TypeDefinitionRegistrationOptions) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	if _, exists := m["documentSelector"]; !exists {
+		return fmt.Errorf("missing required field: documentSelector")
+	}
+	type Alias TypeDefinitionRegistrationOptions
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = TypeDefinitionRegistrationOptions(test)
+
+	return nil
+}
+
+// General parameters to unregister a request or notification.
+type Unregistration = protocol.Unregistration
+
+type UnregistrationParams = protocol.UnregistrationParams
+
+// A text document identifier to denote a specific version of a text document.
+type VersionedTextDocumentIdentifier = protocol.VersionedTextDocumentIdentifier
+
+// The parameters sent in a will save text document notification.
+type WillSaveTextDocumentParams = protocol.WillSaveTextDocumentParams
+
+type WindowClientCapabilities = protocol.WindowClientCapabilities
+
+type WorkDoneProgressBegin = protocol.WorkDoneProgressBegin
+
+type WorkDoneProgressCancelParams = protocol.WorkDoneProgressCancelParams
+
+type WorkDoneProgressCreateParams = protocol.WorkDoneProgressCreateParams
+
+type WorkDoneProgressEnd = protocol.WorkDoneProgressEnd
+
+type WorkDoneProgressOptions = protocol.WorkDoneProgressOptions
+
+type WorkDoneProgressParams = protocol.WorkDoneProgressParams
+
+type WorkDoneProgressReport = protocol.WorkDoneProgressReport
+
+// Workspace specific client capabilities.
+type WorkspaceClientCapabilities struct {
+	// The client supports applying batch edits
+	// to the workspace by supporting the request
+	// 'workspace/applyEdit'
+	ApplyEdit bool `json:"applyEdit,omitempty"`
+	// Capabilities specific to the code lens requests scoped to the
+	// workspace.
+	//
+	// @since 3.16.0.
+	CodeLens *CodeLensWorkspaceClientCapabilities `json:"codeLens,omitzero" since:"3.16.0"`
+	// The client supports `workspace/configuration` requests.
+	//
+	// @since 3.6.0
+	Configuration bool `json:"configuration,omitempty" since:"3.6.0"`
+	// Capabilities specific to the `workspace/didChangeConfiguration` notification.
+	DidChangeConfiguration *DidChangeConfigurationClientCapabilities `json:"didChangeConfiguration,omitzero"`
+	// Capabilities specific to the `workspace/didChangeWatchedFiles` notification.
+	DidChangeWatchedFiles *DidChangeWatchedFilesClientCapabilities `json:"didChangeWatchedFiles,omitzero"`
+	// Capabilities specific to the `workspace/executeCommand` request.
+	ExecuteCommand *ExecuteCommandClientCapabilities `json:"executeCommand,omitzero"`
+	// The client has support for file notifications/requests for user operations on files.
+	//
+	// Since 3.16.0
+	FileOperations *FileOperationClientCapabilities `json:"fileOperations,omitzero"`
+	// Capabilities specific to the semantic token requests scoped to the
+	// workspace.
+	//
+	// @since 3.16.0.
+	SemanticTokens *SemanticTokensWorkspaceClientCapabilities `json:"semanticTokens,omitzero" since:"3.16.0"`
+	// Capabilities specific to the `workspace/symbol` request.
+	Symbol *WorkspaceSymbolClientCapabilities `json:"symbol,omitzero"`
+	// Capabilities specific to `WorkspaceEdit`s.
+	WorkspaceEdit *WorkspaceEditClientCapabilities `json:"workspaceEdit,omitzero"`
+	// The client has support for workspace folders.
+	//
+	// @since 3.6.0
+	WorkspaceFolders bool `json:"workspaceFolders,omitempty" since:"3.6.0"`
+}
+
+func (t *WorkspaceClientCapabilities) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	type Alias WorkspaceClientCapabilities
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = WorkspaceClientCapabilities(test)
+
+	return nil
+}
+
+// A workspace edit represents changes to many resources managed in the workspace. The edit
+// should either provide `changes` or `documentChanges`. If documentChanges are present
+// they are preferred over `changes` if the client can handle versioned document edits.
+//
+// Since version 3.13.0 a workspace edit can contain resource operations as well. If resource
+// operations are present clients need to execute the operations in the order in which they
+// are provided. So a workspace edit for example can consist of the following two changes:
+// (1) a create file a.txt and (2) a text document edit which insert text into file a.txt.
+//
+// An invalid sequence (e.g. (1) delete file a.txt and (2) insert text into file a.txt) will
+// cause failure of the operation. How the client recovers from the failure is described by
+// the client capability: `workspace.workspaceEdit.failureHandling`
+type WorkspaceEdit struct {
+	// A map of change annotations that can be referenced in `AnnotatedTextEdit`s or create, rename and
+	// delete file / folder operations.
+	//
+	// Whether clients honor this property depends on the client capability `workspace.changeAnnotationSupport`.
+	//
+	// @since 3.16.0
+	ChangeAnnotations map[ChangeAnnotationIdentifier]ChangeAnnotation `json:"changeAnnotations,omitzero" since:"3.16.0"`
+	// Holds changes to existing resources.
+	Changes map[DocumentUri][]TextEdit `json:"changes,omitzero"`
+	// Depending on the client capability `workspace.workspaceEdit.resourceOperations` document changes
+	// are either an array of `TextDocumentEdit`s to express changes to n different text documents
+	// where each text document edit addresses a specific version of a text document. Or it can contain
+	// above `TextDocumentEdit`s mixed with create, rename and delete file / folder operations.
+	//
+	// Whether a client supports versioned document edits is expressed via
+	// `workspace.workspaceEdit.documentChanges` client capability.
+	//
+	// If a client neither supports `documentChanges` nor `workspace.workspaceEdit.resourceOperations` then
+	// only plain `TextEdit`s using the `changes` property are supported.
+	DocumentChanges []protocol.Or4[TextDocumentEdit, CreateFile, RenameFile, DeleteFile] `json:"documentChanges,omitzero"`
+}
+
+func (t *WorkspaceEdit) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	type Alias WorkspaceEdit
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = WorkspaceEdit(test)
+
+	return nil
+}
+
+type WorkspaceEditClientCapabilities struct {
+	// Whether the client in general supports change annotations on text edits,
+	// create file, rename file and delete file changes.
+	//
+	// @since 3.16.0
+	ChangeAnnotationSupport *ChangeAnnotationsSupportOptions `json:"changeAnnotationSupport,omitzero" since:"3.16.0"`
+	// The client supports versioned document changes in `WorkspaceEdit`s
+	DocumentChanges bool `json:"documentChanges,omitempty"`
+	// The failure handling strategy of a client if applying the workspace edit
+	// fails.
+	//
+	// @since 3.13.0
+	FailureHandling *FailureHandlingKind `json:"failureHandling,omitzero" since:"3.13.0"`
+	// Whether the client normalizes line endings to the client specific
+	// setting.
+	// If set to `true` the client will normalize line ending characters
+	// in a workspace edit to the client-specified new line
+	// character.
+	//
+	// @since 3.16.0
+	NormalizesLineEndings bool `json:"normalizesLineEndings,omitempty" since:"3.16.0"`
+	// The resource operations the client supports. Clients should at least
+	// support 'create', 'rename' and 'delete' files and folders.
+	//
+	// @since 3.13.0
+	ResourceOperations []ResourceOperationKind `json:"resourceOperations,omitzero" since:"3.13.0"`
+}
+
+func (t *WorkspaceEditClientCapabilities) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	type Alias WorkspaceEditClientCapabilities
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = WorkspaceEditClientCapabilities(test)
+
+	return nil
+}
+
+// A workspace folder inside a client.
+type WorkspaceFolder = protocol.WorkspaceFolder
+
+// The workspace folder change event.
+type WorkspaceFoldersChangeEvent = protocol.WorkspaceFoldersChangeEvent
+
+type WorkspaceFoldersInitializeParams = protocol.WorkspaceFoldersInitializeParams
+
+type WorkspaceFoldersServerCapabilities = protocol.WorkspaceFoldersServerCapabilities
+
+// Defines workspace specific capabilities of the server.
+//
+// @since 3.18.0
+type WorkspaceOptions struct {
+	// The server is interested in notifications/requests for operations on files.
+	//
+	// @since 3.16.0
+	FileOperations *FileOperationOptions `json:"fileOperations,omitzero" since:"3.16.0"`
+	// The server supports workspace folder.
+	//
+	// @since 3.6.0
+	WorkspaceFolders *WorkspaceFoldersServerCapabilities `json:"workspaceFolders,omitzero" since:"3.6.0"`
+}
+
+func (t *WorkspaceOptions) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	type Alias WorkspaceOptions
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = WorkspaceOptions(test)
+
+	return nil
+}
+
+// Client capabilities for a {@link WorkspaceSymbolRequest}.
+type WorkspaceSymbolClientCapabilities struct {
+	// Symbol request supports dynamic registration.
+	DynamicRegistration bool `json:"dynamicRegistration,omitempty"`
+	// Specific capabilities for the `SymbolKind` in the `workspace/symbol` request.
+	SymbolKind *ClientSymbolKindOptions `json:"symbolKind,omitzero"`
+	// The client supports tags on `SymbolInformation`.
+	// Clients supporting tags have to handle unknown tags gracefully.
+	//
+	// @since 3.16.0
+	TagSupport *ClientSymbolTagOptions `json:"tagSupport,omitzero" since:"3.16.0"`
+}
+
+func (t *WorkspaceSymbolClientCapabilities) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	type Alias WorkspaceSymbolClientCapabilities
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = WorkspaceSymbolClientCapabilities(test)
+
+	return nil
+}
+
+// Server capabilities for a {@link WorkspaceSymbolRequest}.
+type WorkspaceSymbolOptions struct {
+	WorkDoneProgress bool `json:"workDoneProgress,omitempty"`
+}
+
+func (t *WorkspaceSymbolOptions) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	type Alias WorkspaceSymbolOptions
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = WorkspaceSymbolOptions(test)
+
+	return nil
+}
+
+// The parameters of a {@link WorkspaceSymbolRequest}.
+type WorkspaceSymbolParams = protocol.WorkspaceSymbolParams
+
+// Registration options for a {@link WorkspaceSymbolRequest}.
+type WorkspaceSymbolRegistrationOptions struct {
+	WorkDoneProgress bool `json:"workDoneProgress,omitempty"`
+}
+
+func (t *WorkspaceSymbolRegistrationOptions) UnmarshalJSON(x []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(x, &m); err != nil {
+		return err
+	}
+	type Alias WorkspaceSymbolRegistrationOptions
+	var test Alias
+	decoder := json.NewDecoder(bytes.NewReader(x))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&test); err != nil {
+		return err
+	}
+	*t = WorkspaceSymbolRegistrationOptions(test)
+
+	return nil
+}
+
+// A set of predefined code action kinds
+type CodeActionKind = protocol.CodeActionKind
+
+const (
+	CodeActionKindEmpty                 = protocol.CodeActionKindEmpty
+	CodeActionKindNotebook              = protocol.CodeActionKindNotebook
+	CodeActionKindQuickFix              = protocol.CodeActionKindQuickFix
+	CodeActionKindRefactor              = protocol.CodeActionKindRefactor
+	CodeActionKindRefactorExtract       = protocol.CodeActionKindRefactorExtract
+	CodeActionKindRefactorInline        = protocol.CodeActionKindRefactorInline
+	CodeActionKindRefactorMove          = protocol.CodeActionKindRefactorMove
+	CodeActionKindRefactorRewrite       = protocol.CodeActionKindRefactorRewrite
+	CodeActionKindSource                = protocol.CodeActionKindSource
+	CodeActionKindSourceFixAll          = protocol.CodeActionKindSourceFixAll
+	CodeActionKindSourceOrganizeImports = protocol.CodeActionKindSourceOrganizeImports
+)
+
+// The kind of a completion entry.
+type CompletionItemKind = protocol.CompletionItemKind
+
+const (
+	CompletionItemKindClass         = protocol.CompletionItemKindClass
+	CompletionItemKindColor         = protocol.CompletionItemKindColor
+	CompletionItemKindConstant      = protocol.CompletionItemKindConstant
+	CompletionItemKindConstructor   = protocol.CompletionItemKindConstructor
+	CompletionItemKindEnum          = protocol.CompletionItemKindEnum
+	CompletionItemKindEnumMember    = protocol.CompletionItemKindEnumMember
+	CompletionItemKindEvent         = protocol.CompletionItemKindEvent
+	CompletionItemKindField         = protocol.CompletionItemKindField
+	CompletionItemKindFile          = protocol.CompletionItemKindFile
+	CompletionItemKindFolder        = protocol.CompletionItemKindFolder
+	CompletionItemKindFunction      = protocol.CompletionItemKindFunction
+	CompletionItemKindInterface     = protocol.CompletionItemKindInterface
+	CompletionItemKindKeyword       = protocol.CompletionItemKindKeyword
+	CompletionItemKindMethod        = protocol.CompletionItemKindMethod
+	CompletionItemKindModule        = protocol.CompletionItemKindModule
+	CompletionItemKindOperator      = protocol.CompletionItemKindOperator
+	CompletionItemKindProperty      = protocol.CompletionItemKindProperty
+	CompletionItemKindReference     = protocol.CompletionItemKindReference
+	CompletionItemKindSnippet       = protocol.CompletionItemKindSnippet
+	CompletionItemKindStruct        = protocol.CompletionItemKindStruct
+	CompletionItemKindText          = protocol.CompletionItemKindText
+	CompletionItemKindTypeParameter = protocol.CompletionItemKindTypeParameter
+	CompletionItemKindUnit          = protocol.CompletionItemKindUnit
+	CompletionItemKindValue         = protocol.CompletionItemKindValue
+	CompletionItemKindVariable      = protocol.CompletionItemKindVariable
+)
+
+// Completion item tags are extra annotations that tweak the rendering of a completion
+// item.
+//
+// @since 3.15.0
+type CompletionItemTag = protocol.CompletionItemTag
+
+const (
+	CompletionItemTagDeprecated = protocol.CompletionItemTagDeprecated
+)
+
+// How a completion was triggered
+type CompletionTriggerKind = protocol.CompletionTriggerKind
+
+const (
+	CompletionTriggerKindInvoked                         = protocol.CompletionTriggerKindInvoked
+	CompletionTriggerKindTriggerCharacter                = protocol.CompletionTriggerKindTriggerCharacter
+	CompletionTriggerKindTriggerForIncompleteCompletions = protocol.CompletionTriggerKindTriggerForIncompleteCompletions
+)
+
+// The diagnostic's severity.
+type DiagnosticSeverity = protocol.DiagnosticSeverity
+
+const (
+	DiagnosticSeverityError       = protocol.DiagnosticSeverityError
+	DiagnosticSeverityHint        = protocol.DiagnosticSeverityHint
+	DiagnosticSeverityInformation = protocol.DiagnosticSeverityInformation
+	DiagnosticSeverityWarning     = protocol.DiagnosticSeverityWarning
+)
+
+// The diagnostic tags.
+//
+// @since 3.15.0
+type DiagnosticTag = protocol.DiagnosticTag
+
+const (
+	DiagnosticTagDeprecated  = protocol.DiagnosticTagDeprecated
+	DiagnosticTagUnnecessary = protocol.DiagnosticTagUnnecessary
+)
+
+// A document highlight kind.
+type DocumentHighlightKind = protocol.DocumentHighlightKind
+
+const (
+	DocumentHighlightKindRead  = protocol.DocumentHighlightKindRead
+	DocumentHighlightKindText  = protocol.DocumentHighlightKindText
+	DocumentHighlightKindWrite = protocol.DocumentHighlightKindWrite
+)
+
+// Predefined error codes.
+type ErrorCodes = protocol.ErrorCodes
+
+type FailureHandlingKind = protocol.FailureHandlingKind
+
+const (
+	FailureHandlingKindAbort                 = protocol.FailureHandlingKindAbort
+	FailureHandlingKindTextOnlyTransactional = protocol.FailureHandlingKindTextOnlyTransactional
+	FailureHandlingKindTransactional         = protocol.FailureHandlingKindTransactional
+	FailureHandlingKindUndo                  = protocol.FailureHandlingKindUndo
+)
+
+// The file event type
+type FileChangeType = protocol.FileChangeType
+
+const (
+	FileChangeTypeChanged = protocol.FileChangeTypeChanged
+	FileChangeTypeCreated = protocol.FileChangeTypeCreated
+	FileChangeTypeDeleted = protocol.FileChangeTypeDeleted
+)
+
+// A pattern kind describing if a glob pattern matches a file a folder or
+// both.
+//
+// @since 3.16.0
+type FileOperationPatternKind = protocol.FileOperationPatternKind
+
+const (
+	FileOperationPatternKindFile   = protocol.FileOperationPatternKindFile
+	FileOperationPatternKindFolder = protocol.FileOperationPatternKindFolder
+)
+
+// A set of predefined range kinds.
+type FoldingRangeKind = protocol.FoldingRangeKind
+
+const (
+	FoldingRangeKindComment = protocol.FoldingRangeKindComment
+	FoldingRangeKindImports = protocol.FoldingRangeKindImports
+	FoldingRangeKindRegion  = protocol.FoldingRangeKindRegion
+)
+
+// Defines whether the insert text in a completion item should be interpreted as
+// plain text or a snippet.
+type InsertTextFormat = protocol.InsertTextFormat
+
+const (
+	InsertTextFormatPlainText = protocol.InsertTextFormatPlainText
+	InsertTextFormatSnippet   = protocol.InsertTextFormatSnippet
+)
+
+// How whitespace and indentation is handled during completion
+// item insertion.
+//
+// @since 3.16.0
+type InsertTextMode = protocol.InsertTextMode
+
+const (
+	InsertTextModeAdjustIndentation = protocol.InsertTextModeAdjustIndentation
+	InsertTextModeAsIs              = protocol.InsertTextModeAsIs
+)
+
+type LSPErrorCodes = protocol.LSPErrorCodes
+
+// Predefined Language kinds
+// @since 3.18.0
+type LanguageKind = protocol.LanguageKind
+
+const (
+	LanguageKindABAP            = protocol.LanguageKindABAP
+	LanguageKindBibTeX          = protocol.LanguageKindBibTeX
+	LanguageKindC               = protocol.LanguageKindC
+	LanguageKindCPP             = protocol.LanguageKindCPP
+	LanguageKindCSS             = protocol.LanguageKindCSS
+	LanguageKindCSharp          = protocol.LanguageKindCSharp
+	LanguageKindClojure         = protocol.LanguageKindClojure
+	LanguageKindCoffeescript    = protocol.LanguageKindCoffeescript
+	LanguageKindD               = protocol.LanguageKindD
+	LanguageKindDart            = protocol.LanguageKindDart
+	LanguageKindDelphi          = protocol.LanguageKindDelphi
+	LanguageKindDiff            = protocol.LanguageKindDiff
+	LanguageKindDockerfile      = protocol.LanguageKindDockerfile
+	LanguageKindElixir          = protocol.LanguageKindElixir
+	LanguageKindErlang          = protocol.LanguageKindErlang
+	LanguageKindFSharp          = protocol.LanguageKindFSharp
+	LanguageKindGitCommit       = protocol.LanguageKindGitCommit
+	LanguageKindGitRebase       = protocol.LanguageKindGitRebase
+	LanguageKindGo              = protocol.LanguageKindGo
+	LanguageKindGroovy          = protocol.LanguageKindGroovy
+	LanguageKindHTML            = protocol.LanguageKindHTML
+	LanguageKindHandlebars      = protocol.LanguageKindHandlebars
+	LanguageKindHaskell         = protocol.LanguageKindHaskell
+	LanguageKindIni             = protocol.LanguageKindIni
+	LanguageKindJSON            = protocol.LanguageKindJSON
+	LanguageKindJava            = protocol.LanguageKindJava
+	LanguageKindJavaScript      = protocol.LanguageKindJavaScript
+	LanguageKindJavaScriptReact = protocol.LanguageKindJavaScriptReact
+	LanguageKindLaTeX           = protocol.LanguageKindLaTeX
+	LanguageKindLess            = protocol.LanguageKindLess
+	LanguageKindLua             = protocol.LanguageKindLua
+	LanguageKindMakefile        = protocol.LanguageKindMakefile
+	LanguageKindMarkdown        = protocol.LanguageKindMarkdown
+	LanguageKindObjectiveC      = protocol.LanguageKindObjectiveC
+	LanguageKindObjectiveCPP    = protocol.LanguageKindObjectiveCPP
+	LanguageKindPHP             = protocol.LanguageKindPHP
+	LanguageKindPascal          = protocol.LanguageKindPascal
+	LanguageKindPerl            = protocol.LanguageKindPerl
+	LanguageKindPerl6           = protocol.LanguageKindPerl6
+	LanguageKindPowershell      = protocol.LanguageKindPowershell
+	LanguageKindPug             = protocol.LanguageKindPug
+	LanguageKindPython          = protocol.LanguageKindPython
+	LanguageKindR               = protocol.LanguageKindR
+	LanguageKindRazor           = protocol.LanguageKindRazor
+	LanguageKindRuby            = protocol.LanguageKindRuby
+	LanguageKindRust            = protocol.LanguageKindRust
+	LanguageKindSASS            = protocol.LanguageKindSASS
+	LanguageKindSCSS            = protocol.LanguageKindSCSS
+	LanguageKindSQL             = protocol.LanguageKindSQL
+	LanguageKindScala           = protocol.LanguageKindScala
+	LanguageKindShaderLab       = protocol.LanguageKindShaderLab
+	LanguageKindShellScript     = protocol.LanguageKindShellScript
+	LanguageKindSwift           = protocol.LanguageKindSwift
+	LanguageKindTeX             = protocol.LanguageKindTeX
+	LanguageKindTypeScript      = protocol.LanguageKindTypeScript
+	LanguageKindTypeScriptReact = protocol.LanguageKindTypeScriptReact
+	LanguageKindVisualBasic     = protocol.LanguageKindVisualBasic
+	LanguageKindWindowsBat      = protocol.LanguageKindWindowsBat
+	LanguageKindXML             = protocol.LanguageKindXML
+	LanguageKindXSL             = protocol.LanguageKindXSL
+	LanguageKindYAML            = protocol.LanguageKindYAML
+)
+
+// Describes the content type that a client supports in various
+// result literals like `Hover`, `ParameterInfo` or `CompletionItem`.
+//
+// Please note that `MarkupKinds` must not start with a `$`. This kinds
+// are reserved for internal usage.
+type MarkupKind = protocol.MarkupKind
+
+const (
+	MarkupKindMarkdown  = protocol.MarkupKindMarkdown
+	MarkupKindPlainText = protocol.MarkupKindPlainText
+)
+
+// The message type
+type MessageType = protocol.MessageType
+
+const (
+	MessageTypeDebug   = protocol.MessageTypeDebug
+	MessageTypeError   = protocol.MessageTypeError
+	MessageTypeInfo    = protocol.MessageTypeInfo
+	MessageTypeLog     = protocol.MessageTypeLog
+	MessageTypeWarning = protocol.MessageTypeWarning
+)
+
+// The moniker kind.
+//
+// @since 3.16.0
+type MonikerKind = protocol.MonikerKind
+
+const (
+	MonikerKindExport = protocol.MonikerKindExport
+	MonikerKindImport = protocol.MonikerKindImport
+	MonikerKindLocal  = protocol.MonikerKindLocal
+)
+
+type PrepareSupportDefaultBehavior = protocol.PrepareSupportDefaultBehavior
+
+const (
+	PrepareSupportDefaultBehaviorIdentifier = protocol.PrepareSupportDefaultBehaviorIdentifier
+)
+
+type ResourceOperationKind = protocol.ResourceOperationKind
+
+const (
+	ResourceOperationKindCreate = protocol.ResourceOperationKindCreate
+	ResourceOperationKindDelete = protocol.ResourceOperationKindDelete
+	ResourceOperationKindRename = protocol.ResourceOperationKindRename
+)
+
+// A set of predefined token modifiers. This set is not fixed
+// an clients can specify additional token types via the
+// corresponding client capabilities.
+//
+// @since 3.16.0
+type SemanticTokenModifiers = protocol.SemanticTokenModifiers
+
+const (
+	SemanticTokenModifiersAbstract       = protocol.SemanticTokenModifiersAbstract
+	SemanticTokenModifiersAsync          = protocol.SemanticTokenModifiersAsync
+	SemanticTokenModifiersDeclaration    = protocol.SemanticTokenModifiersDeclaration
+	SemanticTokenModifiersDefaultLibrary = protocol.SemanticTokenModifiersDefaultLibrary
+	SemanticTokenModifiersDefinition     = protocol.SemanticTokenModifiersDefinition
+	SemanticTokenModifiersDeprecated     = protocol.SemanticTokenModifiersDeprecated
+	SemanticTokenModifiersDocumentation  = protocol.SemanticTokenModifiersDocumentation
+	SemanticTokenModifiersModification   = protocol.SemanticTokenModifiersModification
+	SemanticTokenModifiersReadonly       = protocol.SemanticTokenModifiersReadonly
+	SemanticTokenModifiersStatic         = protocol.SemanticTokenModifiersStatic
+)
+
+// A set of predefined token types. This set is not fixed
+// an clients can specify additional token types via the
+// corresponding client capabilities.
+//
+// @since 3.16.0
+type SemanticTokenTypes = protocol.SemanticTokenTypes
+
+const (
+	SemanticTokenTypesClass         = protocol.SemanticTokenTypesClass
+	SemanticTokenTypesComment       = protocol.SemanticTokenTypesComment
+	SemanticTokenTypesDecorator     = protocol.SemanticTokenTypesDecorator
+	SemanticTokenTypesEnum          = protocol.SemanticTokenTypesEnum
+	SemanticTokenTypesEnumMember    = protocol.SemanticTokenTypesEnumMember
+	SemanticTokenTypesEvent         = protocol.SemanticTokenTypesEvent
+	SemanticTokenTypesFunction      = protocol.SemanticTokenTypesFunction
+	SemanticTokenTypesInterface     = protocol.SemanticTokenTypesInterface
+	SemanticTokenTypesKeyword       = protocol.SemanticTokenTypesKeyword
+	SemanticTokenTypesLabel         = protocol.SemanticTokenTypesLabel
+	SemanticTokenTypesMacro         = protocol.SemanticTokenTypesMacro
+	SemanticTokenTypesMethod        = protocol.SemanticTokenTypesMethod
+	SemanticTokenTypesModifier      = protocol.SemanticTokenTypesModifier
+	SemanticTokenTypesNamespace     = protocol.SemanticTokenTypesNamespace
+	SemanticTokenTypesNumber        = protocol.SemanticTokenTypesNumber
+	SemanticTokenTypesOperator      = protocol.SemanticTokenTypesOperator
+	SemanticTokenTypesParameter     = protocol.SemanticTokenTypesParameter
+	SemanticTokenTypesProperty      = protocol.SemanticTokenTypesProperty
+	SemanticTokenTypesRegexp        = protocol.SemanticTokenTypesRegexp
+	SemanticTokenTypesString        = protocol.SemanticTokenTypesString
+	SemanticTokenTypesStruct        = protocol.SemanticTokenTypesStruct
+	SemanticTokenTypesType          = protocol.SemanticTokenTypesType
+	SemanticTokenTypesTypeParameter = protocol.SemanticTokenTypesTypeParameter
+	SemanticTokenTypesVariable      = protocol.SemanticTokenTypesVariable
+)
+
+// How a signature help was triggered.
+//
+// @since 3.15.0
+type SignatureHelpTriggerKind = protocol.SignatureHelpTriggerKind
+
+const (
+	SignatureHelpTriggerKindContentChange    = protocol.SignatureHelpTriggerKindContentChange
+	SignatureHelpTriggerKindInvoked          = protocol.SignatureHelpTriggerKindInvoked
+	SignatureHelpTriggerKindTriggerCharacter = protocol.SignatureHelpTriggerKindTriggerCharacter
+)
+
+// A symbol kind.
+type SymbolKind = protocol.SymbolKind
+
+const (
+	SymbolKindArray         = protocol.SymbolKindArray
+	SymbolKindBoolean       = protocol.SymbolKindBoolean
+	SymbolKindClass         = protocol.SymbolKindClass
+	SymbolKindConstant      = protocol.SymbolKindConstant
+	SymbolKindConstructor   = protocol.SymbolKindConstructor
+	SymbolKindEnum          = protocol.SymbolKindEnum
+	SymbolKindEnumMember    = protocol.SymbolKindEnumMember
+	SymbolKindEvent         = protocol.SymbolKindEvent
+	SymbolKindField         = protocol.SymbolKindField
+	SymbolKindFile          = protocol.SymbolKindFile
+	SymbolKindFunction      = protocol.SymbolKindFunction
+	SymbolKindInterface     = protocol.SymbolKindInterface
+	SymbolKindKey           = protocol.SymbolKindKey
+	SymbolKindMethod        = protocol.SymbolKindMethod
+	SymbolKindModule        = protocol.SymbolKindModule
+	SymbolKindNamespace     = protocol.SymbolKindNamespace
+	SymbolKindNull          = protocol.SymbolKindNull
+	SymbolKindNumber        = protocol.SymbolKindNumber
+	SymbolKindObject        = protocol.SymbolKindObject
+	SymbolKindOperator      = protocol.SymbolKindOperator
+	SymbolKindPackage       = protocol.SymbolKindPackage
+	SymbolKindProperty      = protocol.SymbolKindProperty
+	SymbolKindString        = protocol.SymbolKindString
+	SymbolKindStruct        = protocol.SymbolKindStruct
+	SymbolKindTypeParameter = protocol.SymbolKindTypeParameter
+	SymbolKindVariable      = protocol.SymbolKindVariable
+)
+
+// Symbol tags are extra annotations that tweak the rendering of a symbol.
+//
+// @since 3.16
+type SymbolTag = protocol.SymbolTag
+
+const (
+	SymbolTagDeprecated = protocol.SymbolTagDeprecated
+)
+
+// Represents reasons why a text document is saved.
+type TextDocumentSaveReason = protocol.TextDocumentSaveReason
+
+const (
+	TextDocumentSaveReasonAfterDelay = protocol.TextDocumentSaveReasonAfterDelay
+	TextDocumentSaveReasonFocusOut   = protocol.TextDocumentSaveReasonFocusOut
+	TextDocumentSaveReasonManual     = protocol.TextDocumentSaveReasonManual
+)
+
+// Defines how the host (editor) should sync
+// document changes to the language server.
+type TextDocumentSyncKind = protocol.TextDocumentSyncKind
+
+const (
+	TextDocumentSyncKindFull        = protocol.TextDocumentSyncKindFull
+	TextDocumentSyncKindIncremental = protocol.TextDocumentSyncKindIncremental
+	TextDocumentSyncKindNone        = protocol.TextDocumentSyncKindNone
+)
+
+type TokenFormat = protocol.TokenFormat
+
+const (
+	TokenFormatRelative = protocol.TokenFormatRelative
+)
+
+type TraceValue = protocol.TraceValue
+
+const (
+	TraceValueMessages = protocol.TraceValueMessages
+	TraceValueOff      = protocol.TraceValueOff
+	TraceValueVerbose  = protocol.TraceValueVerbose
+)
+
+// Moniker uniqueness level to define scope of the moniker.
+//
+// @since 3.16.0
+type UniquenessLevel = protocol.UniquenessLevel
+
+const (
+	UniquenessLevelDocument = protocol.UniquenessLevelDocument
+	UniquenessLevelGlobal   = protocol.UniquenessLevelGlobal
+	UniquenessLevelGroup    = protocol.UniquenessLevelGroup
+	UniquenessLevelProject  = protocol.UniquenessLevelProject
+	UniquenessLevelScheme   = protocol.UniquenessLevelScheme
+)
+
+type WatchKind = protocol.WatchKind
+
+const (
+	WatchKindChange = protocol.WatchKindChange
+	WatchKindCreate = protocol.WatchKindCreate
+	WatchKindDelete = protocol.WatchKindDelete
+)
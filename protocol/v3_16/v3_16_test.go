@@ -0,0 +1,70 @@
+package v3_16
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+)
+
+func TestCompletionItemLeavesOutNewerFields(t *testing.T) {
+	var item CompletionItem
+	if err := json.Unmarshal([]byte(`{"label":"a","labelDetails":{"detail":"()"}}`), &item); err == nil {
+		t.Fatal("Expected labelDetails, added in 3.17, to be rejected")
+	}
+
+	latest := protocol.CompletionItem{Label: "a", LabelDetails: &protocol.CompletionItemLabelDetails{Detail: "()"}, InsertTextMode: new(protocol.InsertTextMode)}
+	*latest.InsertTextMode = protocol.InsertTextModeAsIs
+
+	item, err := CompletionItemFromLatest(latest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if item.Label != "a" || item.InsertTextMode == nil || *item.InsertTextMode != InsertTextModeAsIs {
+		t.Fatalf("Unexpected item: %+v", item)
+	}
+
+	converted, err := CompletionItemToLatest(item)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if converted.Label != "a" || converted.LabelDetails != nil {
+		t.Fatalf("Unexpected item: %+v", converted)
+	}
+}
+
+func TestUnionsLeaveOutNewerAlternatives(t *testing.T) {
+	watcher := protocol.FileSystemWatcher{GlobPattern: protocol.GlobPattern{Value: protocol.Pattern("**/*.go")}}
+
+	converted, err := FileSystemWatcherFromLatest(watcher)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if converted.GlobPattern != "**/*.go" {
+		t.Fatalf("Expected the glob pattern to be a pattern, got %+v", converted)
+	}
+
+	watcher.GlobPattern = protocol.GlobPattern{Value: protocol.RelativePattern{BaseUri: protocol.Or2[protocol.WorkspaceFolder, protocol.URI]{Value: protocol.URI("file:///a")}, Pattern: "*.go"}}
+	if _, err := FileSystemWatcherFromLatest(watcher); err == nil {
+		t.Fatal("Expected a relative pattern, added in 3.17, to be an error")
+	}
+}
+
+func TestDecodeParams(t *testing.T) {
+	params, err := DecodeParams(TextDocumentHoverMethod, []byte(`{"textDocument":{"uri":"file:///a.go"},"position":{"line":1,"character":2}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hover, ok := params.(HoverParams); !ok || hover.Position.Character != 2 {
+		t.Fatalf("Unexpected params: %+v", params)
+	}
+
+	if _, err := DecodeParams(protocol.TextDocumentInlayHintMethod, []byte(`{"textDocument":{"uri":"file:///a.go"},"range":{"start":{"line":0,"character":0},"end":{"line":1,"character":0}}}`)); err == nil {
+		t.Fatal("Expected textDocument/inlayHint, added in 3.17, to be an error")
+	}
+
+	initialize := []byte(`{"processId":null,"rootUri":null,"capabilities":{"general":{"positionEncodings":["utf-8"]}}}`)
+	if _, err := DecodeParams(InitializeMethod, initialize); err == nil {
+		t.Fatal("Expected positionEncodings, added in 3.17, to be rejected")
+	}
+}
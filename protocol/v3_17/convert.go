@@ -0,0 +1,322 @@
+package v3_17
+
+import "github.com/myleshyson/lsprotocol-go/protocol"
+
+// Converts a ApplyWorkspaceEditParams of protocol 3.17 to the latest version.
+func ApplyWorkspaceEditParamsToLatest(value ApplyWorkspaceEditParams) (protocol.ApplyWorkspaceEditParams, error) {
+	return convert[protocol.ApplyWorkspaceEditParams](value)
+}
+
+// Converts a ApplyWorkspaceEditParams of the latest version to protocol 3.17, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func ApplyWorkspaceEditParamsFromLatest(value protocol.ApplyWorkspaceEditParams) (ApplyWorkspaceEditParams, error) {
+	return convert[ApplyWorkspaceEditParams](protocol.Downgrade(Features, value))
+}
+
+// Converts a ClientCapabilities of protocol 3.17 to the latest version.
+func ClientCapabilitiesToLatest(value ClientCapabilities) (protocol.ClientCapabilities, error) {
+	return convert[protocol.ClientCapabilities](value)
+}
+
+// Converts a ClientCapabilities of the latest version to protocol 3.17, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func ClientCapabilitiesFromLatest(value protocol.ClientCapabilities) (ClientCapabilities, error) {
+	return convert[ClientCapabilities](protocol.Downgrade(Features, value))
+}
+
+// Converts a ClientSignatureInformationOptions of protocol 3.17 to the latest version.
+func ClientSignatureInformationOptionsToLatest(value ClientSignatureInformationOptions) (protocol.ClientSignatureInformationOptions, error) {
+	return convert[protocol.ClientSignatureInformationOptions](value)
+}
+
+// Converts a ClientSignatureInformationOptions of the latest version to protocol 3.17, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func ClientSignatureInformationOptionsFromLatest(value protocol.ClientSignatureInformationOptions) (ClientSignatureInformationOptions, error) {
+	return convert[ClientSignatureInformationOptions](protocol.Downgrade(Features, value))
+}
+
+// Converts a CodeAction of protocol 3.17 to the latest version.
+func CodeActionToLatest(value CodeAction) (protocol.CodeAction, error) {
+	return convert[protocol.CodeAction](value)
+}
+
+// Converts a CodeAction of the latest version to protocol 3.17, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func CodeActionFromLatest(value protocol.CodeAction) (CodeAction, error) {
+	return convert[CodeAction](protocol.Downgrade(Features, value))
+}
+
+// Converts a CodeActionClientCapabilities of protocol 3.17 to the latest version.
+func CodeActionClientCapabilitiesToLatest(value CodeActionClientCapabilities) (protocol.CodeActionClientCapabilities, error) {
+	return convert[protocol.CodeActionClientCapabilities](value)
+}
+
+// Converts a CodeActionClientCapabilities of the latest version to protocol 3.17, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func CodeActionClientCapabilitiesFromLatest(value protocol.CodeActionClientCapabilities) (CodeActionClientCapabilities, error) {
+	return convert[CodeActionClientCapabilities](protocol.Downgrade(Features, value))
+}
+
+// Converts a CodeActionOptions of protocol 3.17 to the latest version.
+func CodeActionOptionsToLatest(value CodeActionOptions) (protocol.CodeActionOptions, error) {
+	return convert[protocol.CodeActionOptions](value)
+}
+
+// Converts a CodeActionOptions of the latest version to protocol 3.17, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func CodeActionOptionsFromLatest(value protocol.CodeActionOptions) (CodeActionOptions, error) {
+	return convert[CodeActionOptions](protocol.Downgrade(Features, value))
+}
+
+// Converts a CodeActionRegistrationOptions of protocol 3.17 to the latest version.
+func CodeActionRegistrationOptionsToLatest(value CodeActionRegistrationOptions) (protocol.CodeActionRegistrationOptions, error) {
+	return convert[protocol.CodeActionRegistrationOptions](value)
+}
+
+// Converts a CodeActionRegistrationOptions of the latest version to protocol 3.17, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func CodeActionRegistrationOptionsFromLatest(value protocol.CodeActionRegistrationOptions) (CodeActionRegistrationOptions, error) {
+	return convert[CodeActionRegistrationOptions](protocol.Downgrade(Features, value))
+}
+
+// Converts a CodeLens of protocol 3.17 to the latest version.
+func CodeLensToLatest(value CodeLens) (protocol.CodeLens, error) {
+	return convert[protocol.CodeLens](value)
+}
+
+// Converts a CodeLens of the latest version to protocol 3.17, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func CodeLensFromLatest(value protocol.CodeLens) (CodeLens, error) {
+	return convert[CodeLens](protocol.Downgrade(Features, value))
+}
+
+// Converts a CodeLensClientCapabilities of protocol 3.17 to the latest version.
+func CodeLensClientCapabilitiesToLatest(value CodeLensClientCapabilities) (protocol.CodeLensClientCapabilities, error) {
+	return convert[protocol.CodeLensClientCapabilities](value)
+}
+
+// Converts a CodeLensClientCapabilities of the latest version to protocol 3.17, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func CodeLensClientCapabilitiesFromLatest(value protocol.CodeLensClientCapabilities) (CodeLensClientCapabilities, error) {
+	return convert[CodeLensClientCapabilities](protocol.Downgrade(Features, value))
+}
+
+// Converts a Command of protocol 3.17 to the latest version.
+func CommandToLatest(value Command) (protocol.Command, error) {
+	return convert[protocol.Command](value)
+}
+
+// Converts a Command of the latest version to protocol 3.17, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func CommandFromLatest(value protocol.Command) (Command, error) {
+	return convert[Command](protocol.Downgrade(Features, value))
+}
+
+// Converts a CompletionClientCapabilities of protocol 3.17 to the latest version.
+func CompletionClientCapabilitiesToLatest(value CompletionClientCapabilities) (protocol.CompletionClientCapabilities, error) {
+	return convert[protocol.CompletionClientCapabilities](value)
+}
+
+// Converts a CompletionClientCapabilities of the latest version to protocol 3.17, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func CompletionClientCapabilitiesFromLatest(value protocol.CompletionClientCapabilities) (CompletionClientCapabilities, error) {
+	return convert[CompletionClientCapabilities](protocol.Downgrade(Features, value))
+}
+
+// Converts a CompletionItem of protocol 3.17 to the latest version.
+func CompletionItemToLatest(value CompletionItem) (protocol.CompletionItem, error) {
+	return convert[protocol.CompletionItem](value)
+}
+
+// Converts a CompletionItem of the latest version to protocol 3.17, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func CompletionItemFromLatest(value protocol.CompletionItem) (CompletionItem, error) {
+	return convert[CompletionItem](protocol.Downgrade(Features, value))
+}
+
+// Converts a CompletionList of protocol 3.17 to the latest version.
+func CompletionListToLatest(value CompletionList) (protocol.CompletionList, error) {
+	return convert[protocol.CompletionList](value)
+}
+
+// Converts a CompletionList of the latest version to protocol 3.17, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func CompletionListFromLatest(value protocol.CompletionList) (CompletionList, error) {
+	return convert[CompletionList](protocol.Downgrade(Features, value))
+}
+
+// Converts a CompletionListCapabilities of protocol 3.17 to the latest version.
+func CompletionListCapabilitiesToLatest(value CompletionListCapabilities) (protocol.CompletionListCapabilities, error) {
+	return convert[protocol.CompletionListCapabilities](value)
+}
+
+// Converts a CompletionListCapabilities of the latest version to protocol 3.17, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func CompletionListCapabilitiesFromLatest(value protocol.CompletionListCapabilities) (CompletionListCapabilities, error) {
+	return convert[CompletionListCapabilities](protocol.Downgrade(Features, value))
+}
+
+// Converts a DocumentRangeFormattingClientCapabilities of protocol 3.17 to the latest version.
+func DocumentRangeFormattingClientCapabilitiesToLatest(value DocumentRangeFormattingClientCapabilities) (protocol.DocumentRangeFormattingClientCapabilities, error) {
+	return convert[protocol.DocumentRangeFormattingClientCapabilities](value)
+}
+
+// Converts a DocumentRangeFormattingClientCapabilities of the latest version to protocol 3.17, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func DocumentRangeFormattingClientCapabilitiesFromLatest(value protocol.DocumentRangeFormattingClientCapabilities) (DocumentRangeFormattingClientCapabilities, error) {
+	return convert[DocumentRangeFormattingClientCapabilities](protocol.Downgrade(Features, value))
+}
+
+// Converts a DocumentRangeFormattingOptions of protocol 3.17 to the latest version.
+func DocumentRangeFormattingOptionsToLatest(value DocumentRangeFormattingOptions) (protocol.DocumentRangeFormattingOptions, error) {
+	return convert[protocol.DocumentRangeFormattingOptions](value)
+}
+
+// Converts a DocumentRangeFormattingOptions of the latest version to protocol 3.17, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func DocumentRangeFormattingOptionsFromLatest(value protocol.DocumentRangeFormattingOptions) (DocumentRangeFormattingOptions, error) {
+	return convert[DocumentRangeFormattingOptions](protocol.Downgrade(Features, value))
+}
+
+// Converts a DocumentRangeFormattingRegistrationOptions of protocol 3.17 to the latest version.
+func DocumentRangeFormattingRegistrationOptionsToLatest(value DocumentRangeFormattingRegistrationOptions) (protocol.DocumentRangeFormattingRegistrationOptions, error) {
+	return convert[protocol.DocumentRangeFormattingRegistrationOptions](value)
+}
+
+// Converts a DocumentRangeFormattingRegistrationOptions of the latest version to protocol 3.17, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func DocumentRangeFormattingRegistrationOptionsFromLatest(value protocol.DocumentRangeFormattingRegistrationOptions) (DocumentRangeFormattingRegistrationOptions, error) {
+	return convert[DocumentRangeFormattingRegistrationOptions](protocol.Downgrade(Features, value))
+}
+
+// Converts a InitializeParams of protocol 3.17 to the latest version.
+func InitializeParamsToLatest(value InitializeParams) (protocol.InitializeParams, error) {
+	return convert[protocol.InitializeParams](value)
+}
+
+// Converts a InitializeParams of the latest version to protocol 3.17, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func InitializeParamsFromLatest(value protocol.InitializeParams) (InitializeParams, error) {
+	return convert[InitializeParams](protocol.Downgrade(Features, value))
+}
+
+// Converts a InitializeResult of protocol 3.17 to the latest version.
+func InitializeResultToLatest(value InitializeResult) (protocol.InitializeResult, error) {
+	return convert[protocol.InitializeResult](value)
+}
+
+// Converts a InitializeResult of the latest version to protocol 3.17, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func InitializeResultFromLatest(value protocol.InitializeResult) (InitializeResult, error) {
+	return convert[InitializeResult](protocol.Downgrade(Features, value))
+}
+
+// Converts a InlayHint of protocol 3.17 to the latest version.
+func InlayHintToLatest(value InlayHint) (protocol.InlayHint, error) {
+	return convert[protocol.InlayHint](value)
+}
+
+// Converts a InlayHint of the latest version to protocol 3.17, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func InlayHintFromLatest(value protocol.InlayHint) (InlayHint, error) {
+	return convert[InlayHint](protocol.Downgrade(Features, value))
+}
+
+// Converts a InlayHintLabelPart of protocol 3.17 to the latest version.
+func InlayHintLabelPartToLatest(value InlayHintLabelPart) (protocol.InlayHintLabelPart, error) {
+	return convert[protocol.InlayHintLabelPart](value)
+}
+
+// Converts a InlayHintLabelPart of the latest version to protocol 3.17, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func InlayHintLabelPartFromLatest(value protocol.InlayHintLabelPart) (InlayHintLabelPart, error) {
+	return convert[InlayHintLabelPart](protocol.Downgrade(Features, value))
+}
+
+// Converts a ServerCapabilities of protocol 3.17 to the latest version.
+func ServerCapabilitiesToLatest(value ServerCapabilities) (protocol.ServerCapabilities, error) {
+	return convert[protocol.ServerCapabilities](value)
+}
+
+// Converts a ServerCapabilities of the latest version to protocol 3.17, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func ServerCapabilitiesFromLatest(value protocol.ServerCapabilities) (ServerCapabilities, error) {
+	return convert[ServerCapabilities](protocol.Downgrade(Features, value))
+}
+
+// Converts a SignatureHelpClientCapabilities of protocol 3.17 to the latest version.
+func SignatureHelpClientCapabilitiesToLatest(value SignatureHelpClientCapabilities) (protocol.SignatureHelpClientCapabilities, error) {
+	return convert[protocol.SignatureHelpClientCapabilities](value)
+}
+
+// Converts a SignatureHelpClientCapabilities of the latest version to protocol 3.17, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func SignatureHelpClientCapabilitiesFromLatest(value protocol.SignatureHelpClientCapabilities) (SignatureHelpClientCapabilities, error) {
+	return convert[SignatureHelpClientCapabilities](protocol.Downgrade(Features, value))
+}
+
+// Converts a TextDocumentClientCapabilities of protocol 3.17 to the latest version.
+func TextDocumentClientCapabilitiesToLatest(value TextDocumentClientCapabilities) (protocol.TextDocumentClientCapabilities, error) {
+	return convert[protocol.TextDocumentClientCapabilities](value)
+}
+
+// Converts a TextDocumentClientCapabilities of the latest version to protocol 3.17, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func TextDocumentClientCapabilitiesFromLatest(value protocol.TextDocumentClientCapabilities) (TextDocumentClientCapabilities, error) {
+	return convert[TextDocumentClientCapabilities](protocol.Downgrade(Features, value))
+}
+
+// Converts a TextDocumentEdit of protocol 3.17 to the latest version.
+func TextDocumentEditToLatest(value TextDocumentEdit) (protocol.TextDocumentEdit, error) {
+	return convert[protocol.TextDocumentEdit](value)
+}
+
+// Converts a TextDocumentEdit of the latest version to protocol 3.17, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func TextDocumentEditFromLatest(value protocol.TextDocumentEdit) (TextDocumentEdit, error) {
+	return convert[TextDocumentEdit](protocol.Downgrade(Features, value))
+}
+
+// Converts a WorkspaceClientCapabilities of protocol 3.17 to the latest version.
+func WorkspaceClientCapabilitiesToLatest(value WorkspaceClientCapabilities) (protocol.WorkspaceClientCapabilities, error) {
+	return convert[protocol.WorkspaceClientCapabilities](value)
+}
+
+// Converts a WorkspaceClientCapabilities of the latest version to protocol 3.17, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func WorkspaceClientCapabilitiesFromLatest(value protocol.WorkspaceClientCapabilities) (WorkspaceClientCapabilities, error) {
+	return convert[WorkspaceClientCapabilities](protocol.Downgrade(Features, value))
+}
+
+// Converts a WorkspaceEdit of protocol 3.17 to the latest version.
+func WorkspaceEditToLatest(value WorkspaceEdit) (protocol.WorkspaceEdit, error) {
+	return convert[protocol.WorkspaceEdit](value)
+}
+
+// Converts a WorkspaceEdit of the latest version to protocol 3.17, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func WorkspaceEditFromLatest(value protocol.WorkspaceEdit) (WorkspaceEdit, error) {
+	return convert[WorkspaceEdit](protocol.Downgrade(Features, value))
+}
+
+// Converts a WorkspaceEditClientCapabilities of protocol 3.17 to the latest version.
+func WorkspaceEditClientCapabilitiesToLatest(value WorkspaceEditClientCapabilities) (protocol.WorkspaceEditClientCapabilities, error) {
+	return convert[protocol.WorkspaceEditClientCapabilities](value)
+}
+
+// Converts a WorkspaceEditClientCapabilities of the latest version to protocol 3.17, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func WorkspaceEditClientCapabilitiesFromLatest(value protocol.WorkspaceEditClientCapabilities) (WorkspaceEditClientCapabilities, error) {
+	return convert[WorkspaceEditClientCapabilities](protocol.Downgrade(Features, value))
+}
+
+// Converts a WorkspaceOptions of protocol 3.17 to the latest version.
+func WorkspaceOptionsToLatest(value WorkspaceOptions) (protocol.WorkspaceOptions, error) {
+	return convert[protocol.WorkspaceOptions](value)
+}
+
+// Converts a WorkspaceOptions of the latest version to protocol 3.17, leaving out the
+// fields and union alternatives it doesn't have, as protocol.Downgrade does.
+func WorkspaceOptionsFromLatest(value protocol.WorkspaceOptions) (WorkspaceOptions, error) {
+	return convert[WorkspaceOptions](protocol.Downgrade(Features, value))
+}
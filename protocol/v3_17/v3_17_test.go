@@ -0,0 +1,40 @@
+package v3_17
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+)
+
+func TestTextDocumentEditLeavesOutProposedAlternatives(t *testing.T) {
+	edit := protocol.TextDocumentEdit{
+		TextDocument: protocol.OptionalVersionedTextDocumentIdentifier{Uri: "file:///a.go"},
+		Edits: []protocol.Or3[protocol.TextEdit, protocol.AnnotatedTextEdit, protocol.SnippetTextEdit]{
+			{Value: protocol.TextEdit{NewText: "a"}},
+			{Value: protocol.SnippetTextEdit{Snippet: protocol.StringValue{Kind: "snippet", Value: "${1:b}"}}},
+		},
+	}
+
+	converted, err := TextDocumentEditFromLatest(edit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(converted.Edits) != 1 || converted.Edits[0].Value.(TextEdit).NewText != "a" {
+		t.Fatalf("Expected the snippet edit to be left out, got %+v", converted.Edits)
+	}
+
+	var decoded TextDocumentEdit
+	content, _ := json.Marshal(edit)
+	if err := json.Unmarshal(content, &decoded); err == nil {
+		t.Fatal("Expected the proposed snippet edit to be rejected")
+	}
+
+	latest, err := TextDocumentEditToLatest(converted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(latest.Edits) != 1 || latest.TextDocument.Uri != "file:///a.go" {
+		t.Fatalf("Unexpected edit: %+v", latest)
+	}
+}
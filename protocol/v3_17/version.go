@@ -0,0 +1,244 @@
+// Package v3_17 has the types of version 3.17 of the protocol, without proposed
+// features. It is generated from the protocol package by `mage generate`.
+//
+// Types that are the same in the latest version are aliases of the protocol
+// package's types. Types that changed are declared without the fields and
+// union alternatives added later, are decoded as strictly, and have
+// conversions from and to the latest version, e.g. CompletionItemToLatest
+// and CompletionItemFromLatest. The values of enumerations are not dated by
+// the specification, so they include the values added later.
+package v3_17
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+)
+
+// The part of the protocol this package has.
+var Features = protocol.Features{Version: protocol.Version3_17}
+
+// Decodes the params of a request or notification of this version, rejecting
+// the fields and union alternatives added after it. Methods added after it
+// are an error.
+func DecodeParams(method protocol.MethodKind, params []byte) (any, error) {
+	switch method {
+	case CallHierarchyIncomingCallsMethod:
+		return decodeParams[CallHierarchyIncomingCallsParams](params)
+	case CallHierarchyOutgoingCallsMethod:
+		return decodeParams[CallHierarchyOutgoingCallsParams](params)
+	case ClientRegisterCapabilityMethod:
+		return decodeParams[RegistrationParams](params)
+	case ClientUnregisterCapabilityMethod:
+		return decodeParams[UnregistrationParams](params)
+	case CodeActionResolveMethod:
+		return decodeParams[CodeAction](params)
+	case CodeLensResolveMethod:
+		return decodeParams[CodeLens](params)
+	case CompletionItemResolveMethod:
+		return decodeParams[CompletionItem](params)
+	case DocumentLinkResolveMethod:
+		return decodeParams[DocumentLink](params)
+	case InitializeMethod:
+		return decodeParams[InitializeParams](params)
+	case InlayHintResolveMethod:
+		return decodeParams[InlayHint](params)
+	case ShutdownMethod:
+		return decodeParams[any](params)
+	case TextDocumentCodeActionMethod:
+		return decodeParams[CodeActionParams](params)
+	case TextDocumentCodeLensMethod:
+		return decodeParams[CodeLensParams](params)
+	case TextDocumentColorPresentationMethod:
+		return decodeParams[ColorPresentationParams](params)
+	case TextDocumentCompletionMethod:
+		return decodeParams[CompletionParams](params)
+	case TextDocumentDeclarationMethod:
+		return decodeParams[DeclarationParams](params)
+	case TextDocumentDefinitionMethod:
+		return decodeParams[DefinitionParams](params)
+	case TextDocumentDiagnosticMethod:
+		return decodeParams[DocumentDiagnosticParams](params)
+	case TextDocumentDocumentColorMethod:
+		return decodeParams[DocumentColorParams](params)
+	case TextDocumentDocumentHighlightMethod:
+		return decodeParams[DocumentHighlightParams](params)
+	case TextDocumentDocumentLinkMethod:
+		return decodeParams[DocumentLinkParams](params)
+	case TextDocumentDocumentSymbolMethod:
+		return decodeParams[DocumentSymbolParams](params)
+	case TextDocumentFoldingRangeMethod:
+		return decodeParams[FoldingRangeParams](params)
+	case TextDocumentFormattingMethod:
+		return decodeParams[DocumentFormattingParams](params)
+	case TextDocumentHoverMethod:
+		return decodeParams[HoverParams](params)
+	case TextDocumentImplementationMethod:
+		return decodeParams[ImplementationParams](params)
+	case TextDocumentInlayHintMethod:
+		return decodeParams[InlayHintParams](params)
+	case TextDocumentInlineValueMethod:
+		return decodeParams[InlineValueParams](params)
+	case TextDocumentLinkedEditingRangeMethod:
+		return decodeParams[LinkedEditingRangeParams](params)
+	case TextDocumentMonikerMethod:
+		return decodeParams[MonikerParams](params)
+	case TextDocumentOnTypeFormattingMethod:
+		return decodeParams[DocumentOnTypeFormattingParams](params)
+	case TextDocumentPrepareCallHierarchyMethod:
+		return decodeParams[CallHierarchyPrepareParams](params)
+	case TextDocumentPrepareRenameMethod:
+		return decodeParams[PrepareRenameParams](params)
+	case TextDocumentPrepareTypeHierarchyMethod:
+		return decodeParams[TypeHierarchyPrepareParams](params)
+	case TextDocumentRangeFormattingMethod:
+		return decodeParams[DocumentRangeFormattingParams](params)
+	case TextDocumentReferencesMethod:
+		return decodeParams[ReferenceParams](params)
+	case TextDocumentRenameMethod:
+		return decodeParams[RenameParams](params)
+	case TextDocumentSelectionRangeMethod:
+		return decodeParams[SelectionRangeParams](params)
+	case TextDocumentSemanticTokensFullMethod:
+		return decodeParams[SemanticTokensParams](params)
+	case TextDocumentSemanticTokensFullDeltaMethod:
+		return decodeParams[SemanticTokensDeltaParams](params)
+	case TextDocumentSemanticTokensRangeMethod:
+		return decodeParams[SemanticTokensRangeParams](params)
+	case TextDocumentSignatureHelpMethod:
+		return decodeParams[SignatureHelpParams](params)
+	case TextDocumentTypeDefinitionMethod:
+		return decodeParams[TypeDefinitionParams](params)
+	case TextDocumentWillSaveWaitUntilMethod:
+		return decodeParams[WillSaveTextDocumentParams](params)
+	case TypeHierarchySubtypesMethod:
+		return decodeParams[TypeHierarchySubtypesParams](params)
+	case TypeHierarchySupertypesMethod:
+		return decodeParams[TypeHierarchySupertypesParams](params)
+	case WindowShowDocumentMethod:
+		return decodeParams[ShowDocumentParams](params)
+	case WindowShowMessageRequestMethod:
+		return decodeParams[ShowMessageRequestParams](params)
+	case WindowWorkDoneProgressCreateMethod:
+		return decodeParams[WorkDoneProgressCreateParams](params)
+	case WorkspaceApplyEditMethod:
+		return decodeParams[ApplyWorkspaceEditParams](params)
+	case WorkspaceCodeLensRefreshMethod:
+		return decodeParams[any](params)
+	case WorkspaceConfigurationMethod:
+		return decodeParams[ConfigurationParams](params)
+	case WorkspaceDiagnosticMethod:
+		return decodeParams[WorkspaceDiagnosticParams](params)
+	case WorkspaceDiagnosticRefreshMethod:
+		return decodeParams[any](params)
+	case WorkspaceExecuteCommandMethod:
+		return decodeParams[ExecuteCommandParams](params)
+	case WorkspaceInlayHintRefreshMethod:
+		return decodeParams[any](params)
+	case WorkspaceInlineValueRefreshMethod:
+		return decodeParams[any](params)
+	case WorkspaceSemanticTokensRefreshMethod:
+		return decodeParams[any](params)
+	case WorkspaceSymbolMethod:
+		return decodeParams[WorkspaceSymbolParams](params)
+	case WorkspaceWillCreateFilesMethod:
+		return decodeParams[CreateFilesParams](params)
+	case WorkspaceWillDeleteFilesMethod:
+		return decodeParams[DeleteFilesParams](params)
+	case WorkspaceWillRenameFilesMethod:
+		return decodeParams[RenameFilesParams](params)
+	case WorkspaceWorkspaceFoldersMethod:
+		return decodeParams[any](params)
+	case WorkspaceSymbolResolveMethod:
+		return decodeParams[WorkspaceSymbol](params)
+	case OptionalCancelRequestMethod:
+		return decodeParams[CancelParams](params)
+	case OptionalLogTraceMethod:
+		return decodeParams[LogTraceParams](params)
+	case OptionalProgressMethod:
+		return decodeParams[ProgressParams](params)
+	case OptionalSetTraceMethod:
+		return decodeParams[SetTraceParams](params)
+	case ExitMethod:
+		return decodeParams[any](params)
+	case InitializedMethod:
+		return decodeParams[InitializedParams](params)
+	case NotebookDocumentDidChangeMethod:
+		return decodeParams[DidChangeNotebookDocumentParams](params)
+	case NotebookDocumentDidCloseMethod:
+		return decodeParams[DidCloseNotebookDocumentParams](params)
+	case NotebookDocumentDidOpenMethod:
+		return decodeParams[DidOpenNotebookDocumentParams](params)
+	case NotebookDocumentDidSaveMethod:
+		return decodeParams[DidSaveNotebookDocumentParams](params)
+	case TelemetryEventMethod:
+		return decodeParams[any](params)
+	case TextDocumentDidChangeMethod:
+		return decodeParams[DidChangeTextDocumentParams](params)
+	case TextDocumentDidCloseMethod:
+		return decodeParams[DidCloseTextDocumentParams](params)
+	case TextDocumentDidOpenMethod:
+		return decodeParams[DidOpenTextDocumentParams](params)
+	case TextDocumentDidSaveMethod:
+		return decodeParams[DidSaveTextDocumentParams](params)
+	case TextDocumentPublishDiagnosticsMethod:
+		return decodeParams[PublishDiagnosticsParams](params)
+	case TextDocumentWillSaveMethod:
+		return decodeParams[WillSaveTextDocumentParams](params)
+	case WindowLogMessageMethod:
+		return decodeParams[LogMessageParams](params)
+	case WindowShowMessageMethod:
+		return decodeParams[ShowMessageParams](params)
+	case WindowWorkDoneProgressCancelMethod:
+		return decodeParams[WorkDoneProgressCancelParams](params)
+	case WorkspaceDidChangeConfigurationMethod:
+		return decodeParams[DidChangeConfigurationParams](params)
+	case WorkspaceDidChangeWatchedFilesMethod:
+		return decodeParams[DidChangeWatchedFilesParams](params)
+	case WorkspaceDidChangeWorkspaceFoldersMethod:
+		return decodeParams[DidChangeWorkspaceFoldersParams](params)
+	case WorkspaceDidCreateFilesMethod:
+		return decodeParams[CreateFilesParams](params)
+	case WorkspaceDidDeleteFilesMethod:
+		return decodeParams[DeleteFilesParams](params)
+	case WorkspaceDidRenameFilesMethod:
+		return decodeParams[RenameFilesParams](params)
+	}
+
+	return nil, fmt.Errorf("method not found in protocol 3.17: %s", method)
+}
+
+func decodeParams[T any](params []byte) (any, error) {
+	value, err := decode[T](params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+// Converts a value between versions with a json round trip.
+func convert[T any](value any) (T, error) {
+	content, err := json.Marshal(value)
+
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	return decode[T](content)
+}
+
+// Decodes content strictly, rejecting unknown fields like the types of the
+// protocol package do.
+func decode[T any](content []byte) (T, error) {
+	var value T
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.DisallowUnknownFields()
+	err := decoder.Decode(&value)
+
+	return value, err
+}
@@ -0,0 +1,349 @@
+package protocol
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Version is a version of the protocol, e.g. 3.17.
+type Version struct {
+	Major int
+	Minor int
+}
+
+var (
+	Version3_16 = Version{Major: 3, Minor: 16}
+	Version3_17 = Version{Major: 3, Minor: 17}
+	Version3_18 = Version{Major: 3, Minor: 18}
+)
+
+// Helper function that parses a version such as 3.17 or 3.17.0. The patch
+// version is ignored, since the protocol only adds features in minor versions.
+func ParseVersion(version string) (Version, error) {
+	parts := strings.Split(version, ".")
+
+	if len(parts) < 2 || len(parts) > 3 {
+		return Version{}, fmt.Errorf("invalid version: %q", version)
+	}
+
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return Version{}, fmt.Errorf("invalid version: %q", version)
+	}
+
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return Version{}, fmt.Errorf("invalid version: %q", version)
+	}
+
+	return Version{Major: major, Minor: minor}, nil
+}
+
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d", v.Major, v.Minor)
+}
+
+// Returns -1, 0 or 1 when v is older than, the same as, or newer than other.
+func (v Version) Compare(other Version) int {
+	if v.Major != other.Major {
+		return cmp.Compare(v.Major, other.Major)
+	}
+
+	return cmp.Compare(v.Minor, other.Minor)
+}
+
+// MethodVersion is the version a method or type was added in, and whether it
+// is still proposed.
+type MethodVersion struct {
+	Since    string
+	Proposed bool
+}
+
+// Features is the part of the protocol a peer speaks: everything up to
+// Version, and proposed features only when Proposed is set. The zero value
+// speaks every version.
+//
+// Fields and methods are looked up in the since and proposed struct tags and
+// MethodVersions, and the alternatives of unions, e.g. SnippetTextEdit in
+// TextDocumentEdit.edits, in TypeVersions. The generator fills them in from
+// the specification.
+type Features struct {
+	Version  Version
+	Proposed bool
+}
+
+// Reports whether a feature added in since, and proposed or not, is part of
+// the protocol f speaks.
+func (f Features) Has(since string, proposed bool) bool {
+	if proposed && !f.Proposed && f.Version != (Version{}) {
+		return false
+	}
+
+	if since == "" || f.Version == (Version{}) {
+		return true
+	}
+
+	version, err := ParseVersion(since)
+	if err != nil {
+		return true
+	}
+
+	return version.Compare(f.Version) <= 0
+}
+
+// Reports whether method is part of the protocol f speaks. Methods that are
+// not part of the specification, such as extensions, are always supported.
+func (f Features) SupportsMethod(method MethodKind) bool {
+	version, exists := MethodVersions[string(method)]
+	if !exists {
+		return true
+	}
+
+	return f.Has(version.Since, version.Proposed)
+}
+
+// VersionError reports a message or field that is not part of the protocol a
+// peer speaks.
+type VersionError struct {
+	// The method, or the path of the field, e.g. params.context.selectedCompletionInfo.
+	Path     string
+	Since    string
+	Proposed bool
+}
+
+func (e *VersionError) Error() string {
+	if e.Proposed {
+		return fmt.Sprintf("%s is a proposed feature", e.Path)
+	}
+
+	return fmt.Sprintf("%s requires protocol %s", e.Path, e.Since)
+}
+
+// Returns a *VersionError for the first field set in value that is not part of
+// the protocol f speaks, or nil.
+func (f Features) Check(value any) error {
+	return f.check(reflect.ValueOf(value), "")
+}
+
+func (f Features) check(value reflect.Value, path string) error {
+	switch value.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if value.IsNil() {
+			return nil
+		}
+		return f.check(value.Elem(), path)
+	case reflect.Slice, reflect.Array:
+		for i := range value.Len() {
+			if err := f.check(value.Index(i), fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		for _, key := range value.MapKeys() {
+			if err := f.check(value.MapIndex(key), fmt.Sprintf("%s[%v]", path, key)); err != nil {
+				return err
+			}
+		}
+	case reflect.Struct:
+		if version, unsupported := f.unsupportedAlternative(value); unsupported {
+			return &VersionError{Path: path, Since: version.Since, Proposed: version.Proposed}
+		}
+
+		for i := range value.NumField() {
+			field := value.Type().Field(i)
+
+			if !field.IsExported() {
+				continue
+			}
+
+			fieldPath := joinPath(path, field)
+			since, proposed := fieldVersion(field)
+
+			if !f.Has(since, proposed) {
+				if value.Field(i).IsZero() {
+					continue
+				}
+				return &VersionError{Path: fieldPath, Since: since, Proposed: proposed}
+			}
+
+			if err := f.check(value.Field(i), fieldPath); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Returns a copy of value without the fields that are not part of the protocol
+// f speaks, e.g. to answer a 3.17 client with a result built for the latest
+// version. Unions holding an alternative f doesn't speak are emptied: slices
+// leave them out, and pointers to them become nil.
+func Downgrade[T any](f Features, value T) T {
+	if !reflect.ValueOf(value).IsValid() {
+		return value
+	}
+
+	downgraded := f.downgrade(reflect.ValueOf(&value).Elem())
+
+	return downgraded.Interface().(T)
+}
+
+func (f Features) downgrade(value reflect.Value) reflect.Value {
+	switch value.Kind() {
+	case reflect.Pointer:
+		if value.IsNil() {
+			return value
+		}
+		element := f.downgrade(value.Elem())
+		if emptied(value.Elem(), element) {
+			return reflect.Zero(value.Type())
+		}
+		copied := reflect.New(value.Type().Elem())
+		copied.Elem().Set(element)
+		return copied
+	case reflect.Interface:
+		if value.IsNil() {
+			return value
+		}
+		copied := reflect.New(value.Type()).Elem()
+		copied.Set(f.downgrade(value.Elem()))
+		return copied
+	case reflect.Slice:
+		if value.IsNil() {
+			return value
+		}
+		copied := reflect.MakeSlice(value.Type(), 0, value.Len())
+		for i := range value.Len() {
+			element := f.downgrade(value.Index(i))
+			if emptied(value.Index(i), element) {
+				continue
+			}
+			copied = reflect.Append(copied, element)
+		}
+		return copied
+	case reflect.Map:
+		if value.IsNil() {
+			return value
+		}
+		copied := reflect.MakeMapWithSize(value.Type(), value.Len())
+		for _, key := range value.MapKeys() {
+			copied.SetMapIndex(key, f.downgrade(value.MapIndex(key)))
+		}
+		return copied
+	case reflect.Struct:
+		if _, unsupported := f.unsupportedAlternative(value); unsupported {
+			return reflect.Zero(value.Type())
+		}
+		copied := reflect.New(value.Type()).Elem()
+		copied.Set(value)
+		for i := range value.NumField() {
+			field := value.Type().Field(i)
+
+			if !field.IsExported() {
+				continue
+			}
+
+			if since, proposed := fieldVersion(field); !f.Has(since, proposed) {
+				copied.Field(i).SetZero()
+				continue
+			}
+
+			copied.Field(i).Set(f.downgrade(value.Field(i)))
+		}
+		return copied
+	}
+
+	return value
+}
+
+// Returns the version of the alternative a union such as Or2 holds, if f
+// doesn't speak it. Slices of an alternative, e.g. []WorkspaceSymbol, are
+// looked up by their element.
+func (f Features) unsupportedAlternative(value reflect.Value) (MethodVersion, bool) {
+	if !isUnion(value.Type()) || value.Field(0).IsNil() {
+		return MethodVersion{}, false
+	}
+
+	alternative := value.Field(0).Elem().Type()
+
+	for alternative.Kind() == reflect.Pointer || alternative.Kind() == reflect.Slice {
+		alternative = alternative.Elem()
+	}
+
+	if alternative.PkgPath() != reflect.TypeFor[Features]().PkgPath() {
+		return MethodVersion{}, false
+	}
+
+	version, exists := TypeVersions[alternative.Name()]
+
+	return version, exists && !f.Has(version.Since, version.Proposed)
+}
+
+// Reports whether a type is a union such as Or2, NullableOr3 or an alias of one.
+func isUnion(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && t.NumField() == 1 && t.Field(0).Name == "Value" && t.Field(0).Type.Kind() == reflect.Interface
+}
+
+// Reports whether downgrading emptied a union that held an alternative.
+func emptied(original reflect.Value, downgraded reflect.Value) bool {
+	return isUnion(original.Type()) && !original.Field(0).IsNil() && downgraded.Field(0).IsNil()
+}
+
+// Returns middleware for a peer that speaks f. Requests for methods it doesn't
+// speak are answered with ErrorCodesMethodNotFound and such notifications are
+// dropped. Params with fields it doesn't speak are rejected with
+// ErrorCodesInvalidParams, and results are downgraded.
+func (f Features) Middleware() Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, message IncomingMessage) (any, error) {
+			_, isRequest := message.(Request)
+
+			if !f.SupportsMethod(message.GetMethod()) {
+				if !isRequest {
+					return nil, nil
+				}
+				return nil, Error(int32(ErrorCodesMethodNotFound), fmt.Errorf("method not found: %s", message.GetMethod()))
+			}
+
+			if err := f.Check(message.GetParams()); err != nil {
+				if !isRequest {
+					return nil, nil
+				}
+				return nil, Error(int32(ErrorCodesInvalidParams), err)
+			}
+
+			result, err := next(ctx, message)
+
+			if result != nil {
+				result = Downgrade(f, result)
+			}
+
+			return result, err
+		}
+	}
+}
+
+// Returns the version a field was added in, from its struct tags.
+func fieldVersion(field reflect.StructField) (string, bool) {
+	return field.Tag.Get("since"), field.Tag.Get("proposed") == "true"
+}
+
+// Returns the path of a field, using its json name.
+func joinPath(path string, field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+
+	if name == "" {
+		name = field.Name
+	}
+
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
@@ -0,0 +1,146 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestParseVersion(t *testing.T) {
+	version, err := ParseVersion("3.17.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != Version3_17 {
+		t.Fatalf("Expected 3.17, got %s", version)
+	}
+	if Version3_16.Compare(Version3_17) != -1 || Version3_18.Compare(Version3_17) != 1 {
+		t.Fatal("Expected versions to be ordered by minor version")
+	}
+	if _, err := ParseVersion("3"); err == nil {
+		t.Fatal("Expected an error for a version without a minor version")
+	}
+}
+
+func TestFeaturesSupportsMethod(t *testing.T) {
+	features := Features{Version: Version3_16}
+
+	if !features.SupportsMethod(TextDocumentHoverMethod) {
+		t.Fatal("Expected textDocument/hover in 3.16")
+	}
+	if features.SupportsMethod(TextDocumentInlayHintMethod) {
+		t.Fatal("Expected no textDocument/inlayHint before 3.17")
+	}
+	if (Features{Version: Version3_18}).SupportsMethod(TextDocumentInlineCompletionMethod) {
+		t.Fatal("Expected no proposed methods without Proposed")
+	}
+	if !(Features{Version: Version3_18, Proposed: true}).SupportsMethod(TextDocumentInlineCompletionMethod) {
+		t.Fatal("Expected proposed methods with Proposed")
+	}
+	if !(Features{}).SupportsMethod(TextDocumentInlineCompletionMethod) {
+		t.Fatal("Expected the zero Features to support every method")
+	}
+}
+
+func TestFeaturesCheck(t *testing.T) {
+	params := CompletionParams{Context: &CompletionContext{TriggerKind: CompletionTriggerKindInvoked}}
+
+	if err := (Features{Version: Version3_16}).Check(params); err != nil {
+		t.Fatal(err)
+	}
+
+	capabilities := ClientCapabilities{NotebookDocument: &NotebookDocumentClientCapabilities{}}
+
+	var versionError *VersionError
+	if err := (Features{Version: Version3_16}).Check(capabilities); !errors.As(err, &versionError) || versionError.Path != "notebookDocument" || versionError.Since != "3.17.0" {
+		t.Fatalf("Expected notebookDocument to require 3.17, got %v", err)
+	}
+	if err := (Features{Version: Version3_17}).Check(capabilities); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDowngrade(t *testing.T) {
+	item := CompletionItem{
+		Label:        "fmt",
+		LabelDetails: &CompletionItemLabelDetails{Detail: "package"},
+		Tags:         []CompletionItemTag{CompletionItemTagDeprecated},
+	}
+	list := []CompletionItem{item}
+
+	downgraded := Downgrade(Features{Version: Version3_16}, list)
+
+	if downgraded[0].LabelDetails != nil {
+		t.Fatal("Expected labelDetails to be removed before 3.17")
+	}
+	if len(downgraded[0].Tags) != 1 || downgraded[0].Label != "fmt" {
+		t.Fatalf("Expected the other fields to be kept, got %+v", downgraded[0])
+	}
+	if list[0].LabelDetails == nil {
+		t.Fatal("Expected the original value to be unchanged")
+	}
+}
+
+func TestDowngradeUnionAlternatives(t *testing.T) {
+	edit := TextDocumentEdit{Edits: []Or3[TextEdit, AnnotatedTextEdit, SnippetTextEdit]{
+		{Value: TextEdit{NewText: "a"}},
+		{Value: SnippetTextEdit{Snippet: StringValue{Kind: "snippet", Value: "${1:b}"}}},
+	}}
+
+	downgraded := Downgrade(Features{Version: Version3_18}, edit)
+
+	if len(downgraded.Edits) != 1 || downgraded.Edits[0].Value != (TextEdit{NewText: "a"}) {
+		t.Fatalf("Expected the snippet edit to be left out without proposed features, got %+v", downgraded.Edits)
+	}
+	if len(edit.Edits) != 2 {
+		t.Fatal("Expected the original value to be unchanged")
+	}
+	if downgraded := Downgrade(Features{Version: Version3_18, Proposed: true}, edit); len(downgraded.Edits) != 2 {
+		t.Fatalf("Expected the snippet edit to be kept with proposed features, got %+v", downgraded.Edits)
+	}
+
+	symbols := NullableOr2[[]SymbolInformation, []WorkspaceSymbol]{Value: []WorkspaceSymbol{{Name: "main"}}}
+
+	if downgraded := Downgrade(Features{Version: Version3_16}, symbols); downgraded.Value != nil {
+		t.Fatalf("Expected workspace symbols to be left out before 3.17, got %+v", downgraded.Value)
+	}
+	if downgraded := Downgrade(Features{Version: Version3_17}, symbols); downgraded.Value == nil {
+		t.Fatal("Expected workspace symbols to be kept in 3.17")
+	}
+
+	var versionError *VersionError
+	if err := (Features{Version: Version3_17}).Check(edit); !errors.As(err, &versionError) || versionError.Path != "edits[1]" || !versionError.Proposed {
+		t.Fatalf("Expected the snippet edit to be rejected, got %v", err)
+	}
+}
+
+func TestDowngradeNil(t *testing.T) {
+	if value := Downgrade[any](Features{Version: Version3_16}, nil); value != nil {
+		t.Fatalf("Expected nil, got %v", value)
+	}
+
+	var item *CompletionItem
+	if value := Downgrade(Features{Version: Version3_16}, item); value != nil {
+		t.Fatalf("Expected a nil pointer, got %v", value)
+	}
+}
+
+func TestFeaturesMiddleware(t *testing.T) {
+	client, _ := connPair(t, ConnOptions{}, ConnOptions{
+		Handler: func(ctx context.Context, message IncomingMessage) (any, error) {
+			return &Hover{Contents: Or3[MarkupContent, MarkedString, []MarkedString]{Value: MarkupContent{Kind: MarkupKindPlainText}}}, nil
+		},
+		Middleware: []Middleware{Features{Version: Version3_16}.Middleware()},
+	})
+
+	if _, err := client.Hover(context.Background(), hoverParams("file:///a.go")); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := client.InlayHint(context.Background(), InlayHintParams{})
+
+	var responseError *ResponseError
+	if !errors.As(err, &responseError) || responseError.Code != int32(ErrorCodesMethodNotFound) {
+		t.Fatalf("Expected MethodNotFound for a 3.17 method, got %v", err)
+	}
+}
@@ -0,0 +1,33 @@
+package watchdog
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strconv"
+	"syscall"
+)
+
+// Reports whether pid is running. Zombie processes, which have exited but not
+// been reaped by their parent yet, count as dead.
+func processAlive(pid int) bool {
+	if err := syscall.Kill(pid, 0); err != nil && !errors.Is(err, syscall.EPERM) {
+		return false
+	}
+
+	stat, err := os.ReadFile("/proc/" + strconv.Itoa(pid) + "/stat")
+
+	if err != nil {
+		return !errors.Is(err, os.ErrNotExist)
+	}
+
+	// The state follows the command name, which is wrapped in parentheses and
+	// may itself contain spaces or parentheses.
+	end := bytes.LastIndexByte(stat, ')')
+
+	if end < 0 || end+2 >= len(stat) {
+		return true
+	}
+
+	return stat[end+2] != 'Z' && stat[end+2] != 'X'
+}
@@ -0,0 +1,23 @@
+//go:build !linux
+
+package watchdog
+
+import (
+	"errors"
+	"os"
+	"syscall"
+)
+
+// Reports whether pid is running. Platforms that can't signal a process are
+// assumed to have it running.
+func processAlive(pid int) bool {
+	process, err := os.FindProcess(pid)
+
+	if err != nil {
+		return false
+	}
+
+	err = process.Signal(syscall.Signal(0))
+
+	return err == nil || !errors.Is(err, os.ErrProcessDone)
+}
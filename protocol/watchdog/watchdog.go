@@ -0,0 +1,132 @@
+// Package watchdog shuts a language server down once the editor process that
+// launched it has exited, as the spec asks of servers given a process id.
+package watchdog
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+)
+
+// Options configures a Watchdog.
+type Options struct {
+	// How often the process is polled. Defaults to one second.
+	Interval time.Duration
+	// How long Shutdown has to return before Exit is called. Defaults to five seconds.
+	GracePeriod time.Duration
+	// Performs an orderly shutdown of the server. The context is cancelled once
+	// the grace period is over.
+	Shutdown func(ctx context.Context)
+	// Called when Shutdown has not returned within the grace period, or after it
+	// returns. Defaults to os.Exit(1).
+	Exit func()
+}
+
+// Watchdog polls a parent process and shuts the server down when it dies.
+type Watchdog struct {
+	pid     int
+	options Options
+	stop    chan struct{}
+	done    chan struct{}
+	once    sync.Once
+}
+
+// Starts watching pid. The watchdog stops when ctx is done or Stop is called.
+func Start(ctx context.Context, pid int, options Options) *Watchdog {
+	if options.Interval <= 0 {
+		options.Interval = time.Second
+	}
+
+	if options.GracePeriod <= 0 {
+		options.GracePeriod = 5 * time.Second
+	}
+
+	if options.Exit == nil {
+		options.Exit = func() { os.Exit(1) }
+	}
+
+	w := &Watchdog{
+		pid:     pid,
+		options: options,
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+
+	go w.run(ctx)
+
+	return w
+}
+
+// Starts watching the process id of an initialize request. It returns nil when
+// the client did not send one.
+func FromInitializeParams(ctx context.Context, params protocol.InitializeParams, options Options) *Watchdog {
+	if params.ProcessId == nil || *params.ProcessId <= 0 {
+		return nil
+	}
+
+	return Start(ctx, int(*params.ProcessId), options)
+}
+
+// Stops polling. It has no effect once the shutdown was triggered.
+func (w *Watchdog) Stop() {
+	w.once.Do(func() {
+		close(w.stop)
+	})
+}
+
+// Returns a channel that is closed once the parent process died and the
+// shutdown finished, or the watchdog was stopped.
+func (w *Watchdog) Done() <-chan struct{} {
+	return w.done
+}
+
+// The process id being watched.
+func (w *Watchdog) PID() int {
+	return w.pid
+}
+
+func (w *Watchdog) run(ctx context.Context) {
+	defer close(w.done)
+
+	ticker := time.NewTicker(w.options.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-w.stop:
+			return
+		case <-ticker.C:
+			if processAlive(w.pid) {
+				continue
+			}
+			w.shutdown()
+			return
+		}
+	}
+}
+
+func (w *Watchdog) shutdown() {
+	ctx, cancel := context.WithTimeout(context.Background(), w.options.GracePeriod)
+	defer cancel()
+
+	if w.options.Shutdown != nil {
+		finished := make(chan struct{})
+
+		go func() {
+			defer close(finished)
+			w.options.Shutdown(ctx)
+		}()
+
+		select {
+		case <-finished:
+		case <-ctx.Done():
+		}
+	}
+
+	w.options.Exit()
+}
@@ -0,0 +1,111 @@
+package watchdog
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/myleshyson/lsprotocol-go/protocol"
+)
+
+func startParent(t *testing.T) *exec.Cmd {
+	cmd := exec.Command("sleep", "60")
+
+	if err := cmd.Start(); err != nil {
+		t.Skipf("unable to spawn a process: %s", err)
+	}
+
+	t.Cleanup(func() {
+		cmd.Process.Kill()
+		cmd.Wait()
+	})
+
+	return cmd
+}
+
+func TestShutdownWhenParentDies(t *testing.T) {
+	parent := startParent(t)
+	shutdown := make(chan struct{})
+	exited := make(chan struct{})
+	pid := int32(parent.Process.Pid)
+
+	watchdog := FromInitializeParams(context.Background(), protocol.InitializeParams{ProcessId: &pid}, Options{
+		Interval: 10 * time.Millisecond,
+		Shutdown: func(ctx context.Context) { close(shutdown) },
+		Exit:     func() { close(exited) },
+	})
+
+	select {
+	case <-shutdown:
+		t.Fatal("Expected no shutdown while the parent is alive")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	parent.Process.Kill()
+
+	select {
+	case <-exited:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected exit after the parent died")
+	}
+
+	<-shutdown
+	<-watchdog.Done()
+}
+
+func TestExitAfterGracePeriod(t *testing.T) {
+	parent := startParent(t)
+	exited := make(chan struct{})
+	cancelled := make(chan struct{})
+	release := make(chan struct{})
+	t.Cleanup(func() { close(release) })
+
+	Start(context.Background(), parent.Process.Pid, Options{
+		Interval:    10 * time.Millisecond,
+		GracePeriod: 20 * time.Millisecond,
+		Shutdown: func(ctx context.Context) {
+			<-ctx.Done()
+			close(cancelled)
+			<-release
+		},
+		Exit: func() { close(exited) },
+	})
+
+	parent.Process.Kill()
+
+	select {
+	case <-exited:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected exit after the grace period")
+	}
+
+	<-cancelled
+}
+
+func TestStop(t *testing.T) {
+	parent := startParent(t)
+
+	watchdog := Start(context.Background(), parent.Process.Pid, Options{
+		Interval: 10 * time.Millisecond,
+		Exit:     func() { t.Error("Expected no exit after Stop") },
+	})
+	watchdog.Stop()
+	<-watchdog.Done()
+
+	parent.Process.Kill()
+	time.Sleep(50 * time.Millisecond)
+}
+
+func TestWithoutProcessId(t *testing.T) {
+	if FromInitializeParams(context.Background(), protocol.InitializeParams{}, Options{}) != nil {
+		t.Fatal("Expected no watchdog without a process id")
+	}
+}
+
+func TestProcessAlive(t *testing.T) {
+	if !processAlive(os.Getpid()) {
+		t.Fatal("Expected the current process to be alive")
+	}
+}